@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+
+	"github.com/thatlq1812/service-2-article/internal/config"
+	"github.com/thatlq1812/service-2-article/internal/db"
+	"github.com/thatlq1812/service-2-article/internal/importer"
+	"github.com/thatlq1812/service-2-article/internal/repository"
+	"github.com/thatlq1812/service-2-article/internal/server"
+	pb "github.com/thatlq1812/service-2-article/proto"
+)
+
+const importUsage = "usage: article-service import [-dry-run] [-format jsonl|csv|markdown] [-author id] [-batch-size n] <file or directory>"
+
+// runImport handles the "import" subcommand. It writes to the database directly as an
+// admin, so rows keep their author and timestamps; authors are still checked against
+// User Service.
+func runImport(cfg *config.Config, args []string) {
+	flags := flag.NewFlagSet("import", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), importUsage)
+		flags.PrintDefaults()
+	}
+	dryRun := flags.Bool("dry-run", false, "validate every row without inserting")
+	format := flags.String("format", "", "input format; detected from the path when empty")
+	author := flags.Int("author", 0, "author of rows without author_id")
+	batchSize := flags.Int("batch-size", server.DefaultImportBatchSize, "rows inserted per transaction")
+	flags.Parse(args)
+	if flags.NArg() != 1 {
+		log.Fatal(importUsage)
+	}
+
+	reader, err := importer.Open(flags.Arg(0), *format)
+	if err != nil {
+		log.Fatalf("Failed to open import source: %v", err)
+	}
+	defer reader.Close()
+
+	pool, err := db.NewPostgresPool(cfg.DB)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer pool.Close()
+
+	userClient := newUserClient(cfg)
+	articleServer := server.NewArticleServer(repository.NewArticlePostgresRepository(pool), userClient, nil, cfg.JWTSecret, nil)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	// Unreadable records are reported here; Import never sees them
+	var unreadable int32
+	next := func() (*pb.ImportedArticle, error) {
+		for {
+			article, err := reader.Next()
+			var rowErr *importer.RowError
+			if !errors.As(err, &rowErr) {
+				return article, err
+			}
+			unreadable++
+			fmt.Fprintf(os.Stdout, "%s\t%v\n", rowErr.Source, rowErr.Err)
+		}
+	}
+
+	report, err := articleServer.Import(ctx, server.ImportOptions{
+		Author:    int32(*author),
+		Admin:     true,
+		DryRun:    *dryRun,
+		BatchSize: *batchSize,
+	}, next)
+	if err != nil {
+		log.Fatalf("Import failed: %v", err)
+	}
+
+	for _, e := range report.Errors {
+		fmt.Fprintf(os.Stdout, "%s\t%s\n", e.Source, e.Message)
+	}
+	if report.ErrorsTruncated {
+		fmt.Fprintf(os.Stdout, "... %d more rejected rows\n", int(report.Failed)-len(report.Errors))
+	}
+	verb := "imported"
+	if *dryRun {
+		verb = "valid (dry run)"
+	}
+	fmt.Fprintf(os.Stdout, "%d rows: %d %s, %d failed\n",
+		report.Received+unreadable, report.Imported, verb, report.Failed+unreadable)
+
+	if report.Failed+unreadable > 0 {
+		os.Exit(1)
+	}
+}
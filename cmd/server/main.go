@@ -1,21 +1,33 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net"
+	"os"
 
 	"github.com/joho/godotenv"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/reflection"
 
 	"github.com/thatlq1812/agrios-shared/pkg/common"
 	"github.com/thatlq1812/service-2-article/internal/client"
 	"github.com/thatlq1812/service-2-article/internal/config"
 	"github.com/thatlq1812/service-2-article/internal/db"
+	"github.com/thatlq1812/service-2-article/internal/interceptor"
+	"github.com/thatlq1812/service-2-article/internal/migrate"
+	"github.com/thatlq1812/service-2-article/internal/outbox"
+	"github.com/thatlq1812/service-2-article/internal/ratelimit"
 	"github.com/thatlq1812/service-2-article/internal/repository"
 	"github.com/thatlq1812/service-2-article/internal/server"
+	"github.com/thatlq1812/service-2-article/internal/tlsconfig"
+	"github.com/thatlq1812/service-2-article/internal/views"
+	"github.com/thatlq1812/service-2-article/internal/webhook"
+	"github.com/thatlq1812/service-2-article/migrations"
 	pb "github.com/thatlq1812/service-2-article/proto"
+	pbv2 "github.com/thatlq1812/service-2-article/proto/v2"
 )
 
 func main() {
@@ -26,7 +38,17 @@ func main() {
 	// 0. Load
 	cfg := config.Load()
 
-	// 1.
+	// 1. Subcommands: "migrate up|down|status", "import <path>"
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "migrate":
+			runMigrate(cfg, os.Args[2:])
+			return
+		case "import":
+			runImport(cfg, os.Args[2:])
+			return
+		}
+	}
 
 	// 2. Setup database connection pool
 	pool, err := db.NewPostgresPool(cfg.DB)
@@ -36,10 +58,24 @@ func main() {
 	defer pool.Close()
 	log.Println("Connected to PostgreSQL successfully")
 
-	// 3. Create repository
+	if cfg.AutoMigrate {
+		runner, err := migrate.NewRunner(pool, migrations.FS)
+		if err != nil {
+			log.Fatalf("Failed to load migrations: %v", err)
+		}
+		if err := runner.Up(context.Background()); err != nil {
+			log.Fatalf("Failed to apply migrations: %v", err)
+		}
+	}
+
+	// 3. Create repositories
 	articleRepo := repository.NewArticlePostgresRepository(pool)
+	commentRepo := repository.NewCommentPostgresRepository(pool)
+	reactionRepo := repository.NewReactionPostgresRepository(pool)
+	bookmarkRepo := repository.NewBookmarkPostgresRepository(pool)
+	followRepo := repository.NewFollowPostgresRepository(pool)
 
-	// 4. Setup Redis connection (for token blacklist check)
+	// 4. Setup Redis connection (for token blacklist check and caching)
 	redisClient, err := db.NewRedisClient(cfg.Redis.Addr, cfg.Redis.Password, cfg.Redis.DB)
 	if err != nil {
 		log.Fatalf("Failed to connect to Redis: %v", err)
@@ -47,17 +83,137 @@ func main() {
 	defer redisClient.Close()
 	log.Printf("Connected to Redis at %s", cfg.Redis.Addr)
 
+	if cfg.Cache.Enabled {
+		articleRepo = repository.NewArticleCacheRepository(articleRepo, redisClient, cfg.Cache.TTL)
+		// Comment and reaction changes update the article's counters, so they invalidate the cached article
+		commentRepo = repository.NewCommentCacheRepository(commentRepo, redisClient)
+		reactionRepo = repository.NewReactionCacheRepository(reactionRepo, redisClient)
+		log.Printf("Article cache enabled (ttl=%s)", cfg.Cache.TTL)
+	}
+
+	// Publish article events written to the outbox (see OUTBOX_SINK) and prune old ones
+	relayCtx, stopRelay := context.WithCancel(context.Background())
+	defer stopRelay()
+	relay := outbox.NewRelay(pool, newOutboxSink(cfg.Outbox, redisClient), outbox.Options{
+		BatchSize:    cfg.Outbox.BatchSize,
+		PollInterval: cfg.Outbox.PollInterval,
+		Retention:    cfg.Outbox.Retention,
+	})
+	go relay.Run(relayCtx)
+
+	// Fan committed events out to WatchArticles streams on this replica
+	var hub *outbox.Hub
+	if cfg.Watch.Enabled {
+		hub = outbox.NewHub(pool)
+		go hub.Run(relayCtx)
+	}
+
+	// Send webhook deliveries queued with article changes
+	webhookRepo := repository.NewWebhookPostgresRepository(pool)
+	if cfg.Webhook.WorkerEnabled {
+		worker := webhook.NewWorker(webhookRepo, webhook.Options{
+			MaxAttempts:  int32(cfg.Webhook.MaxAttempts),
+			BackoffBase:  cfg.Webhook.BackoffBase,
+			BackoffMax:   cfg.Webhook.BackoffMax,
+			Timeout:      cfg.Webhook.Timeout,
+			PollInterval: cfg.Webhook.PollInterval,
+			Retention:    cfg.Webhook.Retention,
+			AllowPrivate: cfg.Webhook.AllowPrivate,
+		})
+		go worker.Run(relayCtx)
+	}
+
+	// Count GetArticle views in the background: deduplicated in Redis (in memory while Redis is
+	// down) and flushed to PostgreSQL in batches
+	viewRepo := repository.NewViewPostgresRepository(pool)
+	var viewCounter *views.Counter
+	viewsStopped := make(chan struct{})
+	if cfg.Views.Enabled {
+		dedup := views.NewFallbackDeduper(
+			views.NewRedisDeduper(redisClient, cfg.Views.DedupWindow),
+			views.NewMemoryDeduper(cfg.Views.DedupWindow),
+		)
+		viewCounter = views.NewCounter(dedup, viewRepo, views.Options{
+			Window:        cfg.Views.DedupWindow,
+			FlushInterval: cfg.Views.FlushInterval,
+			BufferSize:    cfg.Views.BufferSize,
+			Retention:     cfg.Views.Retention,
+		})
+		go func() {
+			viewCounter.Run(relayCtx)
+			close(viewsStopped)
+		}()
+	} else {
+		close(viewsStopped)
+	}
+
 	// 5. Create gRPC client to User Service (inter-service communication)
-	userClient, err := client.NewUserClient(cfg.UserServiceAddr)
+	userClient := newUserClient(cfg)
+
+	// 6. Setup gRPC server with interceptors
+	// Order: request ID -> panic recovery -> deadline -> rate limit
+	methodTimeouts, err := interceptor.ParseTimeouts(cfg.RequestTimeoutRules)
 	if err != nil {
-		log.Fatalf("Failed to connect to user service: %v", err)
+		log.Fatalf("Invalid request timeout configuration: %v", err)
+	}
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		interceptor.RequestIDUnaryInterceptor(),
+		interceptor.RecoveryUnaryInterceptor(),
+		interceptor.DeadlineUnaryInterceptor(cfg.RequestTimeout, methodTimeouts),
 	}
-	log.Printf("Connected to User Service at %s", cfg.UserServiceAddr)
 
-	// 6. Setup gRPC server
-	grpcServer := grpc.NewServer()
-	articleServer := server.NewArticleServer(articleRepo, userClient, redisClient, cfg.JWTSecret)
+	// Streaming RPCs (ExportArticles, ImportArticles, WatchArticles) get no deadline, as a long export,
+	// import or watch cannot fit a unary one; ExportArticles stops itself after EXPORT_TIMEOUT
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		interceptor.RequestIDStreamInterceptor(),
+		interceptor.RecoveryStreamInterceptor(),
+	}
+	if cfg.RateLimit.Enabled {
+		rules, err := ratelimit.ParseRules(cfg.RateLimit.Rules)
+		if err != nil {
+			log.Fatalf("Invalid rate limit configuration: %v", err)
+		}
+		// Redis sliding window shared across replicas, in-memory token bucket if Redis is down
+		limiter := ratelimit.NewFallbackLimiter(ratelimit.NewRedisLimiter(redisClient), ratelimit.NewMemoryLimiter())
+		unaryInterceptors = append(unaryInterceptors, interceptor.RateLimitUnaryInterceptor(limiter, rules, cfg.JWTSecret))
+		streamInterceptors = append(streamInterceptors, interceptor.RateLimitStreamInterceptor(limiter, rules, cfg.JWTSecret))
+		log.Printf("Rate limiting enabled: %s", cfg.RateLimit.Rules)
+	}
+
+	serverOptions := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	}
+	if cfg.TLS.Enabled {
+		serverTLS, err := tlsconfig.NewServerConfig(tlsconfig.ServerOptions{
+			CertFile:     cfg.TLS.CertFile,
+			KeyFile:      cfg.TLS.KeyFile,
+			ClientCAFile: cfg.TLS.ClientCAFile,
+		})
+		if err != nil {
+			log.Fatalf("Failed to setup server TLS: %v", err)
+		}
+		serverOptions = append(serverOptions, grpc.Creds(credentials.NewTLS(serverTLS)))
+		log.Printf("TLS enabled (mTLS: %t)", cfg.TLS.ClientCAFile != "")
+	}
+
+	grpcServer := grpc.NewServer(serverOptions...)
+	articleServer := server.NewArticleServer(articleRepo, userClient, redisClient, cfg.JWTSecret, cfg.AdminUserIDs)
+	articleServer.ConfigureExport(cfg.AnalyticsUserIDs, cfg.ExportTimeout)
+	if hub != nil {
+		articleServer.EnableWatch(hub, cfg.Outbox.Retention)
+	}
+	articleServer.EnableWebhooks(webhookRepo)
+	articleServer.EnableComments(commentRepo)
+	articleServer.EnableReactions(reactionRepo)
+	articleServer.EnableBookmarks(bookmarkRepo)
+	articleServer.EnableFollows(followRepo)
+	if viewCounter != nil {
+		articleServer.EnableViews(viewCounter, viewRepo)
+	}
 	pb.RegisterArticleServiceServer(grpcServer, articleServer)
+	// article.v2 shares the core and repository with v1
+	pbv2.RegisterArticleServiceServer(grpcServer, server.NewArticleServerV2(articleServer))
 
 	// 7. Enable reflection for tools like grpcurl
 	reflection.Register(grpcServer)
@@ -83,7 +239,55 @@ func main() {
 
 	log.Println("Shutting down gRPC server...")
 	grpcServer.GracefulStop()
+	stopRelay()
+	// Counted views not yet flushed are written on the way out
+	<-viewsStopped
 
 	<-ctx.Done()
 	log.Println("Server stopped gracefully")
 }
+
+// newUserClient connects to User Service, over TLS when configured
+func newUserClient(cfg *config.Config) *client.UserClient {
+	var userServiceCreds credentials.TransportCredentials
+	if cfg.UserServiceTLS.Enabled {
+		clientTLS, err := tlsconfig.NewClientConfig(tlsconfig.ClientOptions{
+			CAFile:     cfg.UserServiceTLS.CAFile,
+			CertFile:   cfg.UserServiceTLS.CertFile,
+			KeyFile:    cfg.UserServiceTLS.KeyFile,
+			ServerName: cfg.UserServiceTLS.ServerName,
+		})
+		if err != nil {
+			log.Fatalf("Failed to setup TLS for User Service: %v", err)
+		}
+		userServiceCreds = credentials.NewTLS(clientTLS)
+	}
+	userClient, err := client.NewUserClient(cfg.UserServiceAddr, userServiceCreds)
+	if err != nil {
+		log.Fatalf("Failed to connect to user service: %v", err)
+	}
+	log.Printf("Connected to User Service at %s", cfg.UserServiceAddr)
+	return userClient
+}
+
+// newOutboxSink returns the configured event sink, or nil when events are not published
+func newOutboxSink(cfg config.OutboxConfig, redisClient *db.RedisClient) outbox.Sink {
+	switch cfg.Sink {
+	case "":
+		return nil
+	case "redis":
+		log.Printf("Publishing article events to Redis stream %s", cfg.RedisStream)
+		return outbox.NewRedisStreamSink(redisClient, cfg.RedisStream, cfg.RedisMaxLen)
+	case "webhook":
+		if cfg.WebhookURL == "" {
+			log.Fatal("OUTBOX_WEBHOOK_URL is required when OUTBOX_SINK=webhook")
+		}
+		log.Printf("Publishing article events to webhook %s", cfg.WebhookURL)
+		return outbox.NewWebhookSink(cfg.WebhookURL, cfg.WebhookTimeout)
+	case "stdout":
+		return outbox.NewWriterSink(os.Stdout)
+	default:
+		log.Fatalf("Invalid OUTBOX_SINK %q: want redis, webhook or stdout", cfg.Sink)
+		return nil
+	}
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/thatlq1812/service-2-article/internal/config"
+	"github.com/thatlq1812/service-2-article/internal/db"
+	"github.com/thatlq1812/service-2-article/internal/migrate"
+	"github.com/thatlq1812/service-2-article/migrations"
+)
+
+const migrateUsage = "usage: article-service migrate up | down [steps] | status"
+
+// runMigrate handles the "migrate" subcommand
+func runMigrate(cfg *config.Config, args []string) {
+	if len(args) == 0 {
+		log.Fatal(migrateUsage)
+	}
+
+	pool, err := db.NewPostgresPool(cfg.DB)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer pool.Close()
+
+	runner, err := migrate.NewRunner(pool, migrations.FS)
+	if err != nil {
+		log.Fatalf("Failed to load migrations: %v", err)
+	}
+
+	ctx := context.Background()
+
+	switch args[0] {
+	case "up":
+		if err := runner.Up(ctx); err != nil {
+			log.Fatalf("Migration failed: %v", err)
+		}
+
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			steps, err = strconv.Atoi(args[1])
+			if err != nil || steps < 1 {
+				log.Fatalf("Invalid steps %q: must be a positive number", args[1])
+			}
+		}
+		if err := runner.Down(ctx, steps); err != nil {
+			log.Fatalf("Migration failed: %v", err)
+		}
+
+	case "status":
+		statuses, err := runner.Status(ctx)
+		if err != nil {
+			log.Fatalf("Failed to get migration status: %v", err)
+		}
+		for _, s := range statuses {
+			state := "pending"
+			if s.Applied {
+				state = "applied at " + s.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Fprintf(os.Stdout, "%03d_%s\t%s\n", s.Version, s.Name, state)
+		}
+
+	default:
+		log.Fatal(migrateUsage)
+	}
+}
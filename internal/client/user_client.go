@@ -7,6 +7,7 @@ import (
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
 
@@ -30,16 +31,21 @@ type UserClient struct {
 
 // NewUserClient creates a new gRPC client connection to User Service
 // Blocks until connection is established or timeout occurs
-func NewUserClient(address string) (*UserClient, error) {
+// Pass nil creds to connect in plaintext
+func NewUserClient(address string, creds credentials.TransportCredentials) (*UserClient, error) {
 	log.Printf("[UserClient] Connecting to user service at %s", address)
 
+	if creds == nil {
+		creds = insecure.NewCredentials()
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), connectionTimeout)
 	defer cancel()
 
 	conn, err := grpc.DialContext(
 		ctx,
 		address,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(creds),
 		grpc.WithBlock(),
 	)
 	if err != nil {
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/thatlq1812/agrios-shared/pkg/common"
@@ -13,9 +15,32 @@ type Config struct {
 	ShutdownTimeout time.Duration
 	UserServiceAddr string
 
-	DB        db.Config
+	// Maximum handler timeout, applied when the client sets none or a longer one
+	RequestTimeout      time.Duration
+	RequestTimeoutRules string // per-method overrides, e.g. "ListArticles=5s"
+
+	DB          db.Config
+	AutoMigrate bool // apply pending migrations on startup
+
 	Redis     RedisConfig
+	Cache     CacheConfig
 	JWTSecret string
+	RateLimit RateLimitConfig
+
+	Outbox  OutboxConfig
+	Watch   WatchConfig
+	Webhook WebhookConfig
+	Views   ViewsConfig
+
+	// Users allowed to import articles on behalf of other authors, with their original timestamps
+	AdminUserIDs []int32
+
+	// Users allowed to export articles besides admins, and the longest an export may run
+	AnalyticsUserIDs []int32
+	ExportTimeout    time.Duration
+
+	TLS            TLSConfig
+	UserServiceTLS UserServiceTLSConfig
 }
 
 // RedisConfig holds Redis connection settings
@@ -25,6 +50,73 @@ type RedisConfig struct {
 	DB       int
 }
 
+// CacheConfig holds settings for the Redis article cache
+type CacheConfig struct {
+	Enabled bool
+	TTL     time.Duration
+}
+
+// RateLimitConfig holds per-method rate limiting settings
+type RateLimitConfig struct {
+	Enabled bool
+	Rules   string // e.g. "CreateArticle=20/1m,UpdateArticle=60/1m"
+}
+
+// OutboxConfig holds settings for the article event relay
+type OutboxConfig struct {
+	Sink           string // "redis", "webhook", "stdout" or "" (events are stored but not published)
+	RedisStream    string
+	RedisMaxLen    int64 // approximate stream length cap, 0 for none
+	WebhookURL     string
+	WebhookTimeout time.Duration
+	BatchSize      int
+	PollInterval   time.Duration
+	Retention      time.Duration // how long published events are kept
+}
+
+// WatchConfig holds settings for the WatchArticles change feed
+type WatchConfig struct {
+	Enabled bool // one extra database connection per replica LISTENs for events
+}
+
+// WebhookConfig holds settings for the webhook delivery worker
+type WebhookConfig struct {
+	WorkerEnabled bool // deliveries are still queued when false, e.g. to run the worker elsewhere
+	MaxAttempts   int
+	BackoffBase   time.Duration
+	BackoffMax    time.Duration
+	Timeout       time.Duration
+	PollInterval  time.Duration
+	Retention     time.Duration // how long delivered and dead deliveries are kept
+	AllowPrivate  bool          // allow loopback and private addresses (local development only)
+}
+
+// ViewsConfig holds settings for view counting and trending articles
+type ViewsConfig struct {
+	Enabled       bool
+	DedupWindow   time.Duration // a viewer counts once per article per window
+	FlushInterval time.Duration // how often counted views are written to the database
+	BufferSize    int           // views waiting to be counted; further views are dropped
+	Retention     time.Duration // how long hourly counts are kept; at least 7 days for the WEEK window
+}
+
+// TLSConfig holds TLS settings for the gRPC server
+type TLSConfig struct {
+	Enabled      bool
+	CertFile     string
+	KeyFile      string
+	ClientCAFile string // when set, client certificates are required (mTLS)
+}
+
+// UserServiceTLSConfig holds TLS settings for dialing User Service
+type UserServiceTLSConfig struct {
+	Enabled    bool
+	CAFile     string
+	CertFile   string // client certificate for mTLS
+	KeyFile    string
+	ServerName string // overrides the name used to verify the server certificate
+}
+
 func Load() *Config {
 	return &Config{
 		// Server Config
@@ -32,9 +124,19 @@ func Load() *Config {
 		ShutdownTimeout: common.GetEnvDuration("SHUTDOWN_TIMEOUT", 10*time.Second),
 		UserServiceAddr: common.GetEnvString("USER_SERVICE_ADDR", "localhost:50051"),
 
+		RequestTimeout:      common.GetEnvDuration("REQUEST_TIMEOUT", 10*time.Second),
+		RequestTimeoutRules: common.GetEnvString("REQUEST_TIMEOUT_RULES", ""),
+
 		// JWT
 		JWTSecret: common.GetEnvString("JWT_SECRET", "insecure-default-secret-change-this"), // default value for Dev
 
+		// Admins, e.g. "1,2"
+		AdminUserIDs: getEnvInt32List("ADMIN_USER_IDS"),
+
+		// ExportArticles callers besides admins, e.g. "7,8", and the maximum export duration
+		AnalyticsUserIDs: getEnvInt32List("ANALYTICS_USER_IDS"),
+		ExportTimeout:    common.GetEnvDuration("EXPORT_TIMEOUT", 30*time.Minute),
+
 		// Redis Config (for token blacklist check)
 		Redis: RedisConfig{
 			Addr:     common.GetEnvString("REDIS_ADDR", "localhost:6379"),
@@ -42,6 +144,71 @@ func Load() *Config {
 			DB:       common.GetEnvInt("REDIS_DB", 0),
 		},
 
+		// Cache Config (Redis read-through cache for GetArticle)
+		Cache: CacheConfig{
+			Enabled: getEnvBool("CACHE_ENABLED", false),
+			TTL:     common.GetEnvDuration("CACHE_TTL", 5*time.Minute),
+		},
+
+		// Rate Limit Config (write RPCs and exports)
+		RateLimit: RateLimitConfig{
+			Enabled: getEnvBool("RATE_LIMIT_ENABLED", true),
+			Rules:   common.GetEnvString("RATE_LIMIT_RULES", "CreateArticle=20/1m,UpdateArticle=60/1m,DeleteArticle=60/1m,ExportArticles=10/1h,ImportArticles=10/1h,CreateWebhook=10/1h,CreateComment=30/1m,AddReaction=120/1m,BookmarkArticle=60/1m,FollowAuthor=60/1m"),
+		},
+
+		// Outbox Config (article events, written with every change and published by a relay)
+		Outbox: OutboxConfig{
+			Sink:           common.GetEnvString("OUTBOX_SINK", ""),
+			RedisStream:    common.GetEnvString("OUTBOX_REDIS_STREAM", "article-events"),
+			RedisMaxLen:    int64(common.GetEnvInt("OUTBOX_REDIS_MAXLEN", 100000)),
+			WebhookURL:     common.GetEnvString("OUTBOX_WEBHOOK_URL", ""),
+			WebhookTimeout: common.GetEnvDuration("OUTBOX_WEBHOOK_TIMEOUT", 10*time.Second),
+			BatchSize:      common.GetEnvInt("OUTBOX_BATCH_SIZE", 100),
+			PollInterval:   common.GetEnvDuration("OUTBOX_POLL_INTERVAL", time.Second),
+			Retention:      common.GetEnvDuration("OUTBOX_RETENTION", 7*24*time.Hour),
+		},
+
+		// Watch Config (WatchArticles change feed)
+		Watch: WatchConfig{
+			Enabled: getEnvBool("WATCH_ENABLED", true),
+		},
+
+		// Webhook Config (signed deliveries to subscriber URLs)
+		Webhook: WebhookConfig{
+			WorkerEnabled: getEnvBool("WEBHOOK_WORKER_ENABLED", true),
+			MaxAttempts:   common.GetEnvInt("WEBHOOK_MAX_ATTEMPTS", 8),
+			BackoffBase:   common.GetEnvDuration("WEBHOOK_BACKOFF_BASE", 30*time.Second),
+			BackoffMax:    common.GetEnvDuration("WEBHOOK_BACKOFF_MAX", time.Hour),
+			Timeout:       common.GetEnvDuration("WEBHOOK_TIMEOUT", 10*time.Second),
+			PollInterval:  common.GetEnvDuration("WEBHOOK_POLL_INTERVAL", 2*time.Second),
+			Retention:     common.GetEnvDuration("WEBHOOK_RETENTION", 30*24*time.Hour),
+			AllowPrivate:  getEnvBool("WEBHOOK_ALLOW_PRIVATE", false),
+		},
+
+		// Views Config (GetArticle views, deduplicated in Redis and flushed in batches)
+		Views: ViewsConfig{
+			Enabled:       getEnvBool("VIEWS_ENABLED", true),
+			DedupWindow:   common.GetEnvDuration("VIEWS_DEDUP_WINDOW", 30*time.Minute),
+			FlushInterval: common.GetEnvDuration("VIEWS_FLUSH_INTERVAL", 10*time.Second),
+			BufferSize:    common.GetEnvInt("VIEWS_BUFFER_SIZE", 10000),
+			Retention:     common.GetEnvDuration("VIEWS_RETENTION", 8*24*time.Hour),
+		},
+
+		// TLS Config (certificates are reloaded from disk on change)
+		TLS: TLSConfig{
+			Enabled:      getEnvBool("TLS_ENABLED", false),
+			CertFile:     common.GetEnvString("TLS_CERT_FILE", ""),
+			KeyFile:      common.GetEnvString("TLS_KEY_FILE", ""),
+			ClientCAFile: common.GetEnvString("TLS_CLIENT_CA_FILE", ""),
+		},
+		UserServiceTLS: UserServiceTLSConfig{
+			Enabled:    getEnvBool("USER_SERVICE_TLS_ENABLED", false),
+			CAFile:     common.GetEnvString("USER_SERVICE_TLS_CA_FILE", ""),
+			CertFile:   common.GetEnvString("USER_SERVICE_TLS_CERT_FILE", ""),
+			KeyFile:    common.GetEnvString("USER_SERVICE_TLS_KEY_FILE", ""),
+			ServerName: common.GetEnvString("USER_SERVICE_TLS_SERVER_NAME", ""),
+		},
+
 		// Database Config
 		DB: db.Config{
 			Host:     common.GetEnvString("DB_HOST", "localhost"),
@@ -56,5 +223,27 @@ func Load() *Config {
 			MaxConnIdleTime: common.GetEnvDuration("DB_MAX_CONN_IDLE_TIME", 30*time.Minute),
 			ConnectTimeout:  common.GetEnvDuration("DB_CONNECT_TIMEOUT", 5*time.Second),
 		},
+		AutoMigrate: getEnvBool("AUTO_MIGRATE", false),
+	}
+}
+
+// getEnvBool reads a boolean environment variable, falling back to defaultValue when unset or invalid
+func getEnvBool(key string, defaultValue bool) bool {
+	value, err := strconv.ParseBool(common.GetEnvString(key, strconv.FormatBool(defaultValue)))
+	if err != nil {
+		return defaultValue
+	}
+	return value
+}
+
+// getEnvInt32List reads a comma-separated list of IDs, skipping entries that are not positive numbers
+func getEnvInt32List(key string) []int32 {
+	var ids []int32
+	for _, part := range strings.Split(common.GetEnvString(key, ""), ",") {
+		id, err := strconv.ParseInt(strings.TrimSpace(part), 10, 32)
+		if err == nil && id > 0 {
+			ids = append(ids, int32(id))
+		}
 	}
+	return ids
 }
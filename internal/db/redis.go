@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"math/rand"
 	"time"
 
 	"github.com/redis/go-redis/v9"
@@ -11,6 +12,7 @@ import (
 
 type RedisClient struct {
 	client *redis.Client
+	now    func() time.Time // replaced in tests
 }
 
 // NewRedisClient creates a new Redis client
@@ -32,7 +34,7 @@ func NewRedisClient(addr, password string, db int) (*RedisClient, error) {
 	}
 
 	log.Printf("[Redis] Successfully connected to Redis")
-	return &RedisClient{client: client}, nil
+	return &RedisClient{client: client, now: time.Now}, nil
 }
 
 // IsTokenBlacklisted checks if a token is in the blacklist
@@ -54,6 +56,101 @@ func (r *RedisClient) IsTokenBlacklisted(ctx context.Context, token string) (boo
 	return false, nil
 }
 
+// slidingWindowScript atomically trims expired entries, counts the remaining ones
+// and records the new request only when it fits within the limit.
+// Returns {allowed, retry_after_ms}.
+var slidingWindowScript = redis.NewScript(`
+local key = KEYS[1]
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local limit = tonumber(ARGV[3])
+
+redis.call('ZREMRANGEBYSCORE', key, 0, now - window)
+local count = redis.call('ZCARD', key)
+if count < limit then
+	redis.call('ZADD', key, now, ARGV[4])
+	redis.call('PEXPIRE', key, window)
+	return {1, 0}
+end
+
+local oldest = redis.call('ZRANGE', key, 0, 0, 'WITHSCORES')
+local retry = window
+if oldest[2] then
+	retry = window - (now - tonumber(oldest[2]))
+end
+return {0, retry}
+`)
+
+// AllowSlidingWindow records a request under key and reports whether it fits
+// within limit requests per window. Shared across replicas since state lives in Redis.
+func (r *RedisClient) AllowSlidingWindow(ctx context.Context, key string, limit int, window time.Duration) (bool, time.Duration, error) {
+	now := r.now()
+	member := fmt.Sprintf("%d-%d", now.UnixNano(), rand.Int63())
+
+	result, err := slidingWindowScript.Run(ctx, r.client, []string{key},
+		now.UnixMilli(), window.Milliseconds(), limit, member).Int64Slice()
+	if err != nil {
+		return false, 0, fmt.Errorf("sliding window check failed: %w", err)
+	}
+
+	allowed := result[0] == 1
+	retryAfter := time.Duration(result[1]) * time.Millisecond
+	return allowed, retryAfter, nil
+}
+
+// Get returns the value stored at key; found is false when the key does not exist
+func (r *RedisClient) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	value, err := r.client.Get(ctx, key).Bytes()
+	if err == redis.Nil {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return value, true, nil
+}
+
+// Set stores value at key with the given TTL
+func (r *RedisClient) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return r.client.Set(ctx, key, value, ttl).Err()
+}
+
+// Delete removes the given keys
+func (r *RedisClient) Delete(ctx context.Context, keys ...string) error {
+	return r.client.Del(ctx, keys...).Err()
+}
+
+// AddToStream appends an entry to stream, trimming it to about maxLen entries when maxLen > 0
+func (r *RedisClient) AddToStream(ctx context.Context, stream string, maxLen int64, values map[string]interface{}) error {
+	return r.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: stream,
+		MaxLen: maxLen,
+		Approx: true,
+		Values: values,
+	}).Err()
+}
+
+// AddToHyperLogLogs adds elements[i] to the HyperLogLog at keys[i] in one round trip and refreshes
+// each key's TTL. added[i] reports whether the HyperLogLog changed, which almost always means
+// the element was new.
+func (r *RedisClient) AddToHyperLogLogs(ctx context.Context, keys, elements []string, ttl time.Duration) ([]bool, error) {
+	pipe := r.client.Pipeline()
+	adds := make([]*redis.IntCmd, len(keys))
+	for i, key := range keys {
+		adds[i] = pipe.PFAdd(ctx, key, elements[i])
+		pipe.Expire(ctx, key, ttl)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	added := make([]bool, len(keys))
+	for i, cmd := range adds {
+		added[i] = cmd.Val() == 1
+	}
+	return added, nil
+}
+
 // Close closes the Redis connection
 func (r *RedisClient) Close() error {
 	if r.client != nil {
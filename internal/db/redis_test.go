@@ -0,0 +1,75 @@
+//go:build integration
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// The sliding window script runs against a real Redis; the clock it is given is faked
+// so the window can be stepped through without sleeping.
+
+func openTestRedis(t *testing.T, now func() time.Time) *RedisClient {
+	t.Helper()
+
+	addr := os.Getenv("TEST_REDIS_ADDR")
+	if addr == "" {
+		addr = "localhost:6379"
+	}
+
+	client := redis.NewClient(&redis.Options{Addr: addr})
+	t.Cleanup(func() { _ = client.Close() })
+
+	if err := client.Ping(context.Background()).Err(); err != nil {
+		t.Skipf("Redis not available at %s: %v", addr, err)
+	}
+	return &RedisClient{client: client, now: now}
+}
+
+func TestAllowSlidingWindow(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	r := openTestRedis(t, func() time.Time { return now })
+
+	key := fmt.Sprintf("ratelimit:test:%d", now.UnixNano())
+	t.Cleanup(func() { _ = r.Delete(ctx, key) })
+
+	allow := func() (bool, time.Duration) {
+		t.Helper()
+		allowed, retryAfter, err := r.AllowSlidingWindow(ctx, key, 2, 10*time.Second)
+		if err != nil {
+			t.Fatalf("AllowSlidingWindow error = %v", err)
+		}
+		return allowed, retryAfter
+	}
+
+	if allowed, _ := allow(); !allowed {
+		t.Fatal("first request denied, want allowed")
+	}
+	now = now.Add(4 * time.Second)
+	if allowed, _ := allow(); !allowed {
+		t.Fatal("second request denied, want allowed")
+	}
+
+	// The oldest request leaves the window 6s later
+	now = now.Add(time.Second)
+	allowed, retryAfter := allow()
+	if allowed || retryAfter != 5*time.Second {
+		t.Errorf("third request = (%v, %v), want denied with RetryAfter 5s", allowed, retryAfter)
+	}
+
+	// Denied requests are not recorded, so the window slides past the first one
+	now = now.Add(5 * time.Second)
+	if allowed, _ := allow(); !allowed {
+		t.Error("request after the oldest expired denied, want allowed")
+	}
+	if allowed, _ := allow(); allowed {
+		t.Error("request over the limit allowed, want denied")
+	}
+}
@@ -0,0 +1,77 @@
+package importer
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	pb "github.com/thatlq1812/service-2-article/proto"
+)
+
+// csvReader reads records whose first row names the columns
+type csvReader struct {
+	reader *csv.Reader
+	closer io.Closer
+	name   string
+	header []string
+}
+
+// NewCSVReader reads CSV with a header row from r; name prefixes the row sources
+func NewCSVReader(r io.Reader, name string) Reader {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1 // checked per record so one bad row does not stop the import
+	closer, _ := r.(io.Closer)
+	return &csvReader{reader: reader, closer: closer, name: name}
+}
+
+func (r *csvReader) Next() (*pb.ImportedArticle, error) {
+	if r.header == nil {
+		header, err := r.reader.Read()
+		if errors.Is(err, io.EOF) {
+			return nil, io.EOF
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: read header: %w", r.name, err)
+		}
+		for i, column := range header {
+			header[i] = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(column, "\uFEFF")))
+		}
+		r.header = header
+	}
+
+	values, err := r.reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, io.EOF
+	}
+	line, _ := r.reader.FieldPos(0)
+	source := fmt.Sprintf("%s:%d", r.name, line)
+	if err != nil {
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			return nil, &RowError{Source: fmt.Sprintf("%s:%d", r.name, parseErr.StartLine), Err: parseErr.Err}
+		}
+		return nil, fmt.Errorf("%s: %w", r.name, err)
+	}
+	if len(values) != len(r.header) {
+		return nil, &RowError{Source: source, Err: fmt.Errorf("has %d fields, header has %d", len(values), len(r.header))}
+	}
+
+	record := make(map[string]string, len(values))
+	for i, value := range values {
+		record[r.header[i]] = value
+	}
+	article, err := fromRecord(source, record)
+	if err != nil {
+		return nil, &RowError{Source: source, Err: err}
+	}
+	return article, nil
+}
+
+func (r *csvReader) Close() error {
+	if r.closer == nil {
+		return nil
+	}
+	return r.closer.Close()
+}
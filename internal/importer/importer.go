@@ -0,0 +1,194 @@
+// Package importer reads articles for bulk import from JSON Lines, CSV
+// or a directory of Markdown files with YAML front matter.
+//
+// Every format uses the same record keys:
+//
+//	title, content, format (plain|markdown|html), author_id, created_at, updated_at (RFC3339)
+//
+// Unknown keys are ignored so exports from other systems can be imported as-is.
+package importer
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/thatlq1812/service-2-article/proto"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Supported formats
+const (
+	FormatJSONL    = "jsonl"
+	FormatCSV      = "csv"
+	FormatMarkdown = "markdown"
+)
+
+// Reader returns one article per Next call and io.EOF after the last one.
+// A *RowError means that record was malformed; reading can continue with the next one.
+type Reader interface {
+	Next() (*pb.ImportedArticle, error)
+	Close() error
+}
+
+// RowError reports a record that could not be parsed
+type RowError struct {
+	Source string // e.g. "posts.csv:12"
+	Err    error
+}
+
+func (e *RowError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Source, e.Err)
+}
+
+func (e *RowError) Unwrap() error {
+	return e.Err
+}
+
+// Open returns a Reader for path. An empty format is detected from the path:
+// directories are Markdown, ".csv" is CSV and ".jsonl" / ".ndjson" are JSON Lines.
+func Open(path, format string) (Reader, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if format == "" {
+		format, err = detectFormat(path, info.IsDir())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch format {
+	case FormatMarkdown:
+		if !info.IsDir() {
+			return nil, fmt.Errorf("%s: markdown import expects a directory", path)
+		}
+		return NewMarkdownReader(path)
+	case FormatJSONL, FormatCSV:
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		name := filepath.Base(path)
+		if format == FormatCSV {
+			return NewCSVReader(file, name), nil
+		}
+		return NewJSONLReader(file, name), nil
+	default:
+		return nil, fmt.Errorf("unsupported format %q (want %s, %s or %s)", format, FormatJSONL, FormatCSV, FormatMarkdown)
+	}
+}
+
+func detectFormat(path string, isDir bool) (string, error) {
+	if isDir {
+		return FormatMarkdown, nil
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return FormatCSV, nil
+	case ".jsonl", ".ndjson":
+		return FormatJSONL, nil
+	}
+	return "", fmt.Errorf("%s: cannot detect the format, pass it explicitly", path)
+}
+
+// contentFormats maps the accepted "format" values
+var contentFormats = map[string]pb.ContentFormat{
+	"":         pb.ContentFormat_CONTENT_FORMAT_PLAIN,
+	"plain":    pb.ContentFormat_CONTENT_FORMAT_PLAIN,
+	"text":     pb.ContentFormat_CONTENT_FORMAT_PLAIN,
+	"markdown": pb.ContentFormat_CONTENT_FORMAT_MARKDOWN,
+	"md":       pb.ContentFormat_CONTENT_FORMAT_MARKDOWN,
+	"html":     pb.ContentFormat_CONTENT_FORMAT_HTML,
+}
+
+// fromRecord builds an article from record keys; values have already been converted to strings
+func fromRecord(source string, record map[string]string) (*pb.ImportedArticle, error) {
+	article := &pb.ImportedArticle{
+		Title:   record["title"],
+		Content: record["content"],
+		Source:  source,
+	}
+
+	format, ok := contentFormats[strings.ToLower(strings.TrimSpace(record["format"]))]
+	if !ok {
+		return nil, fmt.Errorf("unknown format %q", record["format"])
+	}
+	article.ContentFormat = format
+
+	if value := strings.TrimSpace(record["author_id"]); value != "" {
+		id, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid author_id %q", value)
+		}
+		article.UserId = int32(id)
+	}
+
+	var err error
+	if article.CreateTime, err = parseTime(record, "created_at"); err != nil {
+		return nil, err
+	}
+	if article.UpdateTime, err = parseTime(record, "updated_at"); err != nil {
+		return nil, err
+	}
+	return article, nil
+}
+
+// parseTime parses an optional RFC3339 value
+func parseTime(record map[string]string, key string) (*timestamppb.Timestamp, error) {
+	value := strings.TrimSpace(record[key])
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s %q: want RFC3339, e.g. 2006-01-02T15:04:05Z", key, value)
+	}
+	return timestamppb.New(t), nil
+}
+
+// stringValue converts a decoded JSON or YAML scalar to its string form
+func stringValue(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case time.Time:
+		// YAML decodes unquoted timestamps
+		return v.Format(time.RFC3339Nano), nil
+	case fmt.Stringer:
+		return v.String(), nil
+	case int, int64, uint64, float64, bool:
+		return fmt.Sprint(v), nil
+	default:
+		return "", errors.New("must be a scalar value")
+	}
+}
+
+// stringRecord converts decoded values, ignoring keys fromRecord does not read
+func stringRecord(values map[string]interface{}) (map[string]string, error) {
+	record := make(map[string]string, len(values))
+	for key, value := range values {
+		s, err := stringValue(value)
+		if err != nil {
+			if _, known := recordKeys[key]; known {
+				return nil, fmt.Errorf("%s %w", key, err)
+			}
+			continue
+		}
+		record[key] = s
+	}
+	return record, nil
+}
+
+// recordKeys are the keys fromRecord reads
+var recordKeys = map[string]struct{}{
+	"title": {}, "content": {}, "format": {}, "author_id": {}, "created_at": {}, "updated_at": {},
+}
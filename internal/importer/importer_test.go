@@ -0,0 +1,121 @@
+package importer_test
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/thatlq1812/service-2-article/internal/importer"
+	pb "github.com/thatlq1812/service-2-article/proto"
+)
+
+// readAll returns the articles of r and the sources of malformed records
+func readAll(t *testing.T, r importer.Reader) ([]*pb.ImportedArticle, []string) {
+	t.Helper()
+	defer r.Close()
+
+	var articles []*pb.ImportedArticle
+	var failed []string
+	for {
+		article, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			return articles, failed
+		}
+		var rowErr *importer.RowError
+		if errors.As(err, &rowErr) {
+			failed = append(failed, rowErr.Source)
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Next failed: %v", err)
+		}
+		articles = append(articles, article)
+	}
+}
+
+func TestJSONLReader(t *testing.T) {
+	input := `{"title": "One", "content": "body", "format": "markdown", "author_id": 7, "created_at": "2020-01-02T03:04:05Z", "tags": ["ignored"]}
+
+{"title": "Two", "content": "body", "author_id": "x"}
+not json
+{"title": "Three", "content": "body"}
+`
+	articles, failed := readAll(t, importer.NewJSONLReader(strings.NewReader(input), "posts.jsonl"))
+
+	if len(articles) != 2 || articles[0].Title != "One" || articles[1].Title != "Three" {
+		t.Fatalf("articles = %v, want One and Three", articles)
+	}
+	first := articles[0]
+	if first.UserId != 7 || first.ContentFormat != pb.ContentFormat_CONTENT_FORMAT_MARKDOWN ||
+		first.CreateTime.AsTime().Year() != 2020 || first.Source != "posts.jsonl:1" {
+		t.Errorf("first article = %+v, want author 7, markdown, created 2020, source posts.jsonl:1", first)
+	}
+	if len(failed) != 2 || failed[0] != "posts.jsonl:3" || failed[1] != "posts.jsonl:4" {
+		t.Errorf("failed = %v, want [posts.jsonl:3 posts.jsonl:4]", failed)
+	}
+}
+
+func TestCSVReader(t *testing.T) {
+	input := "Title,content,format,author_id\n" +
+		"One,\"multi\nline\",html,3\n" +
+		"Two,body\n" +
+		"Three,body,,\n"
+	articles, failed := readAll(t, importer.NewCSVReader(strings.NewReader(input), "posts.csv"))
+
+	if len(articles) != 2 || articles[0].Content != "multi\nline" || articles[1].Title != "Three" {
+		t.Fatalf("articles = %v, want One and Three", articles)
+	}
+	if articles[0].UserId != 3 || articles[0].ContentFormat != pb.ContentFormat_CONTENT_FORMAT_HTML || articles[0].Source != "posts.csv:2" {
+		t.Errorf("first article = %+v, want author 3, html, source posts.csv:2", articles[0])
+	}
+	if articles[1].ContentFormat != pb.ContentFormat_CONTENT_FORMAT_PLAIN {
+		t.Errorf("format = %v, want plain by default", articles[1].ContentFormat)
+	}
+	if len(failed) != 1 || failed[0] != "posts.csv:4" {
+		t.Errorf("failed = %v, want [posts.csv:4]", failed)
+	}
+}
+
+func TestMarkdownReader(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"a.md":        "---\ntitle: First\nauthor_id: 5\ncreated_at: 2021-06-01T10:00:00Z\n---\n\n# Heading\n",
+		"b/c.md":      "---\r\ntitle: \"Second\"\r\nformat: plain\r\n---\r\nText\r\n",
+		"b/notes.txt": "not an article",
+		"d.md":        "# No front matter\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	r, err := importer.Open(dir, "")
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	articles, failed := readAll(t, r)
+
+	if len(articles) != 2 {
+		t.Fatalf("articles = %v, want 2", articles)
+	}
+	first, second := articles[0], articles[1]
+	if first.Title != "First" || first.Content != "# Heading" || first.UserId != 5 ||
+		first.ContentFormat != pb.ContentFormat_CONTENT_FORMAT_MARKDOWN || first.CreateTime.AsTime().Month() != 6 {
+		t.Errorf("first article = %+v, want markdown by default and front matter fields", first)
+	}
+	if second.Title != "Second" || second.Content != "Text" || second.ContentFormat != pb.ContentFormat_CONTENT_FORMAT_PLAIN ||
+		second.Source != filepath.Join("b", "c.md") {
+		t.Errorf("second article = %+v, want plain text from b/c.md", second)
+	}
+	if len(failed) != 1 || failed[0] != "d.md" {
+		t.Errorf("failed = %v, want [d.md]", failed)
+	}
+}
@@ -0,0 +1,68 @@
+package importer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	pb "github.com/thatlq1812/service-2-article/proto"
+)
+
+// maxLineSize bounds one JSON Lines record (content is limited to 100,000 characters)
+const maxLineSize = 4 << 20
+
+// jsonlReader reads one JSON object per line; blank lines are skipped
+type jsonlReader struct {
+	scanner *bufio.Scanner
+	closer  io.Closer
+	name    string
+	line    int
+}
+
+// NewJSONLReader reads JSON Lines from r; name prefixes the row sources
+func NewJSONLReader(r io.Reader, name string) Reader {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64<<10), maxLineSize)
+	closer, _ := r.(io.Closer)
+	return &jsonlReader{scanner: scanner, closer: closer, name: name}
+}
+
+func (r *jsonlReader) Next() (*pb.ImportedArticle, error) {
+	for r.scanner.Scan() {
+		r.line++
+		line := bytes.TrimSpace(r.scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		source := fmt.Sprintf("%s:%d", r.name, r.line)
+
+		decoder := json.NewDecoder(bytes.NewReader(line))
+		decoder.UseNumber()
+		var values map[string]interface{}
+		if err := decoder.Decode(&values); err != nil {
+			return nil, &RowError{Source: source, Err: fmt.Errorf("invalid JSON: %w", err)}
+		}
+		record, err := stringRecord(values)
+		if err != nil {
+			return nil, &RowError{Source: source, Err: err}
+		}
+		article, err := fromRecord(source, record)
+		if err != nil {
+			return nil, &RowError{Source: source, Err: err}
+		}
+		return article, nil
+	}
+	if err := r.scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%s:%d: %w", r.name, r.line+1, err)
+	}
+	return nil, io.EOF
+}
+
+func (r *jsonlReader) Close() error {
+	if r.closer == nil {
+		return nil
+	}
+	return r.closer.Close()
+}
@@ -0,0 +1,103 @@
+package importer
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	pb "github.com/thatlq1812/service-2-article/proto"
+
+	"gopkg.in/yaml.v3"
+)
+
+// markdownReader reads every .md / .markdown file under a directory, in path order.
+// Keys come from the YAML front matter; the body is the content, in Markdown unless
+// the front matter sets another format.
+type markdownReader struct {
+	root  string
+	files []string
+}
+
+// NewMarkdownReader lists the Markdown files under dir
+func NewMarkdownReader(dir string) (Reader, error) {
+	var files []string
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".md", ".markdown":
+			if !d.IsDir() {
+				files = append(files, path)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &markdownReader{root: dir, files: files}, nil
+}
+
+func (r *markdownReader) Next() (*pb.ImportedArticle, error) {
+	if len(r.files) == 0 {
+		return nil, io.EOF
+	}
+	path := r.files[0]
+	r.files = r.files[1:]
+
+	source, err := filepath.Rel(r.root, path)
+	if err != nil {
+		source = path
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, &RowError{Source: source, Err: err}
+	}
+	article, err := parseMarkdown(source, data)
+	if err != nil {
+		return nil, &RowError{Source: source, Err: err}
+	}
+	return article, nil
+}
+
+func (r *markdownReader) Close() error {
+	return nil
+}
+
+// parseMarkdown splits a "---" delimited YAML front matter from the body
+func parseMarkdown(source string, data []byte) (*pb.ImportedArticle, error) {
+	data = bytes.TrimPrefix(data, []byte("\uFEFF"))
+	data = bytes.ReplaceAll(data, []byte("\r\n"), []byte("\n"))
+
+	rest, ok := bytes.CutPrefix(data, []byte("---\n"))
+	if !ok {
+		return nil, errors.New("missing YAML front matter")
+	}
+	frontMatter, body, ok := bytes.Cut(rest, []byte("\n---\n"))
+	if !ok {
+		// Front matter closed at the end of the file: no body
+		if frontMatter, ok = bytes.CutSuffix(rest, []byte("\n---")); !ok {
+			return nil, errors.New("unterminated YAML front matter")
+		}
+	}
+
+	var values map[string]interface{}
+	if err := yaml.Unmarshal(frontMatter, &values); err != nil {
+		return nil, fmt.Errorf("invalid front matter: %w", err)
+	}
+	record, err := stringRecord(values)
+	if err != nil {
+		return nil, err
+	}
+	record["content"] = strings.TrimSpace(string(body))
+	if record["format"] == "" {
+		record["format"] = FormatMarkdown
+	}
+	return fromRecord(source, record)
+}
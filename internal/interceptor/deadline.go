@@ -0,0 +1,59 @@
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// DeadlineUnaryInterceptor caps the handler context at a maximum timeout.
+// Clients that send no deadline, or a longer one, get the per-method maximum
+// (matched by short or full method name) or defaultTimeout otherwise.
+func DeadlineUnaryInterceptor(defaultTimeout time.Duration, methodTimeouts map[string]time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		timeout, ok := methodTimeouts[info.FullMethod]
+		if !ok {
+			timeout, ok = methodTimeouts[path.Base(info.FullMethod)]
+		}
+		if !ok {
+			timeout = defaultTimeout
+		}
+		if timeout <= 0 {
+			return handler(ctx, req)
+		}
+
+		// context.WithTimeout keeps the earlier deadline if the client already set one
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		return handler(ctx, req)
+	}
+}
+
+// ParseTimeouts parses a list such as "ListArticles=5s,CreateArticle=3s"
+// into a map of method name to maximum timeout
+func ParseTimeouts(raw string) (map[string]time.Duration, error) {
+	timeouts := make(map[string]time.Duration)
+	for _, entry := range strings.Split(raw, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		method, value, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid timeout rule %q: expected method=duration", entry)
+		}
+		timeout, err := time.ParseDuration(strings.TrimSpace(value))
+		if err != nil || timeout <= 0 {
+			return nil, fmt.Errorf("invalid duration in timeout rule %q", entry)
+		}
+
+		timeouts[strings.TrimSpace(method)] = timeout
+	}
+	return timeouts, nil
+}
@@ -0,0 +1,225 @@
+package interceptor
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// fakeStream is a grpc.ServerStream that only carries a context and records headers
+type fakeStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	header metadata.MD
+}
+
+func (s *fakeStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+var unaryInfo = &grpc.UnaryServerInfo{FullMethod: "/article.ArticleService/GetArticle"}
+
+func TestRequestIDUnaryInterceptor(t *testing.T) {
+	tests := []struct {
+		name     string
+		incoming []string
+		wantKeep bool
+	}{
+		{name: "missing", wantKeep: false},
+		{name: "empty", incoming: []string{""}, wantKeep: false},
+		{name: "valid", incoming: []string{"req-1.abc_DEF"}, wantKeep: true},
+		{name: "max length", incoming: []string{strings.Repeat("a", maxRequestIDLength)}, wantKeep: true},
+		{name: "too long", incoming: []string{strings.Repeat("a", maxRequestIDLength+1)}, wantKeep: false},
+		{name: "newline", incoming: []string{"abc\nforged log line"}, wantKeep: false},
+		{name: "space", incoming: []string{"abc def"}, wantKeep: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.incoming != nil {
+				ctx = metadata.NewIncomingContext(ctx, metadata.MD{RequestIDHeader: tt.incoming})
+			}
+
+			var got, outgoing string
+			_, err := RequestIDUnaryInterceptor()(ctx, nil, unaryInfo, func(ctx context.Context, req interface{}) (interface{}, error) {
+				got = RequestIDFromContext(ctx)
+				md, _ := metadata.FromOutgoingContext(ctx)
+				if values := md.Get(RequestIDHeader); len(values) > 0 {
+					outgoing = values[0]
+				}
+				return nil, nil
+			})
+			if err != nil {
+				t.Fatalf("interceptor error = %v", err)
+			}
+
+			if tt.wantKeep {
+				if got != tt.incoming[0] {
+					t.Errorf("request ID = %q, want %q", got, tt.incoming[0])
+				}
+			} else if len(got) != 32 || !validRequestID(got) {
+				t.Errorf("request ID = %q, want a generated 32-character ID", got)
+			}
+			if outgoing != got {
+				t.Errorf("outgoing request ID = %q, want %q", outgoing, got)
+			}
+		})
+	}
+}
+
+func TestRequestIDStreamInterceptor(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIDHeader, "stream-1"))
+	stream := &fakeStream{ctx: ctx}
+
+	var got string
+	err := RequestIDStreamInterceptor()(nil, stream, &grpc.StreamServerInfo{}, func(srv interface{}, ss grpc.ServerStream) error {
+		got = RequestIDFromContext(ss.Context())
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("interceptor error = %v", err)
+	}
+	if got != "stream-1" {
+		t.Errorf("request ID = %q, want stream-1", got)
+	}
+	if echoed := stream.header.Get(RequestIDHeader); len(echoed) != 1 || echoed[0] != "stream-1" {
+		t.Errorf("response header = %v, want [stream-1]", echoed)
+	}
+}
+
+func TestRecoveryUnaryInterceptor(t *testing.T) {
+	ctx := withRequestID(context.Background(), "req-panic")
+
+	resp, err := RecoveryUnaryInterceptor()(ctx, nil, unaryInfo, func(ctx context.Context, req interface{}) (interface{}, error) {
+		panic("boom")
+	})
+	if resp != nil {
+		t.Errorf("response = %v, want nil", resp)
+	}
+	assertPanicError(t, err, "req-panic")
+}
+
+func TestRecoveryUnaryInterceptorPassesThrough(t *testing.T) {
+	resp, err := RecoveryUnaryInterceptor()(context.Background(), nil, unaryInfo, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	})
+	if err != nil || resp != "ok" {
+		t.Errorf("interceptor = (%v, %v), want (ok, nil)", resp, err)
+	}
+}
+
+func TestRecoveryStreamInterceptor(t *testing.T) {
+	stream := &fakeStream{ctx: withRequestID(context.Background(), "req-stream-panic")}
+
+	err := RecoveryStreamInterceptor()(nil, stream, &grpc.StreamServerInfo{}, func(srv interface{}, ss grpc.ServerStream) error {
+		panic("boom")
+	})
+	assertPanicError(t, err, "req-stream-panic")
+}
+
+// assertPanicError checks err is the Internal error carrying requestID in its ErrorInfo
+func assertPanicError(t *testing.T, err error, requestID string) {
+	t.Helper()
+
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.Internal {
+		t.Fatalf("error = %v, want Internal", err)
+	}
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			if got := info.GetMetadata()["request_id"]; got != requestID {
+				t.Errorf("ErrorInfo request_id = %q, want %q", got, requestID)
+			}
+			return
+		}
+	}
+	t.Errorf("error details = %v, want an ErrorInfo", st.Details())
+}
+
+func TestDeadlineUnaryInterceptor(t *testing.T) {
+	timeouts := map[string]time.Duration{
+		"GetArticle":                           2 * time.Second,
+		"/article.ArticleService/ListArticles": 3 * time.Second,
+	}
+
+	tests := []struct {
+		name           string
+		method         string
+		clientTimeout  time.Duration
+		defaultTimeout time.Duration
+		want           time.Duration // 0 means no deadline
+	}{
+		{name: "default", method: "/article.ArticleService/DeleteArticle", defaultTimeout: 5 * time.Second, want: 5 * time.Second},
+		{name: "short method name", method: "/article.ArticleService/GetArticle", defaultTimeout: 5 * time.Second, want: 2 * time.Second},
+		{name: "full method name", method: "/article.ArticleService/ListArticles", defaultTimeout: 5 * time.Second, want: 3 * time.Second},
+		{name: "earlier client deadline kept", method: "/article.ArticleService/GetArticle", clientTimeout: 500 * time.Millisecond, defaultTimeout: 5 * time.Second, want: 500 * time.Millisecond},
+		{name: "later client deadline capped", method: "/article.ArticleService/GetArticle", clientTimeout: time.Minute, defaultTimeout: 5 * time.Second, want: 2 * time.Second},
+		{name: "disabled", method: "/article.ArticleService/DeleteArticle", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.clientTimeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.clientTimeout)
+				defer cancel()
+			}
+
+			var remaining time.Duration
+			var hasDeadline bool
+			interceptor := DeadlineUnaryInterceptor(tt.defaultTimeout, timeouts)
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				var deadline time.Time
+				deadline, hasDeadline = ctx.Deadline()
+				remaining = time.Until(deadline)
+				return nil, nil
+			})
+			if err != nil {
+				t.Fatalf("interceptor error = %v", err)
+			}
+
+			if tt.want == 0 {
+				if hasDeadline {
+					t.Errorf("handler context has a deadline in %v, want none", remaining)
+				}
+				return
+			}
+			if !hasDeadline {
+				t.Fatalf("handler context has no deadline, want %v", tt.want)
+			}
+			if remaining > tt.want || remaining < tt.want-time.Second/2 {
+				t.Errorf("timeout = %v, want about %v", remaining, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTimeouts(t *testing.T) {
+	got, err := ParseTimeouts(" ListArticles=5s, CreateArticle = 3s ,")
+	if err != nil {
+		t.Fatalf("ParseTimeouts error = %v", err)
+	}
+	if len(got) != 2 || got["ListArticles"] != 5*time.Second || got["CreateArticle"] != 3*time.Second {
+		t.Errorf("ParseTimeouts = %v, want ListArticles=5s CreateArticle=3s", got)
+	}
+
+	for _, raw := range []string{"ListArticles", "ListArticles=soon", "ListArticles=0s", "ListArticles=-1s"} {
+		if _, err := ParseTimeouts(raw); err == nil {
+			t.Errorf("ParseTimeouts(%q) error = nil, want an error", raw)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"net"
+	"path"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/thatlq1812/service-2-article/internal/auth"
+	"github.com/thatlq1812/service-2-article/internal/ratelimit"
+	"github.com/thatlq1812/service-2-article/internal/response"
+)
+
+// RateLimitUnaryInterceptor enforces per-method limits keyed by authenticated user ID,
+// falling back to the peer IP for unauthenticated calls.
+// Methods are matched by short name (e.g. "CreateArticle") or full method name.
+func RateLimitUnaryInterceptor(limiter ratelimit.Limiter, rules map[string]ratelimit.Limit, jwtSecret string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := checkRateLimit(ctx, limiter, rules, jwtSecret, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// RateLimitStreamInterceptor applies the same rules to streaming RPCs; each call counts once,
+// when it is opened
+func RateLimitStreamInterceptor(limiter ratelimit.Limiter, rules map[string]ratelimit.Limit, jwtSecret string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := checkRateLimit(ss.Context(), limiter, rules, jwtSecret, info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// checkRateLimit returns a ResourceExhausted status when the caller is over the limit of fullMethod
+func checkRateLimit(ctx context.Context, limiter ratelimit.Limiter, rules map[string]ratelimit.Limit, jwtSecret, fullMethod string) error {
+	methodName := path.Base(fullMethod)
+	limit, ok := rules[fullMethod]
+	if !ok {
+		limit, ok = rules[methodName]
+	}
+	if !ok {
+		return nil
+	}
+
+	key := fmt.Sprintf("%s:%s", methodName, clientKey(ctx, jwtSecret))
+	result, err := limiter.Allow(ctx, key, limit)
+	if err != nil {
+		// Limiter failures must not block traffic
+		log.Printf("[RateLimit] ERROR: Limiter failed, allowing request: key=%s, error=%v", key, err)
+		return nil
+	}
+
+	if !result.Allowed {
+		retryAfterSeconds := int(math.Ceil(result.RetryAfter.Seconds()))
+		if retryAfterSeconds < 1 {
+			retryAfterSeconds = 1
+		}
+		_ = grpc.SetHeader(ctx, metadata.Pairs("retry-after", fmt.Sprintf("%d", retryAfterSeconds)))
+
+		log.Printf("[RateLimit] Rejected: key=%s, retry_after=%ds", key, retryAfterSeconds)
+		return response.GRPCError(codes.ResourceExhausted,
+			fmt.Sprintf("rate limit exceeded, retry after %d seconds", retryAfterSeconds),
+			response.ErrorInfo(response.ReasonRateLimited, map[string]string{"method": methodName}),
+			response.RetryInfo(time.Duration(retryAfterSeconds)*time.Second))
+	}
+	return nil
+}
+
+// clientKey identifies the caller by user ID from a valid JWT, or by peer IP otherwise
+func clientKey(ctx context.Context, jwtSecret string) string {
+	if userID, err := auth.GetUserIDFromContext(ctx, jwtSecret); err == nil {
+		return fmt.Sprintf("user:%d", userID)
+	}
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		host, _, err := net.SplitHostPort(p.Addr.String())
+		if err != nil {
+			host = p.Addr.String()
+		}
+		return "ip:" + host
+	}
+
+	return "ip:unknown"
+}
@@ -0,0 +1,50 @@
+package interceptor
+
+import (
+	"context"
+	"log"
+	"runtime/debug"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/thatlq1812/service-2-article/internal/response"
+)
+
+// RecoveryUnaryInterceptor converts a panic in a handler into an Internal error
+// so a single bad request cannot take down the whole process
+func RecoveryUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				log.Printf("[Recovery] PANIC: method=%s, request_id=%s, panic=%v\n%s",
+					info.FullMethod, RequestIDFromContext(ctx), r, debug.Stack())
+				resp = nil
+				err = panicError(ctx)
+			}
+		}()
+
+		return handler(ctx, req)
+	}
+}
+
+// RecoveryStreamInterceptor is RecoveryUnaryInterceptor for streaming RPCs
+func RecoveryStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				log.Printf("[Recovery] PANIC: method=%s, request_id=%s, panic=%v\n%s",
+					info.FullMethod, RequestIDFromContext(ss.Context()), r, debug.Stack())
+				err = panicError(ss.Context())
+			}
+		}()
+
+		return handler(srv, ss)
+	}
+}
+
+// panicError is the Internal error returned in place of a panic
+func panicError(ctx context.Context) error {
+	return response.GRPCError(codes.Internal, "internal server error",
+		response.ErrorInfo(response.ReasonForCode(codes.Internal), map[string]string{"request_id": RequestIDFromContext(ctx)}))
+}
@@ -0,0 +1,93 @@
+package interceptor
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// RequestIDHeader is the metadata key used to carry the request ID
+const RequestIDHeader = "x-request-id"
+
+// maxRequestIDLength caps caller-supplied request IDs, which are logged and forwarded
+const maxRequestIDLength = 128
+
+type requestIDKey struct{}
+
+// RequestIDUnaryInterceptor propagates the caller's x-request-id or generates a new one.
+// The ID is stored in the context, echoed in the response headers and forwarded
+// on outgoing calls (e.g. to User Service).
+func RequestIDUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		requestID := incomingRequestID(ctx)
+		_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, requestID))
+		return handler(withRequestID(ctx, requestID), req)
+	}
+}
+
+// RequestIDStreamInterceptor is RequestIDUnaryInterceptor for streaming RPCs
+func RequestIDStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		requestID := incomingRequestID(ss.Context())
+		_ = ss.SetHeader(metadata.Pairs(RequestIDHeader, requestID))
+		return handler(srv, &contextStream{ServerStream: ss, ctx: withRequestID(ss.Context(), requestID)})
+	}
+}
+
+// contextStream overrides the context of a server stream
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
+
+// incomingRequestID returns the caller's x-request-id, or a new one when it is missing or invalid
+func incomingRequestID(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(RequestIDHeader); len(values) > 0 && validRequestID(values[0]) {
+			return values[0]
+		}
+	}
+	return newRequestID()
+}
+
+// validRequestID accepts 1 to maxRequestIDLength characters of [A-Za-z0-9._-], so a caller
+// cannot inject log lines or oversized values
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, c := range []byte(id) {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '.', c == '_', c == '-':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// withRequestID stores requestID in ctx and forwards it on outgoing calls
+func withRequestID(ctx context.Context, requestID string) context.Context {
+	ctx = context.WithValue(ctx, requestIDKey{}, requestID)
+	return metadata.AppendToOutgoingContext(ctx, RequestIDHeader, requestID)
+}
+
+// RequestIDFromContext returns the request ID set by RequestIDUnaryInterceptor, if any
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
+
+// newRequestID generates a random 128-bit hex identifier
+func newRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,252 @@
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// advisoryLockKey serialises migration runs across replicas
+const advisoryLockKey int64 = 7_254_020_001
+
+var fileNamePattern = regexp.MustCompile(`^(\d+)_(.+)\.(up|down)\.sql$`)
+
+// Migration is a single versioned schema change
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Status describes whether a migration has been applied
+type Status struct {
+	Version   int
+	Name      string
+	Applied   bool
+	AppliedAt time.Time
+}
+
+// Runner applies embedded migrations and records them in schema_migrations
+type Runner struct {
+	pool       *pgxpool.Pool
+	migrations []Migration
+}
+
+// NewRunner loads migrations from fsys and creates a runner
+func NewRunner(pool *pgxpool.Pool, fsys fs.FS) (*Runner, error) {
+	migrations, err := Load(fsys)
+	if err != nil {
+		return nil, err
+	}
+	return &Runner{pool: pool, migrations: migrations}, nil
+}
+
+// Load reads NNN_name.up.sql / NNN_name.down.sql files from fsys, sorted by version
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("read migrations failed: %w", err)
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, entry := range entries {
+		matches := fileNamePattern.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || matches == nil {
+			continue
+		}
+
+		version, _ := strconv.Atoi(matches[1])
+		content, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("read migration %s failed: %w", entry.Name(), err)
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: matches[2]}
+			byVersion[version] = m
+		} else if m.Name != matches[2] {
+			return nil, fmt.Errorf("migration version %d has conflicting names %q and %q", version, m.Name, matches[2])
+		}
+
+		if matches[3] == "up" {
+			m.Up = string(content)
+		} else {
+			m.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" {
+			return nil, fmt.Errorf("migration %03d_%s has no up script", m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// Up applies all pending migrations in order, each in its own transaction
+func (r *Runner) Up(ctx context.Context) error {
+	return r.withLock(ctx, func(conn *pgxpool.Conn) error {
+		applied, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		count := 0
+		for _, m := range r.migrations {
+			if _, ok := applied[m.Version]; ok {
+				continue
+			}
+
+			log.Printf("[Migrate] Applying %03d_%s", m.Version, m.Name)
+			if err := runInTx(ctx, conn, m.Up,
+				`INSERT INTO schema_migrations (version, name) VALUES ($1, $2)`, m.Version, m.Name); err != nil {
+				return fmt.Errorf("apply migration %03d_%s failed: %w", m.Version, m.Name, err)
+			}
+			count++
+		}
+
+		log.Printf("[Migrate] Up complete: applied=%d", count)
+		return nil
+	})
+}
+
+// Down reverts the latest steps applied migrations, newest first
+func (r *Runner) Down(ctx context.Context, steps int) error {
+	return r.withLock(ctx, func(conn *pgxpool.Conn) error {
+		applied, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		count := 0
+		for i := len(r.migrations) - 1; i >= 0 && count < steps; i-- {
+			m := r.migrations[i]
+			if _, ok := applied[m.Version]; !ok {
+				continue
+			}
+			if m.Down == "" {
+				return fmt.Errorf("migration %03d_%s has no down script", m.Version, m.Name)
+			}
+
+			log.Printf("[Migrate] Reverting %03d_%s", m.Version, m.Name)
+			if err := runInTx(ctx, conn, m.Down,
+				`DELETE FROM schema_migrations WHERE version = $1`, m.Version); err != nil {
+				return fmt.Errorf("revert migration %03d_%s failed: %w", m.Version, m.Name, err)
+			}
+			count++
+		}
+
+		log.Printf("[Migrate] Down complete: reverted=%d", count)
+		return nil
+	})
+}
+
+// Status reports every known migration and whether it has been applied
+func (r *Runner) Status(ctx context.Context) ([]Status, error) {
+	var statuses []Status
+	err := r.withLock(ctx, func(conn *pgxpool.Conn) error {
+		applied, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for _, m := range r.migrations {
+			appliedAt, ok := applied[m.Version]
+			statuses = append(statuses, Status{
+				Version:   m.Version,
+				Name:      m.Name,
+				Applied:   ok,
+				AppliedAt: appliedAt,
+			})
+		}
+		return nil
+	})
+	return statuses, err
+}
+
+// withLock runs fn on a dedicated connection holding the migration advisory lock,
+// so concurrent replicas starting up don't race on the schema
+func (r *Runner) withLock(ctx context.Context, fn func(conn *pgxpool.Conn) error) error {
+	conn, err := r.pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("acquire connection failed: %w", err)
+	}
+	defer conn.Release()
+
+	if _, err := conn.Exec(ctx, `SELECT pg_advisory_lock($1)`, advisoryLockKey); err != nil {
+		return fmt.Errorf("acquire migration lock failed: %w", err)
+	}
+	defer func() {
+		// Use a fresh context so the lock is released even if ctx was cancelled
+		unlockCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if _, err := conn.Exec(unlockCtx, `SELECT pg_advisory_unlock($1)`, advisoryLockKey); err != nil {
+			log.Printf("[Migrate] ERROR: Failed to release migration lock: %v", err)
+		}
+	}()
+
+	_, err = conn.Exec(ctx, `
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version INTEGER PRIMARY KEY,
+			name TEXT NOT NULL,
+			applied_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)
+	`)
+	if err != nil {
+		return fmt.Errorf("create schema_migrations failed: %w", err)
+	}
+
+	return fn(conn)
+}
+
+// appliedVersions returns applied migration versions with their apply time
+func appliedVersions(ctx context.Context, conn *pgxpool.Conn) (map[int]time.Time, error) {
+	rows, err := conn.Query(ctx, `SELECT version, applied_at FROM schema_migrations`)
+	if err != nil {
+		return nil, fmt.Errorf("query schema_migrations failed: %w", err)
+	}
+	defer rows.Close()
+
+	applied := make(map[int]time.Time)
+	for rows.Next() {
+		var version int
+		var appliedAt time.Time
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, fmt.Errorf("scan schema_migrations failed: %w", err)
+		}
+		applied[version] = appliedAt
+	}
+	return applied, rows.Err()
+}
+
+// runInTx executes a migration script and its bookkeeping statement atomically
+func runInTx(ctx context.Context, conn *pgxpool.Conn, script, bookkeeping string, args ...any) error {
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, script); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(ctx, bookkeeping, args...); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
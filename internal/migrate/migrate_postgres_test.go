@@ -0,0 +1,120 @@
+//go:build integration
+
+package migrate_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/thatlq1812/service-2-article/internal/dbtest"
+	"github.com/thatlq1812/service-2-article/internal/migrate"
+	"github.com/thatlq1812/service-2-article/migrations"
+)
+
+// testSchema isolates this test from the repository suites, which share the public schema
+const testSchema = "migrate_test"
+
+func TestRunnerUpDown(t *testing.T) {
+	ctx := context.Background()
+	pool := openSchemaPool(t, dbtest.OpenPool(t))
+
+	runner, err := migrate.NewRunner(pool, migrations.FS)
+	if err != nil {
+		t.Fatalf("NewRunner error = %v", err)
+	}
+	all, err := migrate.Load(migrations.FS)
+	if err != nil {
+		t.Fatalf("Load error = %v", err)
+	}
+
+	if err := runner.Up(ctx); err != nil {
+		t.Fatalf("Up error = %v", err)
+	}
+	assertApplied(t, runner, len(all))
+	assertTableExists(t, pool, "articles", true)
+
+	// Up is idempotent
+	if err := runner.Up(ctx); err != nil {
+		t.Fatalf("second Up error = %v", err)
+	}
+	assertApplied(t, runner, len(all))
+
+	if err := runner.Down(ctx, 1); err != nil {
+		t.Fatalf("Down(1) error = %v", err)
+	}
+	assertApplied(t, runner, len(all)-1)
+
+	if err := runner.Down(ctx, len(all)); err != nil {
+		t.Fatalf("Down(all) error = %v", err)
+	}
+	assertApplied(t, runner, 0)
+	assertTableExists(t, pool, "articles", false)
+
+	// The schema can be rebuilt from scratch after a full rollback
+	if err := runner.Up(ctx); err != nil {
+		t.Fatalf("Up after Down error = %v", err)
+	}
+	assertApplied(t, runner, len(all))
+}
+
+// openSchemaPool creates an empty schema and returns a pool whose search_path points at it.
+// The schema is dropped when the test ends.
+func openSchemaPool(t *testing.T, pool *pgxpool.Pool) *pgxpool.Pool {
+	t.Helper()
+	ctx := context.Background()
+
+	if _, err := pool.Exec(ctx, `DROP SCHEMA IF EXISTS `+testSchema+` CASCADE`); err != nil {
+		t.Fatalf("Failed to drop schema: %v", err)
+	}
+	if _, err := pool.Exec(ctx, `CREATE SCHEMA `+testSchema); err != nil {
+		t.Fatalf("Failed to create schema: %v", err)
+	}
+	t.Cleanup(func() {
+		if _, err := pool.Exec(context.Background(), `DROP SCHEMA IF EXISTS `+testSchema+` CASCADE`); err != nil {
+			t.Errorf("Failed to drop schema: %v", err)
+		}
+	})
+
+	config := pool.Config()
+	config.ConnConfig.RuntimeParams["search_path"] = testSchema
+	schemaPool, err := pgxpool.NewWithConfig(ctx, config)
+	if err != nil {
+		t.Fatalf("Failed to create pool: %v", err)
+	}
+	t.Cleanup(schemaPool.Close)
+	return schemaPool
+}
+
+func assertApplied(t *testing.T, runner *migrate.Runner, want int) {
+	t.Helper()
+
+	statuses, err := runner.Status(context.Background())
+	if err != nil {
+		t.Fatalf("Status error = %v", err)
+	}
+	applied := 0
+	for _, s := range statuses {
+		if s.Applied {
+			applied++
+		}
+	}
+	if applied != want {
+		t.Errorf("applied migrations = %d, want %d", applied, want)
+	}
+}
+
+func assertTableExists(t *testing.T, pool *pgxpool.Pool, table string, want bool) {
+	t.Helper()
+
+	var exists bool
+	err := pool.QueryRow(context.Background(),
+		`SELECT to_regclass(current_schema() || '.' || $1) IS NOT NULL`, table).Scan(&exists)
+	if err != nil {
+		t.Fatalf("Failed to check table %s: %v", table, err)
+	}
+	if exists != want {
+		t.Errorf("table %s exists = %v, want %v", table, exists, want)
+	}
+}
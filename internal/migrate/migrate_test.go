@@ -0,0 +1,94 @@
+package migrate
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/thatlq1812/service-2-article/migrations"
+)
+
+func TestLoad(t *testing.T) {
+	fsys := fstest.MapFS{
+		"010_add_index.up.sql":      {Data: []byte("CREATE INDEX idx ON articles (id);")},
+		"010_add_index.down.sql":    {Data: []byte("DROP INDEX idx;")},
+		"002_add_column.up.sql":     {Data: []byte("ALTER TABLE articles ADD COLUMN x INT;")},
+		"002_add_column.down.sql":   {Data: []byte("ALTER TABLE articles DROP COLUMN x;")},
+		"001_create_table.up.sql":   {Data: []byte("CREATE TABLE articles (id INT);")},
+		"001_create_table.down.sql": {Data: []byte("DROP TABLE articles;")},
+		"003_no_rollback.up.sql":    {Data: []byte("UPDATE articles SET x = 1;")},
+		"README.md":                 {Data: []byte("not a migration")},
+		"004_wrong_suffix.sql":      {Data: []byte("SELECT 1;")},
+		"nested/005_ignored.up.sql": {Data: []byte("SELECT 1;")},
+	}
+
+	migrations, err := Load(fsys)
+	if err != nil {
+		t.Fatalf("Load error = %v", err)
+	}
+
+	want := []Migration{
+		{Version: 1, Name: "create_table", Up: "CREATE TABLE articles (id INT);", Down: "DROP TABLE articles;"},
+		{Version: 2, Name: "add_column", Up: "ALTER TABLE articles ADD COLUMN x INT;", Down: "ALTER TABLE articles DROP COLUMN x;"},
+		{Version: 3, Name: "no_rollback", Up: "UPDATE articles SET x = 1;"},
+		{Version: 10, Name: "add_index", Up: "CREATE INDEX idx ON articles (id);", Down: "DROP INDEX idx;"},
+	}
+	if len(migrations) != len(want) {
+		t.Fatalf("Load returned %d migrations, want %d: %+v", len(migrations), len(want), migrations)
+	}
+	for i := range want {
+		if migrations[i] != want[i] {
+			t.Errorf("migrations[%d] = %+v, want %+v", i, migrations[i], want[i])
+		}
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		fsys    fstest.MapFS
+		wantErr string
+	}{
+		{
+			name: "missing up script",
+			fsys: fstest.MapFS{
+				"001_create_table.down.sql": {Data: []byte("DROP TABLE articles;")},
+			},
+			wantErr: "has no up script",
+		},
+		{
+			name: "conflicting names",
+			fsys: fstest.MapFS{
+				"001_create_table.up.sql":    {Data: []byte("CREATE TABLE articles (id INT);")},
+				"001_create_articles.up.sql": {Data: []byte("CREATE TABLE articles (id INT);")},
+			},
+			wantErr: "conflicting names",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Load(tt.fsys)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Load error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// Every embedded migration must be reversible so "migrate down" never gets stuck
+func TestEmbeddedMigrations(t *testing.T) {
+	all, err := Load(migrations.FS)
+	if err != nil {
+		t.Fatalf("Load error = %v", err)
+	}
+	if len(all) == 0 {
+		t.Fatal("no embedded migrations found")
+	}
+
+	for _, m := range all {
+		if strings.TrimSpace(m.Down) == "" {
+			t.Errorf("migration %03d_%s has no down script", m.Version, m.Name)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+// Package outbox publishes article events with the transactional outbox pattern:
+// the repository writes an event row in the same transaction as each change (Write),
+// and a Relay publishes the rows, in order, to a Sink. Delivery is at-least-once.
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	pb "github.com/thatlq1812/service-2-article/proto"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Event types, stored in outbox.event_type and passed to sinks
+const (
+	EventCreated = "ArticleCreated"
+	EventUpdated = "ArticleUpdated"
+	EventDeleted = "ArticleDeleted"
+)
+
+// Created returns the event for a new article
+func Created(article *pb.Article) *pb.ArticleEvent {
+	return &pb.ArticleEvent{
+		ArticleId: article.Id,
+		Time:      timestamppb.Now(),
+		Event:     &pb.ArticleEvent_Created{Created: &pb.ArticleCreated{Article: article}},
+	}
+}
+
+// Updated returns the event for an updated article
+func Updated(article *pb.Article) *pb.ArticleEvent {
+	return &pb.ArticleEvent{
+		ArticleId: article.Id,
+		Time:      timestamppb.Now(),
+		Event:     &pb.ArticleEvent_Updated{Updated: &pb.ArticleUpdated{Article: article}},
+	}
+}
+
+// Deleted returns the event for a deleted article, given its last state
+func Deleted(article *pb.Article) *pb.ArticleEvent {
+	return &pb.ArticleEvent{
+		ArticleId: article.Id,
+		Time:      timestamppb.Now(),
+		Event:     &pb.ArticleEvent_Deleted{Deleted: &pb.ArticleDeleted{Article: article}},
+	}
+}
+
+// Type returns the event type of event
+func Type(event *pb.ArticleEvent) string {
+	switch event.Event.(type) {
+	case *pb.ArticleEvent_Created:
+		return EventCreated
+	case *pb.ArticleEvent_Updated:
+		return EventUpdated
+	case *pb.ArticleEvent_Deleted:
+		return EventDeleted
+	default:
+		return ""
+	}
+}
+
+// Article returns the article an event carries
+func Article(event *pb.ArticleEvent) *pb.Article {
+	switch e := event.Event.(type) {
+	case *pb.ArticleEvent_Created:
+		return e.Created.Article
+	case *pb.ArticleEvent_Updated:
+		return e.Updated.Article
+	case *pb.ArticleEvent_Deleted:
+		return e.Deleted.Article
+	default:
+		return nil
+	}
+}
+
+// Channel is the Postgres NOTIFY channel that carries the ids of committed events
+const Channel = "article_events"
+
+// maxNotifyPayload keeps NOTIFY payloads below the 8000 byte limit
+const maxNotifyPayload = 7000
+
+// Write stores events in tx, so they are published only if tx commits, and notifies
+// Channel with their ids (delivered by Postgres on commit). The ids are set on events.
+func Write(ctx context.Context, tx pgx.Tx, events ...*pb.ArticleEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	// Reserve ids first so a batch can be copied and notified
+	rows, err := tx.Query(ctx, `SELECT nextval(pg_get_serial_sequence('outbox', 'id')) FROM generate_series(1, $1)`, len(events))
+	if err != nil {
+		return fmt.Errorf("reserve outbox ids failed: %w", err)
+	}
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[int64])
+	if err != nil {
+		return fmt.Errorf("reserve outbox ids failed: %w", err)
+	}
+
+	values := make([][]interface{}, len(events))
+	for i, event := range events {
+		event.Id = ids[i]
+		payload, err := proto.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("marshal %s event failed: %w", Type(event), err)
+		}
+		values[i] = []interface{}{ids[i], event.ArticleId, Type(event), payload}
+	}
+
+	if len(values) == 1 {
+		_, err = tx.Exec(ctx, `INSERT INTO outbox (id, article_id, event_type, payload) VALUES ($1, $2, $3, $4)`, values[0]...)
+	} else {
+		_, err = tx.CopyFrom(ctx, pgx.Identifier{"outbox"}, []string{"id", "article_id", "event_type", "payload"}, pgx.CopyFromRows(values))
+	}
+	if err != nil {
+		return fmt.Errorf("write outbox events failed: %w", err)
+	}
+
+	for _, payload := range notifyPayloads(ids) {
+		if _, err := tx.Exec(ctx, `SELECT pg_notify($1, $2)`, Channel, payload); err != nil {
+			return fmt.Errorf("notify outbox events failed: %w", err)
+		}
+	}
+	return nil
+}
+
+// notifyPayloads joins ids with commas, split to fit NOTIFY payloads
+func notifyPayloads(ids []int64) []string {
+	var payloads []string
+	var b strings.Builder
+	for _, id := range ids {
+		s := strconv.FormatInt(id, 10)
+		if b.Len() > 0 && b.Len()+1+len(s) > maxNotifyPayload {
+			payloads = append(payloads, b.String())
+			b.Reset()
+		}
+		if b.Len() > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(s)
+	}
+	return append(payloads, b.String())
+}
+
+// parseNotifyPayload is the inverse of notifyPayloads
+func parseNotifyPayload(payload string) ([]int64, error) {
+	parts := strings.Split(payload, ",")
+	ids := make([]int64, len(parts))
+	for i, part := range parts {
+		id, err := strconv.ParseInt(part, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid outbox notification %q", payload)
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
@@ -0,0 +1,36 @@
+package outbox
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestNotifyPayloads(t *testing.T) {
+	ids := make([]int64, 2000)
+	for i := range ids {
+		ids[i] = int64(1_000_000 + i)
+	}
+
+	payloads := notifyPayloads(ids)
+	if len(payloads) < 2 {
+		t.Fatalf("notifyPayloads(2000 ids) = %d payloads, want the ids split", len(payloads))
+	}
+	var got []int64
+	for _, payload := range payloads {
+		if len(payload) > maxNotifyPayload {
+			t.Errorf("payload is %d bytes, want at most %d", len(payload), maxNotifyPayload)
+		}
+		parsed, err := parseNotifyPayload(payload)
+		if err != nil {
+			t.Fatalf("parseNotifyPayload failed: %v", err)
+		}
+		got = append(got, parsed...)
+	}
+	if !slices.Equal(got, ids) {
+		t.Errorf("round trip returned %d ids, want the %d ids in order", len(got), len(ids))
+	}
+
+	if _, err := parseNotifyPayload("1,x"); err == nil {
+		t.Error("parseNotifyPayload(1,x) succeeded, want an error")
+	}
+}
@@ -0,0 +1,217 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	pb "github.com/thatlq1812/service-2-article/proto"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// subscriberBuffer is how many events a subscriber may fall behind before it is dropped
+	subscriberBuffer = 256
+	// replayBatchSize is the number of events read per replay query
+	replayBatchSize = 500
+	// maxListenBackoff caps the wait between listener reconnects
+	maxListenBackoff = 30 * time.Second
+)
+
+// Hub fans committed outbox events out to subscribers on this replica. It LISTENs on Channel
+// with one dedicated connection, so events written by any replica reach every hub.
+type Hub struct {
+	db *pgxpool.Pool
+
+	mu   sync.Mutex
+	subs map[chan *pb.ArticleEvent]struct{}
+}
+
+// NewHub creates a hub; call Run to start listening
+func NewHub(db *pgxpool.Pool) *Hub {
+	return &Hub{db: db, subs: make(map[chan *pb.ArticleEvent]struct{})}
+}
+
+// Subscribe returns a channel of events committed from now on, in commit order, and a func
+// that unsubscribes. The channel is closed when the subscriber falls behind or the listener
+// reconnects, as events may have been missed; Replay recovers them.
+func (h *Hub) Subscribe() (<-chan *pb.ArticleEvent, func()) {
+	ch := make(chan *pb.ArticleEvent, subscriberBuffer)
+	h.mu.Lock()
+	h.subs[ch] = struct{}{}
+	h.mu.Unlock()
+
+	return ch, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		if _, ok := h.subs[ch]; ok {
+			delete(h.subs, ch)
+			close(ch)
+		}
+	}
+}
+
+// Replay calls fn for every stored event with an id above afterID, in id order
+func (h *Hub) Replay(ctx context.Context, afterID int64, fn func(*pb.ArticleEvent) error) error {
+	for {
+		rows, err := h.db.Query(ctx, `
+			SELECT id, payload
+			FROM outbox
+			WHERE id > $1
+			ORDER BY id
+			LIMIT $2
+		`, afterID, replayBatchSize)
+		if err != nil {
+			return fmt.Errorf("query outbox failed: %w", err)
+		}
+		events, err := collectEvents(rows)
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			if err := fn(event); err != nil {
+				return err
+			}
+			afterID = event.Id
+		}
+		if len(events) < replayBatchSize {
+			return nil
+		}
+	}
+}
+
+// LastID returns the highest stored event id, 0 when there is none
+func (h *Hub) LastID(ctx context.Context) (int64, error) {
+	var id int64
+	if err := h.db.QueryRow(ctx, `SELECT COALESCE(max(id), 0) FROM outbox`).Scan(&id); err != nil {
+		return 0, fmt.Errorf("query last event id failed: %w", err)
+	}
+	return id, nil
+}
+
+// Run listens for notifications until ctx is cancelled, reconnecting with backoff
+func (h *Hub) Run(ctx context.Context) {
+	backoff := time.Second
+	for {
+		err := h.listen(ctx)
+		// Subscribers may have missed events while disconnected: make them resume
+		h.dropAll()
+		if ctx.Err() != nil {
+			return
+		}
+		log.Printf("[Outbox] ERROR: Listener failed, reconnecting in %s: %v", backoff, err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, maxListenBackoff)
+	}
+}
+
+// listen holds one connection in LISTEN and dispatches notifications until an error
+func (h *Hub) listen(ctx context.Context) error {
+	conn, err := h.db.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	// The connection is in LISTEN state: take it out of the pool and close it when done.
+	// The pooled handle is unusable once hijacked, so only pgConn is used below.
+	pgConn := conn.Hijack()
+	defer pgConn.Close(context.Background())
+
+	if _, err := pgConn.Exec(ctx, "LISTEN "+pgx.Identifier{Channel}.Sanitize()); err != nil {
+		return err
+	}
+	log.Printf("[Outbox] Listening for article events")
+
+	for {
+		notification, err := pgConn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+		ids, err := parseNotifyPayload(notification.Payload)
+		if err != nil {
+			log.Printf("[Outbox] ERROR: %v", err)
+			continue
+		}
+		if err := h.dispatch(ctx, ids); err != nil {
+			return err
+		}
+	}
+}
+
+// dispatch loads the notified events and sends them to every subscriber
+func (h *Hub) dispatch(ctx context.Context, ids []int64) error {
+	h.mu.Lock()
+	idle := len(h.subs) == 0
+	h.mu.Unlock()
+	if idle {
+		return nil
+	}
+
+	rows, err := h.db.Query(ctx, `SELECT id, payload FROM outbox WHERE id = ANY($1) ORDER BY id`, ids)
+	if err != nil {
+		return fmt.Errorf("load notified events failed: %w", err)
+	}
+	events, err := collectEvents(rows)
+	if err != nil {
+		return err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, event := range events {
+		for ch := range h.subs {
+			select {
+			case ch <- event:
+			default:
+				// Too slow: drop the subscriber rather than block the others
+				delete(h.subs, ch)
+				close(ch)
+			}
+		}
+	}
+	return nil
+}
+
+// dropAll closes every subscription
+func (h *Hub) dropAll() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for ch := range h.subs {
+		delete(h.subs, ch)
+		close(ch)
+	}
+}
+
+// collectEvents decodes and closes rows of (id, payload)
+func collectEvents(rows pgx.Rows) ([]*pb.ArticleEvent, error) {
+	defer rows.Close()
+
+	var events []*pb.ArticleEvent
+	for rows.Next() {
+		var id int64
+		var payload []byte
+		if err := rows.Scan(&id, &payload); err != nil {
+			return nil, fmt.Errorf("scan outbox event failed: %w", err)
+		}
+		event := &pb.ArticleEvent{}
+		if err := proto.Unmarshal(payload, event); err != nil {
+			log.Printf("[Outbox] ERROR: Skipping undecodable event: id=%d, error=%v", id, err)
+			continue
+		}
+		event.Id = id
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("query outbox failed: %w", err)
+	}
+	return events, nil
+}
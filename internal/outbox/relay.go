@@ -0,0 +1,183 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	pb "github.com/thatlq1812/service-2-article/proto"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/protobuf/proto"
+)
+
+// relayLockKey makes one replica at a time publish, which keeps events in order
+const relayLockKey int64 = 7_254_020_002
+
+// pruneInterval is how often published events older than the retention are deleted
+const pruneInterval = time.Hour
+
+// Sink receives published events. Publish must return nil only once the event is accepted;
+// it may be called again with the same event after a failure or restart.
+type Sink interface {
+	Publish(ctx context.Context, eventType string, event *pb.ArticleEvent) error
+}
+
+// Options configure a Relay
+type Options struct {
+	BatchSize    int           // Events per transaction
+	PollInterval time.Duration // Wait between polls when the outbox is drained or the sink fails
+	Retention    time.Duration // How long published events are kept; 0 keeps them forever
+}
+
+// Relay publishes outbox events to a Sink
+type Relay struct {
+	db   *pgxpool.Pool
+	sink Sink
+	opts Options
+}
+
+// NewRelay creates a relay; zero options get defaults (100 events, 1s poll).
+// With a nil sink nothing is published and the relay only prunes events by age,
+// keeping the outbox bounded while WatchArticles still reads it.
+func NewRelay(db *pgxpool.Pool, sink Sink, opts Options) *Relay {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 100
+	}
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = time.Second
+	}
+	return &Relay{db: db, sink: sink, opts: opts}
+}
+
+// Run publishes events until ctx is cancelled
+func (r *Relay) Run(ctx context.Context) {
+	if r.sink != nil {
+		log.Printf("[Outbox] Relay started (batch=%d, poll=%s)", r.opts.BatchSize, r.opts.PollInterval)
+	}
+	var lastPrune time.Time
+
+	for {
+		var published int
+		var err error
+		if r.sink != nil {
+			published, err = r.PublishBatch(ctx)
+			if err != nil && ctx.Err() == nil {
+				log.Printf("[Outbox] ERROR: Publish failed after %d events: %v", published, err)
+			}
+		}
+
+		if r.opts.Retention > 0 && time.Since(lastPrune) >= pruneInterval {
+			r.prune(ctx)
+			lastPrune = time.Now()
+		}
+
+		// A full batch means more events are probably waiting
+		if r.sink != nil && err == nil && published == r.opts.BatchSize {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			log.Printf("[Outbox] Relay stopped")
+			return
+		case <-time.After(r.opts.PollInterval):
+		}
+	}
+}
+
+// PublishBatch publishes up to BatchSize unpublished events in id order and marks them
+// published in the same transaction. It stops at the first sink error, so a later event
+// is never published before an earlier one. It returns the number of events published;
+// 0 with no error also means another replica holds the relay lock.
+func (r *Relay) PublishBatch(ctx context.Context) (int, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("begin outbox batch failed: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var locked bool
+	if err := tx.QueryRow(ctx, `SELECT pg_try_advisory_xact_lock($1)`, relayLockKey).Scan(&locked); err != nil {
+		return 0, fmt.Errorf("acquire relay lock failed: %w", err)
+	}
+	if !locked {
+		return 0, nil
+	}
+
+	rows, err := tx.Query(ctx, `
+		SELECT id, event_type, payload
+		FROM outbox
+		WHERE published_at IS NULL
+		ORDER BY id
+		LIMIT $1
+	`, r.opts.BatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("query outbox failed: %w", err)
+	}
+	type pending struct {
+		id        int64
+		eventType string
+		payload   []byte
+	}
+	var batch []pending
+	for rows.Next() {
+		var p pending
+		if err := rows.Scan(&p.id, &p.eventType, &p.payload); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("scan outbox event failed: %w", err)
+		}
+		batch = append(batch, p)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("query outbox failed: %w", err)
+	}
+
+	var done []int64
+	var publishErr error
+	for _, p := range batch {
+		var event pb.ArticleEvent
+		if err := proto.Unmarshal(p.payload, &event); err != nil {
+			// Retrying cannot fix a corrupt payload; skip it rather than block every later event
+			log.Printf("[Outbox] ERROR: Skipping undecodable event: id=%d, error=%v", p.id, err)
+			done = append(done, p.id)
+			continue
+		}
+		event.Id = p.id
+		if err := r.sink.Publish(ctx, p.eventType, &event); err != nil {
+			publishErr = fmt.Errorf("publish event %d failed: %w", p.id, err)
+			break
+		}
+		done = append(done, p.id)
+	}
+
+	if len(done) > 0 {
+		if _, err := tx.Exec(ctx, `UPDATE outbox SET published_at = CURRENT_TIMESTAMP WHERE id = ANY($1)`, done); err != nil {
+			return 0, fmt.Errorf("mark events published failed: %w", err)
+		}
+		if err := tx.Commit(ctx); err != nil {
+			// The events will be published again: at-least-once
+			return 0, fmt.Errorf("commit outbox batch failed: %w", err)
+		}
+	}
+	return len(done), publishErr
+}
+
+// prune deletes published events older than the retention, or all of them without a sink
+func (r *Relay) prune(ctx context.Context) {
+	query := `DELETE FROM outbox WHERE published_at < $1`
+	if r.sink == nil {
+		query = `DELETE FROM outbox WHERE created_at < $1`
+	}
+	result, err := r.db.Exec(ctx, query, time.Now().Add(-r.opts.Retention))
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("[Outbox] ERROR: Prune failed: %v", err)
+		}
+		return
+	}
+	if n := result.RowsAffected(); n > 0 {
+		log.Printf("[Outbox] Pruned %d published events", n)
+	}
+}
@@ -0,0 +1,111 @@
+//go:build integration
+
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thatlq1812/service-2-article/internal/dbtest"
+	"github.com/thatlq1812/service-2-article/internal/outbox"
+	"github.com/thatlq1812/service-2-article/internal/repository"
+	pb "github.com/thatlq1812/service-2-article/proto"
+)
+
+// recordingSink keeps published events and fails once failAt events have been accepted
+type recordingSink struct {
+	events []*pb.ArticleEvent
+	failAt int
+}
+
+func (s *recordingSink) Publish(_ context.Context, _ string, event *pb.ArticleEvent) error {
+	if s.failAt > 0 && len(s.events) == s.failAt {
+		return errors.New("sink unavailable")
+	}
+	s.events = append(s.events, event)
+	return nil
+}
+
+// Run with: go test -tags=integration ./internal/outbox/...
+// Override the database with TEST_DATABASE_URL.
+func TestRelayAndHub(t *testing.T) {
+	ctx := context.Background()
+
+	pool := dbtest.OpenPool(t)
+	dbtest.ResetTables(t, pool)
+
+	// The hub sees every committed event through LISTEN/NOTIFY
+	hub := outbox.NewHub(pool)
+	hubCtx, stopHub := context.WithCancel(ctx)
+	defer stopHub()
+	go hub.Run(hubCtx)
+	live, unsubscribe := hub.Subscribe()
+	defer unsubscribe()
+	time.Sleep(200 * time.Millisecond) // let the listener connect
+
+	repo := repository.NewArticlePostgresRepository(pool)
+	created, err := repo.Create(ctx, &pb.Article{Title: "Hello", Content: "World", UserId: 1})
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	created.Title = "Hello again"
+	if _, err := repo.Update(ctx, created); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	if err := repo.Import(ctx, []*pb.Article{{Title: "Imported", Content: "Body", UserId: 2}}); err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+	if err := repo.Delete(ctx, created.Id); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+
+	for i, wantType := range []string{outbox.EventCreated, outbox.EventUpdated, outbox.EventCreated, outbox.EventDeleted} {
+		select {
+		case event := <-live:
+			if outbox.Type(event) != wantType || event.Id != int64(i+1) {
+				t.Errorf("live event %d = %s (id %d), want %s (id %d)", i, outbox.Type(event), event.Id, wantType, i+1)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("live event %d not received", i)
+		}
+	}
+	var replayed []int64
+	if err := hub.Replay(ctx, 2, func(event *pb.ArticleEvent) error {
+		replayed = append(replayed, event.Id)
+		return nil
+	}); err != nil || len(replayed) != 2 || replayed[0] != 3 {
+		t.Errorf("Replay(after 2) = %v, %v; want [3 4]", replayed, err)
+	}
+
+	// The sink fails after two events: the rest stay unpublished and come next, in order
+	sink := &recordingSink{failAt: 2}
+	relay := outbox.NewRelay(pool, sink, outbox.Options{BatchSize: 10})
+	if n, err := relay.PublishBatch(ctx); n != 2 || err == nil {
+		t.Fatalf("PublishBatch(failing sink) = %d, %v; want 2 and an error", n, err)
+	}
+	sink.failAt = 0
+	if n, err := relay.PublishBatch(ctx); n != 2 || err != nil {
+		t.Fatalf("PublishBatch = %d, %v; want the remaining 2", n, err)
+	}
+	if n, err := relay.PublishBatch(ctx); n != 0 || err != nil {
+		t.Errorf("PublishBatch(drained) = %d, %v; want 0", n, err)
+	}
+
+	want := []string{outbox.EventCreated, outbox.EventUpdated, outbox.EventCreated, outbox.EventDeleted}
+	if len(sink.events) != len(want) {
+		t.Fatalf("published %d events, want %d", len(sink.events), len(want))
+	}
+	for i, event := range sink.events {
+		if outbox.Type(event) != want[i] || event.Id != int64(i+1) {
+			t.Errorf("event %d = %s (id %d), want %s (id %d)", i, outbox.Type(event), event.Id, want[i], i+1)
+		}
+	}
+	if got := outbox.Article(sink.events[2]); got.Title != "Imported" || got.Id == 0 || got.CreateTime == nil {
+		t.Errorf("imported article event = %v, want id and timestamps", got)
+	}
+	if got := outbox.Article(sink.events[3]); got.Title != "Hello again" {
+		t.Errorf("deleted article event = %v, want the last state", got)
+	}
+}
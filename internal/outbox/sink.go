@@ -0,0 +1,108 @@
+package outbox
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	pb "github.com/thatlq1812/service-2-article/proto"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// StreamAdder appends entries to a Redis stream (implemented by db.RedisClient)
+type StreamAdder interface {
+	AddToStream(ctx context.Context, stream string, maxLen int64, values map[string]interface{}) error
+}
+
+// redisStreamSink appends one entry per event to a Redis stream
+type redisStreamSink struct {
+	redis  StreamAdder
+	stream string
+	maxLen int64
+}
+
+// NewRedisStreamSink publishes to stream, trimmed to about maxLen entries (0 keeps all).
+// Entries have the fields id, type, article_id and payload (serialized article.ArticleEvent).
+func NewRedisStreamSink(redis StreamAdder, stream string, maxLen int64) Sink {
+	return &redisStreamSink{redis: redis, stream: stream, maxLen: maxLen}
+}
+
+func (s *redisStreamSink) Publish(ctx context.Context, eventType string, event *pb.ArticleEvent) error {
+	payload, err := proto.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return s.redis.AddToStream(ctx, s.stream, s.maxLen, map[string]interface{}{
+		"id":         event.Id,
+		"type":       eventType,
+		"article_id": event.ArticleId,
+		"payload":    payload,
+	})
+}
+
+// webhookSink POSTs each event to a URL
+type webhookSink struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookSink POSTs the serialized article.ArticleEvent (application/x-protobuf) to url,
+// with X-Event-Id and X-Event-Type headers. Any status other than 2xx is a failure.
+func NewWebhookSink(url string, timeout time.Duration) Sink {
+	return &webhookSink{url: url, client: &http.Client{Timeout: timeout}}
+}
+
+func (s *webhookSink) Publish(ctx context.Context, eventType string, event *pb.ArticleEvent) error {
+	payload, err := proto.Marshal(event)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	req.Header.Set("X-Event-Id", strconv.FormatInt(event.Id, 10))
+	req.Header.Set("X-Event-Type", eventType)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10)) // let the connection be reused
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+// writerSink writes one JSON line per event
+type writerSink struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewWriterSink writes events to w as JSON lines, e.g. to stdout for debugging
+func NewWriterSink(w io.Writer) Sink {
+	return &writerSink{w: w}
+}
+
+func (s *writerSink) Publish(_ context.Context, _ string, event *pb.ArticleEvent) error {
+	line, err := protojson.Marshal(event)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = fmt.Fprintf(s.w, "%s\n", line)
+	return err
+}
@@ -0,0 +1,126 @@
+package outbox_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/thatlq1812/service-2-article/internal/outbox"
+	pb "github.com/thatlq1812/service-2-article/proto"
+)
+
+func testEvent() *pb.ArticleEvent {
+	event := outbox.Updated(&pb.Article{Id: 7, Title: "Hello", UserId: 1})
+	event.Id = 42
+	return event
+}
+
+func TestEventType(t *testing.T) {
+	article := &pb.Article{Id: 1}
+	for _, tc := range []struct {
+		event *pb.ArticleEvent
+		want  string
+	}{
+		{outbox.Created(article), outbox.EventCreated},
+		{outbox.Updated(article), outbox.EventUpdated},
+		{outbox.Deleted(article), outbox.EventDeleted},
+		{&pb.ArticleEvent{}, ""},
+	} {
+		if got := outbox.Type(tc.event); got != tc.want {
+			t.Errorf("Type(%v) = %q, want %q", tc.event, got, tc.want)
+		}
+	}
+	if got := outbox.Article(outbox.Deleted(article)); got != article {
+		t.Errorf("Article(deleted) = %v, want the deleted article", got)
+	}
+}
+
+func TestWebhookSink(t *testing.T) {
+	var received *pb.ArticleEvent
+	var header http.Header
+	status := http.StatusNoContent
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received = &pb.ArticleEvent{}
+		if err := proto.Unmarshal(body, received); err != nil {
+			t.Errorf("webhook body is not an ArticleEvent: %v", err)
+		}
+		header = r.Header
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	sink := outbox.NewWebhookSink(srv.URL, time.Second)
+	event := testEvent()
+	if err := sink.Publish(context.Background(), outbox.EventUpdated, event); err != nil {
+		t.Fatalf("Publish failed: %v", err)
+	}
+	if !proto.Equal(received, event) {
+		t.Errorf("webhook received %v, want %v", received, event)
+	}
+	if header.Get("X-Event-Id") != "42" || header.Get("X-Event-Type") != outbox.EventUpdated || header.Get("Content-Type") != "application/x-protobuf" {
+		t.Errorf("webhook headers = %v, want event id, type and protobuf content type", header)
+	}
+
+	status = http.StatusServiceUnavailable
+	if err := sink.Publish(context.Background(), outbox.EventUpdated, event); err == nil || !strings.Contains(err.Error(), "503") {
+		t.Errorf("Publish to a failing webhook = %v, want a 503 error", err)
+	}
+}
+
+// fakeStream records AddToStream calls
+type fakeStream struct {
+	stream string
+	maxLen int64
+	values map[string]interface{}
+}
+
+func (f *fakeStream) AddToStream(_ context.Context, stream string, maxLen int64, values map[string]interface{}) error {
+	f.stream, f.maxLen, f.values = stream, maxLen, values
+	return nil
+}
+
+func TestRedisStreamSink(t *testing.T) {
+	redis := &fakeStream{}
+	event := testEvent()
+	if err := outbox.NewRedisStreamSink(redis, "article-events", 1000).Publish(context.Background(), outbox.EventUpdated, event); err != nil {
+		t.Fatalf("Publish failed: %v", err)
+	}
+	if redis.stream != "article-events" || redis.maxLen != 1000 {
+		t.Errorf("stream = %s (maxlen %d), want article-events (1000)", redis.stream, redis.maxLen)
+	}
+	if redis.values["id"] != int64(42) || redis.values["type"] != outbox.EventUpdated || redis.values["article_id"] != int32(7) {
+		t.Errorf("entry = %v, want id, type and article_id", redis.values)
+	}
+	var decoded pb.ArticleEvent
+	if err := proto.Unmarshal(redis.values["payload"].([]byte), &decoded); err != nil || !proto.Equal(&decoded, event) {
+		t.Errorf("payload = %v (%v), want the event", &decoded, err)
+	}
+}
+
+func TestWriterSink(t *testing.T) {
+	var buf bytes.Buffer
+	sink := outbox.NewWriterSink(&buf)
+	for i := 0; i < 2; i++ {
+		if err := sink.Publish(context.Background(), outbox.EventUpdated, testEvent()); err != nil {
+			t.Fatalf("Publish failed: %v", err)
+		}
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("wrote %q, want 2 lines", buf.String())
+	}
+	var decoded pb.ArticleEvent
+	if err := protojson.Unmarshal([]byte(lines[0]), &decoded); err != nil || decoded.GetUpdated().GetArticle().GetTitle() != "Hello" {
+		t.Errorf("line = %s (%v), want the JSON event", lines[0], err)
+	}
+}
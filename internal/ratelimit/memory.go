@@ -0,0 +1,81 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const cleanupInterval = time.Minute
+
+// tokenBucket refills continuously at limit.Requests per limit.Window
+type tokenBucket struct {
+	tokens   float64
+	lastSeen time.Time
+	window   time.Duration
+}
+
+// memoryLimiter is a per-process token bucket limiter
+// Used as a fallback when Redis is unavailable, so limits are enforced per replica
+type memoryLimiter struct {
+	mu          sync.Mutex
+	buckets     map[string]*tokenBucket
+	lastCleanup time.Time
+	now         func() time.Time // replaced in tests
+}
+
+// NewMemoryLimiter creates an in-memory token bucket limiter
+func NewMemoryLimiter() Limiter {
+	return newMemoryLimiter(time.Now)
+}
+
+func newMemoryLimiter(now func() time.Time) *memoryLimiter {
+	return &memoryLimiter{
+		buckets:     make(map[string]*tokenBucket),
+		lastCleanup: now(),
+		now:         now,
+	}
+}
+
+func (l *memoryLimiter) Allow(_ context.Context, key string, limit Limit) (Result, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.cleanup(now)
+
+	capacity := float64(limit.Requests)
+	ratePerSecond := capacity / limit.Window.Seconds()
+
+	bucket, ok := l.buckets[key]
+	if !ok {
+		bucket = &tokenBucket{tokens: capacity, lastSeen: now, window: limit.Window}
+		l.buckets[key] = bucket
+	}
+
+	// Refill tokens for the time elapsed since the last request
+	elapsed := now.Sub(bucket.lastSeen).Seconds()
+	bucket.tokens = min(capacity, bucket.tokens+elapsed*ratePerSecond)
+	bucket.lastSeen = now
+
+	if bucket.tokens >= 1 {
+		bucket.tokens--
+		return Result{Allowed: true}, nil
+	}
+
+	retryAfter := time.Duration((1 - bucket.tokens) / ratePerSecond * float64(time.Second))
+	return Result{Allowed: false, RetryAfter: retryAfter}, nil
+}
+
+// cleanup drops buckets that have been idle long enough to be full again
+func (l *memoryLimiter) cleanup(now time.Time) {
+	if now.Sub(l.lastCleanup) < cleanupInterval {
+		return
+	}
+	for key, bucket := range l.buckets {
+		if now.Sub(bucket.lastSeen) > bucket.window {
+			delete(l.buckets, key)
+		}
+	}
+	l.lastCleanup = now
+}
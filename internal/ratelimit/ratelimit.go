@@ -0,0 +1,105 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Limit defines how many requests are allowed per window
+type Limit struct {
+	Requests int
+	Window   time.Duration
+}
+
+// Result is the outcome of a single rate limit check
+type Result struct {
+	Allowed    bool
+	RetryAfter time.Duration
+}
+
+// Limiter decides whether a request identified by key is allowed under limit
+type Limiter interface {
+	Allow(ctx context.Context, key string, limit Limit) (Result, error)
+}
+
+// SlidingWindowStore is implemented by db.RedisClient
+type SlidingWindowStore interface {
+	AllowSlidingWindow(ctx context.Context, key string, limit int, window time.Duration) (bool, time.Duration, error)
+}
+
+// redisLimiter enforces limits across replicas with a Redis sliding window
+type redisLimiter struct {
+	store SlidingWindowStore
+}
+
+// NewRedisLimiter creates a limiter backed by a shared Redis sliding window
+func NewRedisLimiter(store SlidingWindowStore) Limiter {
+	return &redisLimiter{store: store}
+}
+
+func (l *redisLimiter) Allow(ctx context.Context, key string, limit Limit) (Result, error) {
+	allowed, retryAfter, err := l.store.AllowSlidingWindow(ctx, "ratelimit:"+key, limit.Requests, limit.Window)
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{Allowed: allowed, RetryAfter: retryAfter}, nil
+}
+
+// fallbackLimiter uses the primary limiter and degrades to the secondary one
+// when the primary returns an error (e.g. Redis outage)
+type fallbackLimiter struct {
+	primary   Limiter
+	secondary Limiter
+}
+
+// NewFallbackLimiter creates a limiter that falls back to secondary when primary fails
+func NewFallbackLimiter(primary, secondary Limiter) Limiter {
+	return &fallbackLimiter{primary: primary, secondary: secondary}
+}
+
+func (l *fallbackLimiter) Allow(ctx context.Context, key string, limit Limit) (Result, error) {
+	result, err := l.primary.Allow(ctx, key, limit)
+	if err == nil {
+		return result, nil
+	}
+
+	log.Printf("[RateLimit] WARN: Primary limiter failed, using in-memory fallback: key=%s, error=%v", key, err)
+	return l.secondary.Allow(ctx, key, limit)
+}
+
+// ParseRules parses a rule list such as "CreateArticle=20/1m,UpdateArticle=60/1m"
+// into a map of method name to limit
+func ParseRules(raw string) (map[string]Limit, error) {
+	rules := make(map[string]Limit)
+	for _, entry := range strings.Split(raw, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		method, spec, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid rate limit rule %q: expected method=requests/window", entry)
+		}
+		requestsStr, windowStr, ok := strings.Cut(spec, "/")
+		if !ok {
+			return nil, fmt.Errorf("invalid rate limit rule %q: expected method=requests/window", entry)
+		}
+
+		requests, err := strconv.Atoi(strings.TrimSpace(requestsStr))
+		if err != nil || requests <= 0 {
+			return nil, fmt.Errorf("invalid request count in rate limit rule %q", entry)
+		}
+		window, err := time.ParseDuration(strings.TrimSpace(windowStr))
+		if err != nil || window <= 0 {
+			return nil, fmt.Errorf("invalid window in rate limit rule %q", entry)
+		}
+
+		rules[strings.TrimSpace(method)] = Limit{Requests: requests, Window: window}
+	}
+	return rules, nil
+}
@@ -0,0 +1,206 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// fakeClock is a manually advanced clock for the in-memory limiter
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+// allowN calls Allow n times and returns how many requests were allowed
+func allowN(t *testing.T, limiter Limiter, key string, limit Limit, n int) int {
+	t.Helper()
+	allowed := 0
+	for i := 0; i < n; i++ {
+		result, err := limiter.Allow(context.Background(), key, limit)
+		if err != nil {
+			t.Fatalf("Allow error = %v", err)
+		}
+		if result.Allowed {
+			allowed++
+		}
+	}
+	return allowed
+}
+
+func TestMemoryLimiterTokenBucket(t *testing.T) {
+	clock := newFakeClock()
+	limiter := newMemoryLimiter(clock.Now)
+	limit := Limit{Requests: 3, Window: 3 * time.Second}
+
+	if got := allowN(t, limiter, "user:1", limit, 5); got != 3 {
+		t.Fatalf("allowed = %d, want the burst of 3", got)
+	}
+
+	result, err := limiter.Allow(context.Background(), "user:1", limit)
+	if err != nil {
+		t.Fatalf("Allow error = %v", err)
+	}
+	if result.Allowed || result.RetryAfter != time.Second {
+		t.Errorf("Allow = %+v, want denied with RetryAfter 1s", result)
+	}
+
+	// One token refills per second
+	clock.Advance(999 * time.Millisecond)
+	if got := allowN(t, limiter, "user:1", limit, 1); got != 0 {
+		t.Errorf("allowed before refill = %d, want 0", got)
+	}
+	clock.Advance(time.Millisecond)
+	if got := allowN(t, limiter, "user:1", limit, 2); got != 1 {
+		t.Errorf("allowed after 1s = %d, want 1", got)
+	}
+
+	// The bucket never holds more than the limit
+	clock.Advance(time.Hour)
+	if got := allowN(t, limiter, "user:1", limit, 5); got != 3 {
+		t.Errorf("allowed after idling = %d, want 3", got)
+	}
+}
+
+func TestMemoryLimiterKeysAreIndependent(t *testing.T) {
+	limiter := newMemoryLimiter(newFakeClock().Now)
+	limit := Limit{Requests: 1, Window: time.Minute}
+
+	if got := allowN(t, limiter, "user:1", limit, 2); got != 1 {
+		t.Errorf("user:1 allowed = %d, want 1", got)
+	}
+	if got := allowN(t, limiter, "user:2", limit, 2); got != 1 {
+		t.Errorf("user:2 allowed = %d, want 1", got)
+	}
+}
+
+func TestMemoryLimiterCleanup(t *testing.T) {
+	clock := newFakeClock()
+	limiter := newMemoryLimiter(clock.Now)
+
+	allowN(t, limiter, "idle", Limit{Requests: 1, Window: time.Second}, 1)
+	allowN(t, limiter, "busy", Limit{Requests: 1, Window: time.Hour}, 1)
+
+	clock.Advance(cleanupInterval)
+	allowN(t, limiter, "busy", Limit{Requests: 1, Window: time.Hour}, 1)
+
+	if _, ok := limiter.buckets["idle"]; ok {
+		t.Error("idle bucket kept after cleanup, want it dropped")
+	}
+	if _, ok := limiter.buckets["busy"]; !ok {
+		t.Error("busy bucket dropped by cleanup, want it kept")
+	}
+}
+
+// fakeStore is a SlidingWindowStore returning a fixed answer
+type fakeStore struct {
+	allowed    bool
+	retryAfter time.Duration
+	err        error
+
+	key    string
+	limit  int
+	window time.Duration
+}
+
+func (s *fakeStore) AllowSlidingWindow(_ context.Context, key string, limit int, window time.Duration) (bool, time.Duration, error) {
+	s.key, s.limit, s.window = key, limit, window
+	return s.allowed, s.retryAfter, s.err
+}
+
+func TestRedisLimiter(t *testing.T) {
+	store := &fakeStore{allowed: false, retryAfter: 2 * time.Second}
+	limiter := NewRedisLimiter(store)
+
+	result, err := limiter.Allow(context.Background(), "CreateArticle:user:1", Limit{Requests: 20, Window: time.Minute})
+	if err != nil {
+		t.Fatalf("Allow error = %v", err)
+	}
+	if result.Allowed || result.RetryAfter != 2*time.Second {
+		t.Errorf("Allow = %+v, want denied with RetryAfter 2s", result)
+	}
+	if store.key != "ratelimit:CreateArticle:user:1" || store.limit != 20 || store.window != time.Minute {
+		t.Errorf("store called with (%q, %d, %v), want (ratelimit:CreateArticle:user:1, 20, 1m)", store.key, store.limit, store.window)
+	}
+
+	store.err = errors.New("connection refused")
+	if _, err := limiter.Allow(context.Background(), "CreateArticle:user:1", Limit{Requests: 20, Window: time.Minute}); err == nil {
+		t.Error("Allow error = nil, want the store error")
+	}
+}
+
+func TestFallbackLimiter(t *testing.T) {
+	limit := Limit{Requests: 1, Window: time.Minute}
+
+	t.Run("primary healthy", func(t *testing.T) {
+		primary := &fakeStore{allowed: false, retryAfter: time.Second}
+		limiter := NewFallbackLimiter(NewRedisLimiter(primary), newMemoryLimiter(newFakeClock().Now))
+
+		result, err := limiter.Allow(context.Background(), "user:1", limit)
+		if err != nil {
+			t.Fatalf("Allow error = %v", err)
+		}
+		if result.Allowed {
+			t.Error("Allow = allowed, want the primary's denial")
+		}
+	})
+
+	t.Run("primary failing", func(t *testing.T) {
+		primary := &fakeStore{err: errors.New("connection refused")}
+		limiter := NewFallbackLimiter(NewRedisLimiter(primary), newMemoryLimiter(newFakeClock().Now))
+
+		// The in-memory limiter still enforces the limit during the outage
+		if got := allowN(t, limiter, "user:1", limit, 3); got != 1 {
+			t.Errorf("allowed = %d, want 1", got)
+		}
+	})
+}
+
+func TestParseRules(t *testing.T) {
+	rules, err := ParseRules(" CreateArticle=20/1m, UpdateArticle = 60 / 1h ,")
+	if err != nil {
+		t.Fatalf("ParseRules error = %v", err)
+	}
+	want := map[string]Limit{
+		"CreateArticle": {Requests: 20, Window: time.Minute},
+		"UpdateArticle": {Requests: 60, Window: time.Hour},
+	}
+	if len(rules) != len(want) {
+		t.Fatalf("ParseRules = %v, want %v", rules, want)
+	}
+	for method, limit := range want {
+		if rules[method] != limit {
+			t.Errorf("rules[%s] = %+v, want %+v", method, rules[method], limit)
+		}
+	}
+
+	if rules, err := ParseRules(""); err != nil || len(rules) != 0 {
+		t.Errorf("ParseRules(\"\") = (%v, %v), want no rules", rules, err)
+	}
+
+	for _, raw := range []string{
+		"CreateArticle",
+		"CreateArticle=20",
+		"CreateArticle=many/1m",
+		"CreateArticle=0/1m",
+		"CreateArticle=-1/1m",
+		"CreateArticle=20/soon",
+		"CreateArticle=20/0s",
+	} {
+		if _, err := ParseRules(raw); err == nil {
+			t.Errorf("ParseRules(%q) error = nil, want an error", raw)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+// Package render turns article content into sanitised HTML.
+package render
+
+import (
+	"bytes"
+	"fmt"
+
+	pb "github.com/thatlq1812/service-2-article/proto"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+)
+
+var (
+	// markdown renders CommonMark with GFM extensions (tables, strikethrough, autolinks, task lists).
+	// Raw HTML in Markdown is dropped by goldmark and the output is sanitised again below.
+	markdown = goldmark.New(goldmark.WithExtensions(extension.GFM))
+
+	// policy is the XSS-safe allowlist for user-generated content
+	policy = newPolicy()
+)
+
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	// GFM task lists render as disabled checkboxes
+	p.AllowAttrs("type").Matching(bluemonday.SpaceSeparatedTokens).OnElements("input")
+	p.AllowAttrs("checked", "disabled").OnElements("input")
+	p.RequireNoFollowOnLinks(true)
+	p.AddTargetBlankToFullyQualifiedLinks(true)
+	return p
+}
+
+// Rendered is article content prepared for storage
+type Rendered struct {
+	Content string // Content to store; sanitised for HTML input
+	HTML    string // Sanitised HTML, empty for PLAIN
+	Text    string // Plain text with markup removed, for excerpts and word counts
+}
+
+// Prepare normalises content before it is stored and renders the HTML to store alongside it.
+// HTML input is sanitised so stored content is always safe; PLAIN content has no HTML.
+func Prepare(format pb.ContentFormat, content string) (Rendered, error) {
+	switch format {
+	case pb.ContentFormat_CONTENT_FORMAT_PLAIN:
+		return Rendered{Content: content, Text: content}, nil
+	case pb.ContentFormat_CONTENT_FORMAT_MARKDOWN:
+		html, err := Markdown(content)
+		if err != nil {
+			return Rendered{}, err
+		}
+		return Rendered{Content: content, HTML: html, Text: TextFromHTML(html)}, nil
+	case pb.ContentFormat_CONTENT_FORMAT_HTML:
+		safe := SanitizeHTML(content)
+		return Rendered{Content: safe, HTML: safe, Text: TextFromHTML(safe)}, nil
+	default:
+		return Rendered{}, fmt.Errorf("unsupported content format %d", format)
+	}
+}
+
+// Markdown renders Markdown to sanitised HTML
+func Markdown(source string) (string, error) {
+	var buf bytes.Buffer
+	if err := markdown.Convert([]byte(source), &buf); err != nil {
+		return "", fmt.Errorf("render markdown failed: %w", err)
+	}
+	return policy.Sanitize(buf.String()), nil
+}
+
+// SanitizeHTML strips everything outside the allowlist (scripts, event handlers, javascript: URLs, ...)
+func SanitizeHTML(html string) string {
+	return policy.Sanitize(html)
+}
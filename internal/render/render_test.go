@@ -0,0 +1,79 @@
+package render_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/thatlq1812/service-2-article/internal/render"
+	pb "github.com/thatlq1812/service-2-article/proto"
+)
+
+func TestPrepare(t *testing.T) {
+	tests := []struct {
+		name        string
+		format      pb.ContentFormat
+		content     string
+		wantStored  string
+		wantHTML    []string // substrings expected in the HTML
+		wantMissing []string // substrings that must not survive
+	}{
+		{
+			name:       "plain",
+			format:     pb.ContentFormat_CONTENT_FORMAT_PLAIN,
+			content:    "Hello <b>world</b>",
+			wantStored: "Hello <b>world</b>",
+		},
+		{
+			name:        "markdown with table",
+			format:      pb.ContentFormat_CONTENT_FORMAT_MARKDOWN,
+			content:     "# Title\n\n| a | b |\n|---|---|\n| 1 | 2 |\n",
+			wantStored:  "# Title\n\n| a | b |\n|---|---|\n| 1 | 2 |\n",
+			wantHTML:    []string{"<h1", "Title</h1>", "<table>", "<td>1</td>"},
+			wantMissing: []string{"<script"},
+		},
+		{
+			name:        "markdown drops raw html and javascript links",
+			format:      pb.ContentFormat_CONTENT_FORMAT_MARKDOWN,
+			content:     "<script>alert(1)</script>\n\n[click](javascript:alert(1))",
+			wantStored:  "<script>alert(1)</script>\n\n[click](javascript:alert(1))",
+			wantMissing: []string{"<script", "javascript:"},
+		},
+		{
+			name:        "html is sanitised on write",
+			format:      pb.ContentFormat_CONTENT_FORMAT_HTML,
+			content:     `<p onclick="steal()">Hi</p><script>alert(1)</script>`,
+			wantStored:  "<p>Hi</p>",
+			wantHTML:    []string{"<p>Hi</p>"},
+			wantMissing: []string{"onclick", "<script"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rendered, err := render.Prepare(tt.format, tt.content)
+			if err != nil {
+				t.Fatalf("Prepare failed: %v", err)
+			}
+			if rendered.Content != tt.wantStored {
+				t.Errorf("Prepare stored = %q, want %q", rendered.Content, tt.wantStored)
+			}
+			html := rendered.HTML
+			for _, want := range tt.wantHTML {
+				if !strings.Contains(html, want) {
+					t.Errorf("Prepare html = %q, want it to contain %q", html, want)
+				}
+			}
+			for _, bad := range tt.wantMissing {
+				if strings.Contains(html, bad) {
+					t.Errorf("Prepare html = %q, must not contain %q", html, bad)
+				}
+			}
+		})
+	}
+}
+
+func TestPrepareUnknownFormat(t *testing.T) {
+	if _, err := render.Prepare(pb.ContentFormat(42), "x"); err == nil {
+		t.Error("Prepare(unknown format) succeeded, want error")
+	}
+}
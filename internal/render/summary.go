@@ -0,0 +1,94 @@
+package render
+
+import (
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+const (
+	// ExcerptLength is the maximum excerpt length in characters
+	ExcerptLength = 200
+	// WordsPerMinute is the reading speed used for reading-time estimates
+	WordsPerMinute = 200
+)
+
+// Summary is the list-view metadata derived from article text
+type Summary struct {
+	Excerpt        string
+	WordCount      int
+	ReadingMinutes int
+}
+
+// Summarize computes the excerpt, word count and reading time of plain text
+func Summarize(text string) Summary {
+	words := strings.Fields(text)
+	return Summary{
+		Excerpt:        excerpt(strings.Join(words, " "), ExcerptLength),
+		WordCount:      len(words),
+		ReadingMinutes: int(math.Ceil(float64(len(words)) / WordsPerMinute)),
+	}
+}
+
+// excerpt shortens whitespace-normalised text to at most limit characters.
+// It prefers ending at a sentence boundary in the second half of the window,
+// otherwise cuts at the last word boundary and appends an ellipsis.
+func excerpt(text string, limit int) string {
+	if utf8.RuneCountInString(text) <= limit {
+		return text
+	}
+
+	window := string([]rune(text)[:limit])
+	if end := lastSentenceEnd(window); end >= len(window)/2 {
+		return window[:end]
+	}
+	if space := strings.LastIndexByte(window, ' '); space > 0 {
+		window = window[:space]
+	}
+	return strings.TrimRightFunc(window, func(r rune) bool {
+		return unicode.IsSpace(r) || unicode.IsPunct(r)
+	}) + "…"
+}
+
+// lastSentenceEnd returns the byte offset just past the last ". ", "! " or "? " in s, or -1
+func lastSentenceEnd(s string) int {
+	end := -1
+	for i := 0; i+1 < len(s); i++ {
+		if (s[i] == '.' || s[i] == '!' || s[i] == '?') && s[i+1] == ' ' {
+			end = i + 1
+		}
+	}
+	return end
+}
+
+// blockElements separate words even when the HTML has no whitespace between them
+var blockElements = map[atom.Atom]bool{
+	atom.P: true, atom.Br: true, atom.Div: true, atom.Li: true, atom.Ul: true, atom.Ol: true,
+	atom.H1: true, atom.H2: true, atom.H3: true, atom.H4: true, atom.H5: true, atom.H6: true,
+	atom.Blockquote: true, atom.Pre: true, atom.Hr: true,
+	atom.Table: true, atom.Tr: true, atom.Td: true, atom.Th: true,
+}
+
+// TextFromHTML strips tags and decodes entities, keeping block elements apart
+func TextFromHTML(source string) string {
+	var b strings.Builder
+	tokenizer := html.NewTokenizer(strings.NewReader(source))
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			// io.EOF, or malformed input: return what was read
+			return strings.TrimSpace(b.String())
+		case html.TextToken:
+			b.Write(tokenizer.Text())
+		case html.StartTagToken, html.EndTagToken, html.SelfClosingTagToken:
+			name, _ := tokenizer.TagName()
+			if blockElements[atom.Lookup(name)] {
+				b.WriteByte(' ')
+			}
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package render_test
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/thatlq1812/service-2-article/internal/render"
+	pb "github.com/thatlq1812/service-2-article/proto"
+)
+
+func TestSummarize(t *testing.T) {
+	short := render.Summarize("  Hello   world.\n\nSecond line ")
+	if short.Excerpt != "Hello world. Second line" || short.WordCount != 4 || short.ReadingMinutes != 1 {
+		t.Errorf("Summarize(short) = %+v, want full normalised text, 4 words, 1 minute", short)
+	}
+
+	if empty := render.Summarize(""); empty != (render.Summary{}) {
+		t.Errorf("Summarize(\"\") = %+v, want zero summary", empty)
+	}
+
+	long := render.Summarize(strings.Repeat("word ", 450))
+	if long.WordCount != 450 || long.ReadingMinutes != 3 {
+		t.Errorf("Summarize(450 words) = %d words, %d minutes; want 450, 3", long.WordCount, long.ReadingMinutes)
+	}
+	if !strings.HasSuffix(long.Excerpt, "word…") || utf8.RuneCountInString(long.Excerpt) > render.ExcerptLength+1 {
+		t.Errorf("Summarize(450 words) excerpt = %q, want cut at a word boundary with an ellipsis", long.Excerpt)
+	}
+
+	sentences := strings.Repeat("This sentence has exactly seven words in it. ", 10)
+	if got := render.Summarize(sentences).Excerpt; !strings.HasSuffix(got, "in it.") {
+		t.Errorf("Summarize(sentences) excerpt = %q, want it to end at a sentence boundary", got)
+	}
+}
+
+func TestSummarizeStripsMarkdown(t *testing.T) {
+	rendered, err := render.Prepare(pb.ContentFormat_CONTENT_FORMAT_MARKDOWN, "# Title\n\nSome **bold** text &amp; [a link](https://example.com).")
+	if err != nil {
+		t.Fatalf("Prepare failed: %v", err)
+	}
+
+	summary := render.Summarize(rendered.Text)
+	if summary.Excerpt != "Title Some bold text & a link." {
+		t.Errorf("Summarize(markdown) excerpt = %q, want markup removed", summary.Excerpt)
+	}
+	if summary.WordCount != 7 {
+		t.Errorf("Summarize(markdown) word count = %d, want 7", summary.WordCount)
+	}
+}
@@ -0,0 +1,311 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"slices"
+	"sync"
+	"time"
+
+	pb "github.com/thatlq1812/service-2-article/proto"
+
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/protobuf/proto"
+)
+
+// ArticleCache is a byte-oriented cache with TTL (implemented by db.RedisClient)
+type ArticleCache interface {
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	Delete(ctx context.Context, keys ...string) error
+}
+
+// articleCacheRepo decorates an ArticleRepository with a read-through cache for GetByID and
+// RelatedIDs. Entries are invalidated on Update/Delete; cache errors fall back to the wrapped repository.
+type articleCacheRepo struct {
+	next  ArticleRepository
+	cache ArticleCache
+	ttl   time.Duration
+	// group collapses concurrent misses for the same article into one database query
+	group singleflight.Group
+}
+
+// NewArticleCacheRepository wraps next with a read-through cache
+func NewArticleCacheRepository(next ArticleRepository, cache ArticleCache, ttl time.Duration) ArticleRepository {
+	return &articleCacheRepo{
+		next:  next,
+		cache: cache,
+		ttl:   ttl,
+	}
+}
+
+func articleCacheKey(id int32) string {
+	return fmt.Sprintf("article:%d", id)
+}
+
+func relatedCacheKey(id int32) string {
+	return fmt.Sprintf("article:%d:related", id)
+}
+
+// loadTimeout bounds a load shared by concurrent misses, which no single caller's context covers
+const loadTimeout = 5 * time.Second
+
+// load runs fn once for concurrent misses of key and caches the encoded value fn returns.
+// fn is detached from the context of the caller that started it, so one caller giving up does
+// not fail the others; each caller stops waiting when its own ctx is done.
+func (r *articleCacheRepo) load(ctx context.Context, key string, fn func(ctx context.Context) (interface{}, []byte, error)) (interface{}, bool, error) {
+	results := r.group.DoChan(key, func() (interface{}, error) {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), loadTimeout)
+		defer cancel()
+
+		generation := generations.begin(key)
+		defer generations.end(key)
+
+		value, data, err := fn(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if data != nil {
+			r.store(ctx, key, data, generation)
+		}
+		return value, nil
+	})
+	select {
+	case result := <-results:
+		return result.Val, result.Shared, result.Err
+	case <-ctx.Done():
+		return nil, false, ctx.Err()
+	}
+}
+
+// store caches data loaded at generation, unless key was invalidated since. An invalidation that
+// lands between the check and the write is caught by the second check, which deletes the entry.
+func (r *articleCacheRepo) store(ctx context.Context, key string, data []byte, generation uint64) {
+	if generations.changed(key, generation) {
+		return
+	}
+	if err := r.cache.Set(ctx, key, data, r.ttl); err != nil {
+		log.Printf("[ArticleCache] WARN: Cache write failed: key=%s, error=%v", key, err)
+		return
+	}
+	if generations.changed(key, generation) {
+		if err := r.cache.Delete(ctx, key); err != nil {
+			log.Printf("[ArticleCache] ERROR: Stale cache entry not deleted: key=%s, error=%v", key, err)
+		}
+	}
+}
+
+// relatedCacheSize is the number of related IDs cached per article, the largest limit
+// GetRelatedArticles accepts; larger limits bypass the cache
+const relatedCacheSize = 20
+
+// GetByID returns the cached article or loads it from the wrapped repository.
+// Whole articles are cached; requested fields are projected from them.
+func (r *articleCacheRepo) GetByID(ctx context.Context, id int32, fields ...string) (*pb.Article, error) {
+	key := articleCacheKey(id)
+
+	data, found, err := r.cache.Get(ctx, key)
+	if err != nil {
+		log.Printf("[ArticleCache] WARN: Cache read failed, falling back to database: key=%s, error=%v", key, err)
+	} else if found {
+		var article pb.Article
+		if err := proto.Unmarshal(data, &article); err == nil {
+			return projectArticle(&article, fields), nil
+		}
+		log.Printf("[ArticleCache] WARN: Corrupt cache entry, reloading: key=%s", key)
+	}
+
+	result, shared, err := r.load(ctx, key, func(ctx context.Context) (interface{}, []byte, error) {
+		article, err := r.next.GetByID(ctx, id)
+		if err != nil {
+			return nil, nil, err
+		}
+		data, _ := proto.Marshal(article)
+		return article, data, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	article := result.(*pb.Article)
+	if shared {
+		// Callers must not share one mutable message
+		article = proto.Clone(article).(*pb.Article)
+	}
+	return projectArticle(article, fields), nil
+}
+
+// RelatedIDs caches the first relatedCacheSize IDs of each article and slices them to limit.
+// The list is recomputed when the article changes or the entry expires, so articles created or
+// edited since may be missing and deleted ones present until then
+func (r *articleCacheRepo) RelatedIDs(ctx context.Context, id, limit int32) ([]int32, error) {
+	if limit > relatedCacheSize {
+		return r.next.RelatedIDs(ctx, id, limit)
+	}
+	key := relatedCacheKey(id)
+
+	data, found, err := r.cache.Get(ctx, key)
+	if err != nil {
+		log.Printf("[ArticleCache] WARN: Cache read failed, falling back to database: key=%s, error=%v", key, err)
+	} else if found {
+		var ids []int32
+		if err := json.Unmarshal(data, &ids); err == nil {
+			return ids[:min(int(limit), len(ids))], nil
+		}
+		log.Printf("[ArticleCache] WARN: Corrupt cache entry, reloading: key=%s", key)
+	}
+
+	result, _, err := r.load(ctx, key, func(ctx context.Context) (interface{}, []byte, error) {
+		ids, err := r.next.RelatedIDs(ctx, id, relatedCacheSize)
+		if err != nil {
+			return nil, nil, err
+		}
+		data, _ := json.Marshal(ids)
+		return ids, data, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Callers collapsed by the group must not share one slice
+	ids := result.([]int32)
+	return slices.Clone(ids[:min(int(limit), len(ids))]), nil
+}
+
+// Create new article (not cached until first read)
+func (r *articleCacheRepo) Create(ctx context.Context, article *pb.Article) (*pb.Article, error) {
+	return r.next.Create(ctx, article)
+}
+
+// Update article and invalidate its cache entry
+func (r *articleCacheRepo) Update(ctx context.Context, article *pb.Article) (*pb.Article, error) {
+	updated, err := r.next.Update(ctx, article)
+	if err != nil {
+		return nil, err
+	}
+	r.invalidate(ctx, article.Id)
+	return updated, nil
+}
+
+// Delete article and invalidate its cache entry
+func (r *articleCacheRepo) Delete(ctx context.Context, id int32) error {
+	if err := r.next.Delete(ctx, id); err != nil {
+		return err
+	}
+	r.invalidate(ctx, id)
+	return nil
+}
+
+// ListByUser is not cached
+func (r *articleCacheRepo) ListByUser(ctx context.Context, userId, limit, offset int32, fields ...string) ([]*pb.Article, int32, error) {
+	return r.next.ListByUser(ctx, userId, limit, offset, fields...)
+}
+
+// ListAll is not cached
+func (r *articleCacheRepo) ListAll(ctx context.Context, limit, offset int32, fields ...string) ([]*pb.Article, int32, error) {
+	return r.next.ListAll(ctx, limit, offset, fields...)
+}
+
+// ListByIDs is not cached
+func (r *articleCacheRepo) ListByIDs(ctx context.Context, ids []int32, fields ...string) ([]*pb.Article, error) {
+	return r.next.ListByIDs(ctx, ids, fields...)
+}
+
+// ListPopular is not cached
+func (r *articleCacheRepo) ListPopular(ctx context.Context, userID, limit, offset int32, fields ...string) ([]*pb.Article, int32, error) {
+	return r.next.ListPopular(ctx, userID, limit, offset, fields...)
+}
+
+// Export is not cached
+func (r *articleCacheRepo) Export(ctx context.Context, filter ExportFilter, fields []string, fn func(*pb.Article) error) error {
+	return r.next.Export(ctx, filter, fields, fn)
+}
+
+// Import creates new articles only, so no cache entry can be stale
+func (r *articleCacheRepo) Import(ctx context.Context, articles []*pb.Article) error {
+	return r.next.Import(ctx, articles)
+}
+
+// invalidate deletes the article and its related list; lists of other articles that include it
+// expire after the TTL. Later misses start a new load instead of joining one already in flight.
+func (r *articleCacheRepo) invalidate(ctx context.Context, id int32) {
+	invalidateArticle(ctx, r.cache, id)
+	r.group.Forget(articleCacheKey(id))
+
+	key := relatedCacheKey(id)
+	generations.bump(key)
+	r.group.Forget(key)
+	if err := r.cache.Delete(ctx, key); err != nil {
+		log.Printf("[ArticleCache] ERROR: Cache invalidation failed: key=%s, error=%v", key, err)
+	}
+}
+
+// invalidateArticle deletes the cache entry of article id and stops loads in flight from
+// writing back what they read before the change
+func invalidateArticle(ctx context.Context, cache ArticleCache, id int32) {
+	key := articleCacheKey(id)
+	generations.bump(key)
+	if err := cache.Delete(ctx, key); err != nil {
+		// The entry will still expire after the TTL
+		log.Printf("[ArticleCache] ERROR: Cache invalidation failed: key=%s, error=%v", key, err)
+	}
+}
+
+// generations is shared by every cache decorator in the process, so comment and reaction
+// changes also stop stale loads. Other replicas' changes are only bounded by the TTL.
+var generations = &cacheGenerations{keys: make(map[string]*keyGeneration)}
+
+// cacheGenerations counts invalidations of keys with a load in flight. A key is only tracked
+// while it is being loaded, so the map stays as small as the number of concurrent loads.
+type cacheGenerations struct {
+	mu   sync.Mutex
+	keys map[string]*keyGeneration
+}
+
+type keyGeneration struct {
+	loads      int
+	generation uint64
+}
+
+// begin registers a load of key and returns its current generation
+func (g *cacheGenerations) begin(key string) uint64 {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	state, ok := g.keys[key]
+	if !ok {
+		state = &keyGeneration{}
+		g.keys[key] = state
+	}
+	state.loads++
+	return state.generation
+}
+
+// end unregisters a load of key started with begin
+func (g *cacheGenerations) end(key string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if state, ok := g.keys[key]; ok {
+		if state.loads--; state.loads == 0 {
+			delete(g.keys, key)
+		}
+	}
+}
+
+// bump records an invalidation of key; it must happen before the cache entry is deleted
+func (g *cacheGenerations) bump(key string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if state, ok := g.keys[key]; ok {
+		state.generation++
+	}
+}
+
+// changed reports whether key was invalidated since a load began at generation
+func (g *cacheGenerations) changed(key string, generation uint64) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	state, ok := g.keys[key]
+	return ok && state.generation != generation
+}
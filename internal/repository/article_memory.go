@@ -0,0 +1,360 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	pb "github.com/thatlq1812/service-2-article/proto"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// memoryArticle keeps full-precision timestamps next to the stored article
+type memoryArticle struct {
+	article   *pb.Article
+	createdAt time.Time
+}
+
+// articleMemoryRepo implement ArticleRepository in memory
+// Thread-safe and mirrors the PostgreSQL semantics: created_at DESC ordering, totals, not-found errors
+type articleMemoryRepo struct {
+	mu       sync.RWMutex
+	articles map[int32]*memoryArticle
+	nextID   int32
+}
+
+// NewArticleMemoryRepository creates an empty in-memory repository (for tests and local development)
+func NewArticleMemoryRepository() ArticleRepository {
+	return &articleMemoryRepo{
+		articles: make(map[int32]*memoryArticle),
+		nextID:   1,
+	}
+}
+
+// GetByID
+func (r *articleMemoryRepo) GetByID(ctx context.Context, id int32, fields ...string) (*pb.Article, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	stored, ok := r.articles[id]
+	if !ok {
+		return nil, fmt.Errorf("article with ID %d: %w", id, ErrNotFound)
+	}
+	return projectArticle(cloneArticle(stored.article), fields), nil
+}
+
+// Create new article
+func (r *articleMemoryRepo) Create(ctx context.Context, input *pb.Article) (*pb.Article, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	article := cloneArticle(input)
+	article.Id = r.nextID
+	copyCounters(article, &pb.Article{})
+	article.CreatedAt = formatTime(now)
+	article.UpdatedAt = formatTime(now)
+	article.CreateTime = timestamppb.New(now)
+	article.UpdateTime = timestamppb.New(now)
+	r.articles[article.Id] = &memoryArticle{article: article, createdAt: now}
+	r.nextID++
+
+	return cloneArticle(article), nil
+}
+
+// Import inserts every article under one lock, so the batch appears at once
+func (r *articleMemoryRepo) Import(ctx context.Context, articles []*pb.Article) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	for _, input := range articles {
+		createdAt, updatedAt := importTimes(input, now)
+		article := cloneArticle(input)
+		article.Id = r.nextID
+		copyCounters(article, &pb.Article{})
+		article.CreatedAt = formatTime(createdAt)
+		article.UpdatedAt = formatTime(updatedAt)
+		article.CreateTime = timestamppb.New(createdAt)
+		article.UpdateTime = timestamppb.New(updatedAt)
+		r.articles[article.Id] = &memoryArticle{article: article, createdAt: createdAt}
+		r.nextID++
+	}
+	return nil
+}
+
+// Update
+func (r *articleMemoryRepo) Update(ctx context.Context, input *pb.Article) (*pb.Article, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.articles[input.Id]
+	if !ok {
+		return nil, fmt.Errorf("article with ID %d: %w", input.Id, ErrNotFound)
+	}
+
+	article := cloneArticle(input)
+	article.UserId = stored.article.UserId
+	copyCounters(article, stored.article)
+	now := time.Now()
+	article.CreatedAt = stored.article.CreatedAt
+	article.CreateTime = stored.article.CreateTime
+	article.UpdatedAt = formatTime(now)
+	article.UpdateTime = timestamppb.New(now)
+	stored.article = article
+
+	return cloneArticle(article), nil
+}
+
+// Delete article
+func (r *articleMemoryRepo) Delete(ctx context.Context, id int32) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.articles[id]; !ok {
+		return fmt.Errorf("article with ID %d: %w", id, ErrNotFound)
+	}
+	delete(r.articles, id)
+	return nil
+}
+
+// updateCounters lets the comment and reaction repositories change the counters of an article
+// (see NewCommentMemoryRepository); missing articles are skipped
+func (r *articleMemoryRepo) updateCounters(id int32, update func(article *pb.Article)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if stored, ok := r.articles[id]; ok {
+		update(stored.article)
+	}
+}
+
+// ListByUser
+func (r *articleMemoryRepo) ListByUser(ctx context.Context, userID, limit, offset int32, fields ...string) ([]*pb.Article, int32, error) {
+	return r.list(func(a *pb.Article) bool { return a.UserId == userID }, newestFirst, limit, offset, fields)
+}
+
+// ListAll articles
+func (r *articleMemoryRepo) ListAll(ctx context.Context, limit, offset int32, fields ...string) ([]*pb.Article, int32, error) {
+	return r.list(func(*pb.Article) bool { return true }, newestFirst, limit, offset, fields)
+}
+
+// ListByIDs
+func (r *articleMemoryRepo) ListByIDs(ctx context.Context, ids []int32, fields ...string) ([]*pb.Article, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var articles []*pb.Article
+	for _, stored := range r.articles {
+		if slices.Contains(ids, stored.article.Id) {
+			articles = append(articles, projectArticle(cloneArticle(stored.article), fields))
+		}
+	}
+	return orderByIDs(articles, ids), nil
+}
+
+// ListPopular
+func (r *articleMemoryRepo) ListPopular(ctx context.Context, userID, limit, offset int32, fields ...string) ([]*pb.Article, int32, error) {
+	match := func(a *pb.Article) bool { return userID <= 0 || a.UserId == userID }
+	return r.list(match, mostReactedFirst, limit, offset, fields)
+}
+
+// Export snapshots the matching articles, then calls fn outside the lock
+func (r *articleMemoryRepo) Export(ctx context.Context, filter ExportFilter, fields []string, fn func(*pb.Article) error) error {
+	r.mu.RLock()
+	var snapshot []*pb.Article
+	for _, stored := range r.articles {
+		if filter.Matches(stored.article.UserId, stored.createdAt) {
+			snapshot = append(snapshot, projectArticle(cloneArticle(stored.article), fields))
+		}
+	}
+	r.mu.RUnlock()
+
+	sort.Slice(snapshot, func(i, j int) bool { return snapshot[i].Id < snapshot[j].Id })
+	for _, article := range snapshot {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := fn(article); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RelatedIDs approximates the PostgreSQL ranking: lower-cased words instead of English stems,
+// and a similarity that grows with the number of top terms matched, title matches counting more
+func (r *articleMemoryRepo) RelatedIDs(ctx context.Context, id, limit int32) ([]int32, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	source, ok := r.articles[id]
+	if !ok {
+		return nil, nil
+	}
+	top := topTerms(articleTerms(source.article), relatedTerms)
+
+	type candidate struct {
+		id    int32
+		score float64
+	}
+	var candidates []candidate
+	for _, stored := range r.articles {
+		if stored.article.Id == id {
+			continue
+		}
+		terms := articleTerms(stored.article)
+		var rank float64
+		for _, term := range top {
+			if stats, ok := terms[term]; ok {
+				rank += stats.rank()
+			}
+		}
+		sameAuthor := stored.article.UserId == source.article.UserId
+		if rank == 0 && !sameAuthor {
+			continue
+		}
+		score := rank / (rank + 1)
+		if sameAuthor {
+			score += sameAuthorBoost
+		}
+		candidates = append(candidates, candidate{id: stored.article.Id, score: score})
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].score != candidates[j].score {
+			return candidates[i].score > candidates[j].score
+		}
+		return candidates[i].id > candidates[j].id
+	})
+	ids := make([]int32, 0, min(int(limit), len(candidates)))
+	for _, c := range candidates[:min(int(limit), len(candidates))] {
+		ids = append(ids, c.id)
+	}
+	return ids, nil
+}
+
+// newestFirst orders by created_at DESC, then id DESC
+func newestFirst(a, b *memoryArticle) bool {
+	if a.createdAt.Equal(b.createdAt) {
+		return a.article.Id > b.article.Id
+	}
+	return a.createdAt.After(b.createdAt)
+}
+
+// mostReactedFirst orders by reaction_count DESC, then like newestFirst
+func mostReactedFirst(a, b *memoryArticle) bool {
+	if a.article.ReactionCount != b.article.ReactionCount {
+		return a.article.ReactionCount > b.article.ReactionCount
+	}
+	return newestFirst(a, b)
+}
+
+// list filters, sorts with less, paginates and projects fields
+func (r *articleMemoryRepo) list(match func(*pb.Article) bool, less func(a, b *memoryArticle) bool, limit, offset int32, fields []string) ([]*pb.Article, int32, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var matched []*memoryArticle
+	for _, stored := range r.articles {
+		if match(stored.article) {
+			matched = append(matched, stored)
+		}
+	}
+
+	sort.Slice(matched, func(i, j int) bool { return less(matched[i], matched[j]) })
+
+	total := int32(len(matched))
+	if offset < 0 {
+		offset = 0
+	}
+	var articles []*pb.Article
+	for i := offset; i < total && int32(len(articles)) < limit; i++ {
+		articles = append(articles, projectArticle(cloneArticle(matched[i].article), fields))
+	}
+
+	return articles, total, nil
+}
+
+// copyCounters copies the counters kept by the comment, reaction and view repositories from src to dst
+func copyCounters(dst, src *pb.Article) {
+	dst.CommentCount = src.CommentCount
+	dst.ReactionCount = src.ReactionCount
+	dst.ReactionCounts = src.ReactionCounts
+	dst.ViewCount = src.ViewCount
+}
+
+// termStats counts the occurrences of a word in an article
+type termStats struct {
+	inTitle bool
+	count   int
+}
+
+// rank weighs a title word like tsvector weight A and a content word like weight B
+func (t termStats) rank() float64 {
+	if t.inTitle {
+		return 1
+	}
+	return 0.4
+}
+
+// stopWords are skipped like the english text search configuration does
+var stopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "are": true, "as": true, "at": true, "be": true, "but": true,
+	"by": true, "for": true, "from": true, "has": true, "have": true, "in": true, "is": true, "it": true,
+	"its": true, "not": true, "of": true, "on": true, "or": true, "that": true, "the": true, "this": true,
+	"to": true, "was": true, "were": true, "will": true, "with": true,
+}
+
+// articleTerms splits title and content into lower-cased words without stop words
+func articleTerms(article *pb.Article) map[string]termStats {
+	terms := make(map[string]termStats)
+	add := func(text string, inTitle bool) {
+		words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})
+		for _, word := range words {
+			if len(word) < 2 || stopWords[word] {
+				continue
+			}
+			stats := terms[word]
+			stats.inTitle = stats.inTitle || inTitle
+			stats.count++
+			terms[word] = stats
+		}
+	}
+	add(article.Title, true)
+	add(article.Content, false)
+	return terms
+}
+
+// topTerms returns up to n terms: title words first, then the most frequent, then alphabetical
+func topTerms(terms map[string]termStats, n int) []string {
+	words := make([]string, 0, len(terms))
+	for word := range terms {
+		words = append(words, word)
+	}
+	sort.Slice(words, func(i, j int) bool {
+		a, b := terms[words[i]], terms[words[j]]
+		if a.inTitle != b.inTitle {
+			return a.inTitle
+		}
+		if a.count != b.count {
+			return a.count > b.count
+		}
+		return words[i] < words[j]
+	})
+	return words[:min(n, len(words))]
+}
+
+// cloneArticle returns a copy so callers cannot mutate stored state
+func cloneArticle(article *pb.Article) *pb.Article {
+	return proto.Clone(article).(*pb.Article)
+}
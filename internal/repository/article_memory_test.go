@@ -0,0 +1,159 @@
+package repository_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/thatlq1812/service-2-article/internal/repository"
+	"github.com/thatlq1812/service-2-article/internal/repository/repotest"
+	pb "github.com/thatlq1812/service-2-article/proto"
+)
+
+func TestArticleMemoryRepository(t *testing.T) {
+	repotest.RunArticleRepositoryContract(t, func(t *testing.T) repository.ArticleRepository {
+		return repository.NewArticleMemoryRepository()
+	})
+}
+
+func TestArticleCacheRepository(t *testing.T) {
+	repotest.RunArticleRepositoryContract(t, func(t *testing.T) repository.ArticleRepository {
+		return repository.NewArticleCacheRepository(repository.NewArticleMemoryRepository(), newMapCache(), time.Minute)
+	})
+}
+
+// blockingRepo holds GetByID until release is closed, failing if its context ends first
+type blockingRepo struct {
+	repository.ArticleRepository
+	started chan struct{}
+	release chan struct{}
+}
+
+func (r *blockingRepo) GetByID(ctx context.Context, id int32, fields ...string) (*pb.Article, error) {
+	close(r.started)
+	select {
+	case <-r.release:
+		return r.ArticleRepository.GetByID(ctx, id, fields...)
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func TestArticleCacheLoadOutlivesCaller(t *testing.T) {
+	ctx := context.Background()
+	articles := repository.NewArticleMemoryRepository()
+	article, err := articles.Create(ctx, &pb.Article{Title: "Title", Content: "Content", UserId: 1})
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	cache := newMapCache()
+	blocking := &blockingRepo{ArticleRepository: articles, started: make(chan struct{}), release: make(chan struct{})}
+	repo := repository.NewArticleCacheRepository(blocking, cache, time.Minute)
+
+	// The caller that starts the load gives up; the load still finishes and fills the cache
+	callerCtx, cancel := context.WithCancel(ctx)
+	done := make(chan error)
+	go func() {
+		_, err := repo.GetByID(callerCtx, article.Id)
+		done <- err
+	}()
+	<-blocking.started
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Fatalf("GetByID(cancelled) = %v, want context.Canceled", err)
+	}
+	close(blocking.release)
+
+	for deadline := time.Now().Add(time.Second); ; time.Sleep(time.Millisecond) {
+		if _, found, _ := cache.Get(ctx, fmt.Sprintf("article:%d", article.Id)); found {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("cache was not filled after the caller gave up")
+		}
+	}
+}
+
+// slowReadRepo reads the article in GetByID, then holds the result until release is closed
+type slowReadRepo struct {
+	repository.ArticleRepository
+	once    sync.Once
+	read    chan struct{}
+	release chan struct{}
+}
+
+func (r *slowReadRepo) GetByID(ctx context.Context, id int32, fields ...string) (*pb.Article, error) {
+	article, err := r.ArticleRepository.GetByID(ctx, id, fields...)
+	r.once.Do(func() { close(r.read) })
+	<-r.release
+	return article, err
+}
+
+func TestArticleCacheUpdateDuringLoad(t *testing.T) {
+	ctx := context.Background()
+	articles := repository.NewArticleMemoryRepository()
+	article, err := articles.Create(ctx, &pb.Article{Title: "Old", Content: "Content", UserId: 1})
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	slow := &slowReadRepo{ArticleRepository: articles, read: make(chan struct{}), release: make(chan struct{})}
+	repo := repository.NewArticleCacheRepository(slow, newMapCache(), time.Minute)
+
+	// A miss reads the old row, the update commits and invalidates, then the miss tries to cache what it read
+	done := make(chan error)
+	go func() {
+		_, err := repo.GetByID(ctx, article.Id)
+		done <- err
+	}()
+	<-slow.read
+	if _, err := repo.Update(ctx, &pb.Article{Id: article.Id, Title: "New", Content: "Content"}); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	close(slow.release)
+	if err := <-done; err != nil {
+		t.Fatalf("GetByID failed: %v", err)
+	}
+
+	got, err := repo.GetByID(ctx, article.Id)
+	if err != nil {
+		t.Fatalf("GetByID failed: %v", err)
+	}
+	if got.Title != "New" {
+		t.Errorf("GetByID after update = %q, want %q (stale entry cached)", got.Title, "New")
+	}
+}
+
+// mapCache is an in-process ArticleCache standing in for Redis
+type mapCache struct {
+	mu   sync.Mutex
+	data map[string][]byte
+}
+
+func newMapCache() *mapCache {
+	return &mapCache{data: make(map[string][]byte)}
+}
+
+func (c *mapCache) Get(_ context.Context, key string) ([]byte, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	value, ok := c.data[key]
+	return value, ok, nil
+}
+
+func (c *mapCache) Set(_ context.Context, key string, value []byte, _ time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.data[key] = value
+	return nil
+}
+
+func (c *mapCache) Delete(_ context.Context, keys ...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, key := range keys {
+		delete(c.data, key)
+	}
+	return nil
+}
@@ -2,14 +2,40 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/thatlq1812/service-2-article/internal/outbox"
 	pb "github.com/thatlq1812/service-2-article/proto"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// articleColumns selects every Article field
+var articleColumns = selectColumns(allArticleFields)
+
+// selectColumns returns the select list for fields; columns are named after the proto fields,
+// except the timestamps, which back both the Timestamp and the deprecated string fields
+func selectColumns(fields []string) string {
+	columns := make([]string, len(fields))
+	for i, field := range fields {
+		switch field {
+		case "create_time":
+			columns[i] = "created_at"
+		case "update_time":
+			columns[i] = "updated_at"
+		default:
+			columns[i] = field
+		}
+	}
+	return strings.Join(columns, ", ")
+}
+
 // articlePostgresRepo implement ArticleRepository with PostgreSQL
 type articlePostgresRepo struct {
 	db *pgxpool.Pool
@@ -20,148 +46,262 @@ func NewArticlePostgresRepository(db *pgxpool.Pool) ArticleRepository {
 	return &articlePostgresRepo{db: db}
 }
 
-// GetByID
-func (r *articlePostgresRepo) GetByID(ctx context.Context, id int32) (*pb.Article, error) {
-	query := `
-		SELECT id, title, content, user_id, created_at, updated_at
-		FROM articles
-		WHERE id = $1
-	`
-	var article pb.Article
-	var createdAt, updatedAt time.Time
+// scanArticle scans one row selected with articleColumns
+func scanArticle(row pgx.Row) (*pb.Article, error) {
+	return scanArticleFields(row, allArticleFields)
+}
 
-	err := r.db.QueryRow(ctx, query, id).Scan(
-		&article.Id,
-		&article.Title,
-		&article.Content,
-		&article.UserId,
-		&createdAt,
-		&updatedAt,
-	)
+// scanArticleFields scans one row whose columns are fields, in order
+func scanArticleFields(row pgx.Row, fields []string) (*pb.Article, error) {
+	var article pb.Article
+	var createdAt, updatedAt, createTime, updateTime time.Time
+	var format int32
+	var reactionCounts map[int32]int32 // JSON object keys are ReactionType numbers
+
+	dest := make([]interface{}, len(fields))
+	for i, field := range fields {
+		switch field {
+		case "id":
+			dest[i] = &article.Id
+		case "title":
+			dest[i] = &article.Title
+		case "content":
+			dest[i] = &article.Content
+		case "user_id":
+			dest[i] = &article.UserId
+		case "created_at":
+			dest[i] = &createdAt
+		case "updated_at":
+			dest[i] = &updatedAt
+		case "create_time":
+			dest[i] = &createTime
+		case "update_time":
+			dest[i] = &updateTime
+		case "content_format":
+			dest[i] = &format
+		case "content_html":
+			dest[i] = &article.ContentHtml
+		case "excerpt":
+			dest[i] = &article.Excerpt
+		case "word_count":
+			dest[i] = &article.WordCount
+		case "reading_time_minutes":
+			dest[i] = &article.ReadingTimeMinutes
+		case "comment_count":
+			dest[i] = &article.CommentCount
+		case "reaction_count":
+			dest[i] = &article.ReactionCount
+		case "reaction_counts":
+			dest[i] = &reactionCounts
+		case "view_count":
+			dest[i] = &article.ViewCount
+		default:
+			return nil, fmt.Errorf("no column for article field %q", field)
+		}
+	}
 
-	if err != nil {
-		return nil, fmt.Errorf("query article failed: %w", err)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
 	}
 
-	// Convert time to string
-	article.CreatedAt = createdAt.Format(time.RFC3339)
-	article.UpdatedAt = updatedAt.Format(time.RFC3339)
+	// TIMESTAMPTZ columns; strings are rendered in UTC so they never depend on server time zones
+	if !createdAt.IsZero() {
+		article.CreatedAt = formatTime(createdAt)
+	}
+	if !updatedAt.IsZero() {
+		article.UpdatedAt = formatTime(updatedAt)
+	}
+	if !createTime.IsZero() {
+		article.CreateTime = timestamppb.New(createTime)
+	}
+	if !updateTime.IsZero() {
+		article.UpdateTime = timestamppb.New(updateTime)
+	}
+	article.ContentFormat = pb.ContentFormat(format)
+	article.ReactionCounts = reactionCountList(reactionCounts)
 
 	return &article, nil
 }
 
-// Create new article
-func (r *articlePostgresRepo) Create(ctx context.Context, title, content string, userId int32) (*pb.Article, error) {
+// GetByID selects only the requested fields (all when none are given)
+func (r *articlePostgresRepo) GetByID(ctx context.Context, id int32, fields ...string) (*pb.Article, error) {
+	fields = loadFields(fields)
 	query := `
-		INSERT INTO articles (title, content, user_id, created_at, updated_at)
-		VALUES ($1, $2, $3, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
-		RETURNING id, title, content, user_id, created_at, updated_at
+		SELECT ` + selectColumns(fields) + `
+		FROM articles
+		WHERE id = $1
 	`
-	var article pb.Article
-	var createdAt, updatedAt time.Time
+	article, err := scanArticleFields(r.db.QueryRow(ctx, query, id), fields)
+	if err != nil {
+		return nil, fmt.Errorf("query article failed: %w", mapPgError(err))
+	}
+	return article, nil
+}
 
-	err := r.db.QueryRow(ctx, query, title, content, userId).Scan(
-		&article.Id,
-		&article.Title,
-		&article.Content,
-		&article.UserId,
-		&createdAt,
-		&updatedAt,
-	)
+// Create new article, with its ArticleCreated event in the same transaction
+func (r *articlePostgresRepo) Create(ctx context.Context, article *pb.Article) (*pb.Article, error) {
+	query := `
+		INSERT INTO articles (title, content, user_id, content_format, content_html,
+			excerpt, word_count, reading_time_minutes, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+		RETURNING ` + articleColumns
+
+	var created *pb.Article
+	err := r.withEvent(ctx, func(tx pgx.Tx) (*pb.ArticleEvent, error) {
+		var err error
+		created, err = scanArticle(tx.QueryRow(ctx, query,
+			article.Title, article.Content, article.UserId, int32(article.ContentFormat), article.ContentHtml,
+			article.Excerpt, article.WordCount, article.ReadingTimeMinutes))
+		if err != nil {
+			return nil, err
+		}
+		return outbox.Created(created), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create article failed: %w", mapPgError(err))
+	}
+	return created, nil
+}
 
+// withEvent runs fn in a transaction and writes the event it returns before committing
+func (r *articlePostgresRepo) withEvent(ctx context.Context, fn func(tx pgx.Tx) (*pb.ArticleEvent, error)) error {
+	tx, err := r.db.Begin(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("create article failed: %w", err)
+		return err
 	}
+	defer tx.Rollback(ctx)
 
-	article.CreatedAt = createdAt.Format(time.RFC3339)
-	article.UpdatedAt = updatedAt.Format(time.RFC3339)
+	event, err := fn(tx)
+	if err != nil {
+		return err
+	}
+	if err := writeEvents(ctx, tx, event); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
 
-	return &article, nil
+// writeEvents writes events to the outbox and queues their webhook deliveries in tx
+func writeEvents(ctx context.Context, tx pgx.Tx, events ...*pb.ArticleEvent) error {
+	if err := outbox.Write(ctx, tx, events...); err != nil {
+		return err
+	}
+	return enqueueDeliveries(ctx, tx, events)
 }
 
-// Update
-func (r *articlePostgresRepo) Update(ctx context.Context, id int32, title, content string) (*pb.Article, error) {
-	query := `
-		UPDATE articles
-		SET title = $1, content = $2, updated_at = CURRENT_TIMESTAMP
-		WHERE id = $3
-		RETURNING id, title, content, user_id, created_at, updated_at
-	`
-	var article pb.Article
-	var createdAt, updatedAt time.Time
+// importColumns are the columns COPY fills for imported articles
+var importColumns = []string{
+	"id", "title", "content", "user_id", "content_format", "content_html",
+	"excerpt", "word_count", "reading_time_minutes", "created_at", "updated_at",
+}
 
-	err := r.db.QueryRow(ctx, query, title, content, id).Scan(
-		&article.Id,
-		&article.Title,
-		&article.Content,
-		&article.UserId,
-		&createdAt,
-		&updatedAt,
-	)
+// Import copies articles into the table with COPY inside a transaction. IDs are reserved
+// from the sequence first so an ArticleCreated event can be written for every row.
+func (r *articlePostgresRepo) Import(ctx context.Context, articles []*pb.Article) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin import failed: %w", err)
+	}
+	defer tx.Rollback(ctx)
 
+	idRows, err := tx.Query(ctx, `SELECT nextval(pg_get_serial_sequence('articles', 'id')) FROM generate_series(1, $1)`, len(articles))
 	if err != nil {
-		return nil, fmt.Errorf("update article failed: %w", err)
+		return fmt.Errorf("reserve article ids failed: %w", err)
+	}
+	ids, err := pgx.CollectRows(idRows, pgx.RowTo[int32])
+	if err != nil {
+		return fmt.Errorf("reserve article ids failed: %w", err)
 	}
 
-	article.CreatedAt = createdAt.Format(time.RFC3339)
-	article.UpdatedAt = updatedAt.Format(time.RFC3339)
+	now := time.Now()
+	rows := make([][]interface{}, len(articles))
+	events := make([]*pb.ArticleEvent, len(articles))
+	for i, article := range articles {
+		createdAt, updatedAt := importTimes(article, now)
+		rows[i] = []interface{}{
+			ids[i], article.Title, article.Content, article.UserId, int16(article.ContentFormat), article.ContentHtml,
+			article.Excerpt, article.WordCount, article.ReadingTimeMinutes, createdAt, updatedAt,
+		}
 
-	return &article, nil
-}
+		imported := proto.Clone(article).(*pb.Article)
+		imported.Id = ids[i]
+		imported.CreatedAt, imported.CreateTime = formatTime(createdAt), timestamppb.New(createdAt)
+		imported.UpdatedAt, imported.UpdateTime = formatTime(updatedAt), timestamppb.New(updatedAt)
+		events[i] = outbox.Created(imported)
+	}
 
-// Delete article
-func (r *articlePostgresRepo) Delete(ctx context.Context, id int32) error {
-	query := `DELETE FROM articles WHERE id = $1`
+	if _, err := tx.CopyFrom(ctx, pgx.Identifier{"articles"}, importColumns, pgx.CopyFromRows(rows)); err != nil {
+		return fmt.Errorf("copy articles failed: %w", mapPgError(err))
+	}
+	if err := writeEvents(ctx, tx, events...); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit import failed: %w", mapPgError(err))
+	}
+	return nil
+}
 
-	result, err := r.db.Exec(ctx, query, id)
+// Update, with its ArticleUpdated event in the same transaction
+func (r *articlePostgresRepo) Update(ctx context.Context, article *pb.Article) (*pb.Article, error) {
+	query := `
+		UPDATE articles
+		SET title = $1, content = $2, content_format = $3, content_html = $4,
+			excerpt = $5, word_count = $6, reading_time_minutes = $7, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $8
+		RETURNING ` + articleColumns
+
+	var updated *pb.Article
+	err := r.withEvent(ctx, func(tx pgx.Tx) (*pb.ArticleEvent, error) {
+		var err error
+		updated, err = scanArticle(tx.QueryRow(ctx, query,
+			article.Title, article.Content, int32(article.ContentFormat), article.ContentHtml,
+			article.Excerpt, article.WordCount, article.ReadingTimeMinutes, article.Id))
+		if err != nil {
+			return nil, err
+		}
+		return outbox.Updated(updated), nil
+	})
 	if err != nil {
-		return fmt.Errorf("Delete article failded: %w", err)
+		return nil, fmt.Errorf("update article failed: %w", mapPgError(err))
 	}
+	return updated, nil
+}
+
+// Delete article, with its ArticleDeleted event (carrying the deleted row) in the same transaction
+func (r *articlePostgresRepo) Delete(ctx context.Context, id int32) error {
+	query := `DELETE FROM articles WHERE id = $1 RETURNING ` + articleColumns
 
-	if result.RowsAffected() == 0 {
-		return fmt.Errorf("article with ID %d not found", id)
+	err := r.withEvent(ctx, func(tx pgx.Tx) (*pb.ArticleEvent, error) {
+		deleted, err := scanArticle(tx.QueryRow(ctx, query, id))
+		if err != nil {
+			return nil, err
+		}
+		return outbox.Deleted(deleted), nil
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("article with ID %d: %w", id, ErrNotFound)
+	}
+	if err != nil {
+		return fmt.Errorf("Delete article failded: %w", mapPgError(err))
 	}
 	return nil
 }
 
 // ListByUser
-func (r *articlePostgresRepo) ListByUser(ctx context.Context, userID, limit, offset int32) ([]*pb.Article, int32, error) {
+func (r *articlePostgresRepo) ListByUser(ctx context.Context, userID, limit, offset int32, fields ...string) ([]*pb.Article, int32, error) {
 	// Query
+	fields = loadFields(fields)
 	query := `
-		SELECT id, title, content, user_id, created_at, updated_at
+		SELECT ` + selectColumns(fields) + `
 		FROM articles
 		WHERE user_id = $1
 		ORDER BY created_at DESC
 		LIMIT $2 OFFSET $3
 	`
-	rows, err := r.db.Query(ctx, query, userID, limit, offset)
+	articles, err := r.queryArticles(ctx, fields, query, userID, limit, offset)
 	if err != nil {
-		return nil, 0, fmt.Errorf("query articles failed: %w", err)
-	}
-	defer rows.Close()
-
-	var articles []*pb.Article
-
-	for rows.Next() {
-		var article pb.Article
-		var createdAt, updatedAt time.Time
-
-		err := rows.Scan(
-			&article.Id,
-			&article.Title,
-			&article.Content,
-			&article.UserId,
-			&createdAt,
-			&updatedAt,
-		)
-
-		if err != nil {
-			return nil, 0, fmt.Errorf("scan article failed: %w", err)
-		}
-
-		article.CreatedAt = createdAt.Format(time.RFC3339)
-		article.UpdatedAt = updatedAt.Format(time.RFC3339)
-		articles = append(articles, &article)
+		return nil, 0, err
 	}
 
 	// Count data
@@ -175,51 +315,200 @@ func (r *articlePostgresRepo) ListByUser(ctx context.Context, userID, limit, off
 }
 
 // ListAll articles
-func (r *articlePostgresRepo) ListAll(ctx context.Context, limit, offset int32) ([]*pb.Article, int32, error) {
+func (r *articlePostgresRepo) ListAll(ctx context.Context, limit, offset int32, fields ...string) ([]*pb.Article, int32, error) {
+	fields = loadFields(fields)
 	query := `
-		SELECT id, title, content, user_id, created_at, updated_at
+		SELECT ` + selectColumns(fields) + `
 		FROM articles
 		ORDER BY created_at DESC
 		LIMIT $1 OFFSET $2
 	`
+	articles, err := r.queryArticles(ctx, fields, query, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
 
-	rows, err := r.db.Query(ctx, query, limit, offset)
+	// Count total articles
+	countQuery := `SELECT COUNT(*) FROM articles`
+	var total int32
+	err = r.db.QueryRow(ctx, countQuery).Scan(&total)
 	if err != nil {
-		return nil, 0, fmt.Errorf("query articles failed: %w", err)
+		return nil, 0, fmt.Errorf("count articles failed: %w", err)
 	}
-	defer rows.Close()
 
-	var articles []*pb.Article
+	return articles, total, nil
+}
 
-	for rows.Next() {
-		var article pb.Article
-		var createdAt, updatedAt time.Time
-
-		err := rows.Scan(
-			&article.Id,
-			&article.Title,
-			&article.Content,
-			&article.UserId,
-			&createdAt,
-			&updatedAt,
+// ListByIDs loads the articles with one query
+func (r *articlePostgresRepo) ListByIDs(ctx context.Context, ids []int32, fields ...string) ([]*pb.Article, error) {
+	fields = loadFields(fields)
+	query := `SELECT ` + selectColumns(fields) + ` FROM articles WHERE id = ANY($1)`
+	articles, err := r.queryArticles(ctx, fields, query, ids)
+	if err != nil {
+		return nil, err
+	}
+	return orderByIDs(articles, ids), nil
+}
+
+// ListPopular
+func (r *articlePostgresRepo) ListPopular(ctx context.Context, userID, limit, offset int32, fields ...string) ([]*pb.Article, int32, error) {
+	fields = loadFields(fields)
+	query := `
+		SELECT ` + selectColumns(fields) + `
+		FROM articles
+		WHERE $1 <= 0 OR user_id = $1
+		ORDER BY reaction_count DESC, created_at DESC, id DESC
+		LIMIT $2 OFFSET $3
+	`
+	articles, err := r.queryArticles(ctx, fields, query, userID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	countQuery := `SELECT COUNT(*) FROM articles WHERE $1 <= 0 OR user_id = $1`
+	var total int32
+	err = r.db.QueryRow(ctx, countQuery, userID).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("count articles failed: %w", err)
+	}
+	return articles, total, nil
+}
+
+// RelatedIDs matches the source's top lexemes against search_vector (GIN index) and takes the
+// author's articles (user_id index), then ranks the union with ts_rank
+func (r *articlePostgresRepo) RelatedIDs(ctx context.Context, id, limit int32) ([]int32, error) {
+	query := `
+		WITH source AS (
+			SELECT id, user_id, search_vector FROM articles WHERE id = $1
+		), terms AS (
+			-- Title lexemes first, then the most frequent; only plain words, which need no quoting
+			SELECT to_tsquery('simple', string_agg(lexeme, ' | ')) AS query
+			FROM (
+				SELECT t.lexeme
+				FROM source, unnest(source.search_vector) AS t
+				WHERE t.lexeme ~ '^[[:alnum:]]+$'
+				ORDER BY 'A' = ANY(t.weights) DESC, cardinality(t.positions) DESC, t.lexeme
+				LIMIT $3
+			) top
+		), candidates AS (
+			SELECT a.id FROM articles a, terms WHERE a.search_vector @@ terms.query
+			UNION
+			SELECT a.id FROM articles a, source WHERE a.user_id = source.user_id
 		)
+		SELECT a.id
+		FROM candidates c
+		JOIN articles a ON a.id = c.id
+		CROSS JOIN source
+		CROSS JOIN terms
+		WHERE a.id <> source.id
+		ORDER BY COALESCE(ts_rank(a.search_vector, terms.query, 32), 0)
+			+ CASE WHEN a.user_id = source.user_id THEN $4::float8 ELSE 0 END DESC,
+			a.id DESC
+		LIMIT $2
+	`
+	rows, err := r.db.Query(ctx, query, id, limit, relatedTerms, sameAuthorBoost)
+	if err != nil {
+		return nil, fmt.Errorf("query related articles failed: %w", err)
+	}
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[int32])
+	if err != nil {
+		return nil, fmt.Errorf("query related articles failed: %w", err)
+	}
+	return ids, nil
+}
+
+// queryArticles runs a query selecting fields and scans every row
+func (r *articlePostgresRepo) queryArticles(ctx context.Context, fields []string, query string, args ...interface{}) ([]*pb.Article, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query articles failed: %w", err)
+	}
+	return collectArticles(rows, fields)
+}
 
+// collectArticles scans and closes rows whose columns are fields
+func collectArticles(rows pgx.Rows, fields []string) ([]*pb.Article, error) {
+	defer rows.Close()
+
+	var articles []*pb.Article
+	for rows.Next() {
+		article, err := scanArticleFields(rows, fields)
 		if err != nil {
-			return nil, 0, fmt.Errorf("scan article failed: %w", err)
+			return nil, fmt.Errorf("scan article failed: %w", err)
 		}
+		articles = append(articles, article)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("query articles failed: %w", err)
+	}
+	return articles, nil
+}
+
+// exportBatchSize is the number of rows fetched from the export cursor at a time
+const exportBatchSize = 500
 
-		article.CreatedAt = createdAt.Format(time.RFC3339)
-		article.UpdatedAt = updatedAt.Format(time.RFC3339)
-		articles = append(articles, &article)
+// Export walks a server-side cursor inside a read-only REPEATABLE READ transaction, so the
+// export sees one snapshot however long it runs. The next batch is fetched only after fn
+// has consumed the previous one, which keeps memory bounded and lets a slow client throttle the export.
+func (r *articlePostgresRepo) Export(ctx context.Context, filter ExportFilter, fields []string, fn func(*pb.Article) error) error {
+	fields = loadFields(fields)
+
+	var conditions []string
+	var args []interface{}
+	if filter.UserID > 0 {
+		args = append(args, filter.UserID)
+		conditions = append(conditions, fmt.Sprintf("user_id = $%d", len(args)))
+	}
+	if !filter.CreatedAfter.IsZero() {
+		args = append(args, filter.CreatedAfter)
+		conditions = append(conditions, fmt.Sprintf("created_at >= $%d", len(args)))
+	}
+	if !filter.CreatedBefore.IsZero() {
+		args = append(args, filter.CreatedBefore)
+		conditions = append(conditions, fmt.Sprintf("created_at < $%d", len(args)))
+	}
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
 	}
 
-	// Count total articles
-	countQuery := `SELECT COUNT(*) FROM articles`
-	var total int32
-	err = r.db.QueryRow(ctx, countQuery).Scan(&total)
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
 	if err != nil {
-		return nil, 0, fmt.Errorf("count articles failed: %w", err)
+		return fmt.Errorf("begin export failed: %w", err)
 	}
+	defer tx.Rollback(ctx)
 
-	return articles, total, nil
+	declare := `
+		DECLARE article_export NO SCROLL CURSOR FOR
+		SELECT ` + selectColumns(fields) + `
+		FROM articles
+		` + where + `
+		ORDER BY id
+	`
+	if _, err := tx.Exec(ctx, declare, args...); err != nil {
+		return fmt.Errorf("declare export cursor failed: %w", err)
+	}
+
+	fetch := fmt.Sprintf("FETCH FORWARD %d FROM article_export", exportBatchSize)
+	for {
+		rows, err := tx.Query(ctx, fetch)
+		if err != nil {
+			return fmt.Errorf("fetch export batch failed: %w", err)
+		}
+		batch, err := collectArticles(rows, fields)
+		if err != nil {
+			return err
+		}
+		for _, article := range batch {
+			if err := fn(article); err != nil {
+				return err
+			}
+		}
+		if len(batch) < exportBatchSize {
+			break
+		}
+	}
+
+	// Read-only: commit just releases the snapshot
+	return tx.Commit(ctx)
 }
@@ -0,0 +1,22 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/thatlq1812/service-2-article/internal/dbtest"
+	"github.com/thatlq1812/service-2-article/internal/repository"
+	"github.com/thatlq1812/service-2-article/internal/repository/repotest"
+)
+
+// Run with: go test -tags=integration ./internal/repository/...
+// Override the database with TEST_DATABASE_URL. Every table is emptied between subtests.
+func TestArticlePostgresRepository(t *testing.T) {
+	pool := dbtest.OpenPool(t)
+
+	repotest.RunArticleRepositoryContract(t, func(t *testing.T) repository.ArticleRepository {
+		dbtest.ResetTables(t, pool)
+		return repository.NewArticlePostgresRepository(pool)
+	})
+}
@@ -2,27 +2,80 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	pb "github.com/thatlq1812/service-2-article/proto"
 )
 
 // ArticleRepository define CRUD operations for articles
+// Implementations return ErrNotFound, ErrConflict or ErrForbidden (wrapped) for domain errors
 type ArticleRepository interface {
 	// GetByID get article by ID
-	GetByID(ctx context.Context, id int32) (*pb.Article, error)
+	// fields are Article proto field names to load (all when empty); id and user_id are always loaded
+	GetByID(ctx context.Context, id int32, fields ...string) (*pb.Article, error)
 
-	//Create new article
-	Create(ctx context.Context, title, content string, userId int32) (*pb.Article, error)
+	// Create new article from its user_id, title, content and the fields derived from content
+	// (format, HTML, excerpt, word count, reading time); id and timestamps are assigned
+	Create(ctx context.Context, article *pb.Article) (*pb.Article, error)
 
-	// Update article
-	Update(ctx context.Context, id int32, title, content string) (*pb.Article, error)
+	// Update replaces everything but user_id, created_at and the comment and reaction counters of article.Id
+	Update(ctx context.Context, article *pb.Article) (*pb.Article, error)
 
 	// Delete article
 	Delete(ctx context.Context, id int32) error
 
-	// ListByUser get article of 1 user (pagination)
-	ListByUser(ctx context.Context, userId, limit, offset int32) ([]*pb.Article, int32, error)
+	// ListByUser get article of 1 user (pagination), loading fields like GetByID
+	ListByUser(ctx context.Context, userId, limit, offset int32, fields ...string) ([]*pb.Article, int32, error)
 
-	// ListAll
-	ListAll(ctx context.Context, limit, offset int32) ([]*pb.Article, int32, error)
+	// ListAll, loading fields like GetByID
+	ListAll(ctx context.Context, limit, offset int32, fields ...string) ([]*pb.Article, int32, error)
+
+	// ListByIDs returns the articles of ids that exist, in the order of ids, loading fields like GetByID
+	ListByIDs(ctx context.Context, ids []int32, fields ...string) ([]*pb.Article, error)
+
+	// ListPopular lists articles (of userID when > 0) by reaction_count, most first, then newest first,
+	// loading fields like GetByID
+	ListPopular(ctx context.Context, userID, limit, offset int32, fields ...string) ([]*pb.Article, int32, error)
+
+	// Export calls fn for every article matching filter, in ID order, from one consistent snapshot,
+	// loading fields like GetByID. Articles are read in batches as fn returns, so a slow consumer
+	// slows the export down; an error from fn stops the export and is returned unchanged
+	Export(ctx context.Context, filter ExportFilter, fields []string, fn func(*pb.Article) error) error
+
+	// Import inserts articles in one transaction (all or none), keeping their user_id and
+	// timestamps: a nil create_time means now and a nil update_time means create_time
+	Import(ctx context.Context, articles []*pb.Article) error
+
+	// RelatedIDs returns up to limit IDs of the articles most related to article id: those sharing
+	// its top terms (title words first) or written by the same author, best first. id itself is
+	// excluded and a missing id has no related articles
+	RelatedIDs(ctx context.Context, id, limit int32) ([]int32, error)
+}
+
+// Related articles match any of the source's top relatedTerms terms; a similarity in [0, 1)
+// is ranked with sameAuthorBoost added for articles by the same author
+const (
+	relatedTerms    = 10
+	sameAuthorBoost = 0.25
+)
+
+// ExportFilter selects the articles Export walks; zero values do not restrict
+type ExportFilter struct {
+	UserID        int32
+	CreatedAfter  time.Time // inclusive
+	CreatedBefore time.Time // exclusive
+}
+
+// Matches reports whether an article created at createdAt by userID passes the filter
+func (f ExportFilter) Matches(userID int32, createdAt time.Time) bool {
+	if f.UserID > 0 && userID != f.UserID {
+		return false
+	}
+	if !f.CreatedAfter.IsZero() && createdAt.Before(f.CreatedAfter) {
+		return false
+	}
+	if !f.CreatedBefore.IsZero() && !createdAt.Before(f.CreatedBefore) {
+		return false
+	}
+	return true
 }
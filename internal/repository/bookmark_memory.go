@@ -0,0 +1,273 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	pb "github.com/thatlq1812/service-2-article/proto"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// bookmarkMemoryRepo implement BookmarkRepository in memory.
+// Articles are looked up in the article repository it was created with.
+type bookmarkMemoryRepo struct {
+	mu               sync.Mutex
+	articles         ArticleRepository
+	bookmarks        map[int32]*pb.Bookmark
+	collections      map[int32]*pb.Collection
+	nextBookmarkID   int32
+	nextCollectionID int32
+}
+
+// NewBookmarkMemoryRepository creates an empty in-memory repository (for tests and local development)
+// for the articles of articles
+func NewBookmarkMemoryRepository(articles ArticleRepository) BookmarkRepository {
+	return &bookmarkMemoryRepo{
+		articles:         articles,
+		bookmarks:        make(map[int32]*pb.Bookmark),
+		collections:      make(map[int32]*pb.Collection),
+		nextBookmarkID:   1,
+		nextCollectionID: 1,
+	}
+}
+
+// collection returns a copy of a collection of userID with its bookmark count
+func (r *bookmarkMemoryRepo) collection(userID, id int32) (*pb.Collection, error) {
+	c, ok := r.collections[id]
+	if !ok || c.UserId != userID {
+		return nil, fmt.Errorf("collection with ID %d: %w", id, ErrNotFound)
+	}
+	result := proto.Clone(c).(*pb.Collection)
+	for _, b := range r.bookmarks {
+		if b.CollectionId == id {
+			result.BookmarkCount++
+		}
+	}
+	return result, nil
+}
+
+// find returns the bookmark of an article in a list of userID, or nil
+func (r *bookmarkMemoryRepo) find(userID, articleID, collectionID int32) *pb.Bookmark {
+	for _, b := range r.bookmarks {
+		if b.UserId == userID && b.ArticleId == articleID && b.CollectionId == collectionID {
+			return b
+		}
+	}
+	return nil
+}
+
+// nameTaken reports whether userID has another collection called name
+func (r *bookmarkMemoryRepo) nameTaken(userID, id int32, name string) bool {
+	for _, c := range r.collections {
+		if c.UserId == userID && c.Id != id && c.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// AddBookmark
+func (r *bookmarkMemoryRepo) AddBookmark(ctx context.Context, userID, articleID, collectionID int32) (*pb.Bookmark, bool, error) {
+	if _, err := r.articles.GetByID(ctx, articleID, "id"); err != nil {
+		return nil, false, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if collectionID != 0 {
+		if _, err := r.collection(userID, collectionID); err != nil {
+			return nil, false, err
+		}
+	}
+	if existing := r.find(userID, articleID, collectionID); existing != nil {
+		return proto.Clone(existing).(*pb.Bookmark), false, nil
+	}
+	b := &pb.Bookmark{
+		Id:           r.nextBookmarkID,
+		UserId:       userID,
+		ArticleId:    articleID,
+		CollectionId: collectionID,
+		CreateTime:   timestamppb.Now(),
+	}
+	r.bookmarks[b.Id] = b
+	r.nextBookmarkID++
+	return proto.Clone(b).(*pb.Bookmark), true, nil
+}
+
+// RemoveBookmark
+func (r *bookmarkMemoryRepo) RemoveBookmark(ctx context.Context, userID, articleID, collectionID int32) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	b := r.find(userID, articleID, collectionID)
+	if b == nil {
+		return false, nil
+	}
+	delete(r.bookmarks, b.Id)
+	return true, nil
+}
+
+// ListBookmarks
+func (r *bookmarkMemoryRepo) ListBookmarks(ctx context.Context, userID, collectionID, after, limit int32) ([]*pb.Bookmark, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var page []*pb.Bookmark
+	for _, b := range r.bookmarks {
+		if b.UserId == userID && b.CollectionId == collectionID && (after == 0 || b.Id < after) {
+			page = append(page, proto.Clone(b).(*pb.Bookmark))
+		}
+	}
+	sort.Slice(page, func(i, j int) bool { return page[i].Id > page[j].Id })
+	if int32(len(page)) > limit {
+		page = page[:limit]
+	}
+	return page, nil
+}
+
+// CreateCollection
+func (r *bookmarkMemoryRepo) CreateCollection(ctx context.Context, userID int32, name string) (*pb.Collection, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.nameTaken(userID, 0, name) {
+		return nil, fmt.Errorf("collection %q: %w", name, ErrConflict)
+	}
+	var position int32
+	for _, c := range r.collections {
+		if c.UserId == userID && c.Position >= position {
+			position = c.Position + 1
+		}
+	}
+	now := timestamppb.New(time.Now())
+	c := &pb.Collection{
+		Id:         r.nextCollectionID,
+		UserId:     userID,
+		Name:       name,
+		Position:   position,
+		CreateTime: now,
+		UpdateTime: now,
+	}
+	r.collections[c.Id] = c
+	r.nextCollectionID++
+	return proto.Clone(c).(*pb.Collection), nil
+}
+
+// GetCollection
+func (r *bookmarkMemoryRepo) GetCollection(ctx context.Context, userID, id int32) (*pb.Collection, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.collection(userID, id)
+}
+
+// GetSharedCollection
+func (r *bookmarkMemoryRepo) GetSharedCollection(ctx context.Context, token string) (*pb.Collection, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, c := range r.collections {
+		if token != "" && c.ShareToken == token {
+			return r.collection(c.UserId, c.Id)
+		}
+	}
+	return nil, fmt.Errorf("shared collection: %w", ErrNotFound)
+}
+
+// ListCollections
+func (r *bookmarkMemoryRepo) ListCollections(ctx context.Context, userID int32) ([]*pb.Collection, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.listCollections(userID), nil
+}
+
+func (r *bookmarkMemoryRepo) listCollections(userID int32) []*pb.Collection {
+	var collections []*pb.Collection
+	for _, c := range r.collections {
+		if c.UserId == userID {
+			collection, _ := r.collection(userID, c.Id)
+			collections = append(collections, collection)
+		}
+	}
+	sort.Slice(collections, func(i, j int) bool {
+		if collections[i].Position != collections[j].Position {
+			return collections[i].Position < collections[j].Position
+		}
+		return collections[i].Id < collections[j].Id
+	})
+	return collections
+}
+
+// update applies fn to a collection of userID
+func (r *bookmarkMemoryRepo) update(userID, id int32, fn func(c *pb.Collection) error) (*pb.Collection, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c, ok := r.collections[id]
+	if !ok || c.UserId != userID {
+		return nil, fmt.Errorf("collection with ID %d: %w", id, ErrNotFound)
+	}
+	if err := fn(c); err != nil {
+		return nil, err
+	}
+	c.UpdateTime = timestamppb.Now()
+	return r.collection(userID, id)
+}
+
+// RenameCollection
+func (r *bookmarkMemoryRepo) RenameCollection(ctx context.Context, userID, id int32, name string) (*pb.Collection, error) {
+	return r.update(userID, id, func(c *pb.Collection) error {
+		if r.nameTaken(userID, id, name) {
+			return fmt.Errorf("collection %q: %w", name, ErrConflict)
+		}
+		c.Name = name
+		return nil
+	})
+}
+
+// ReorderCollections
+func (r *bookmarkMemoryRepo) ReorderCollections(ctx context.Context, userID int32, ids []int32) ([]*pb.Collection, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := timestamppb.Now()
+	for i, id := range ids {
+		if c, ok := r.collections[id]; ok && c.UserId == userID {
+			c.Position = int32(i)
+			c.UpdateTime = now
+		}
+	}
+	return r.listCollections(userID), nil
+}
+
+// SetShareToken
+func (r *bookmarkMemoryRepo) SetShareToken(ctx context.Context, userID, id int32, token string) (*pb.Collection, error) {
+	return r.update(userID, id, func(c *pb.Collection) error {
+		c.ShareToken = token
+		return nil
+	})
+}
+
+// DeleteCollection
+func (r *bookmarkMemoryRepo) DeleteCollection(ctx context.Context, userID, id int32) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if c, ok := r.collections[id]; !ok || c.UserId != userID {
+		return fmt.Errorf("collection with ID %d: %w", id, ErrNotFound)
+	}
+	delete(r.collections, id)
+	for bookmarkID, b := range r.bookmarks {
+		if b.CollectionId == id {
+			delete(r.bookmarks, bookmarkID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/thatlq1812/service-2-article/internal/repository"
+	"github.com/thatlq1812/service-2-article/internal/repository/repotest"
+)
+
+func TestBookmarkMemoryRepository(t *testing.T) {
+	repotest.RunBookmarkRepositoryContract(t, func(t *testing.T) (repository.BookmarkRepository, repository.ArticleRepository) {
+		articles := repository.NewArticleMemoryRepository()
+		return repository.NewBookmarkMemoryRepository(articles), articles
+	})
+}
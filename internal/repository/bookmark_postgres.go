@@ -0,0 +1,237 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/thatlq1812/service-2-article/proto"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// bookmarkPostgresRepo implement BookmarkRepository with PostgreSQL
+type bookmarkPostgresRepo struct {
+	db *pgxpool.Pool
+}
+
+// NewBookmarkPostgresRepository
+func NewBookmarkPostgresRepository(db *pgxpool.Pool) BookmarkRepository {
+	return &bookmarkPostgresRepo{db: db}
+}
+
+const (
+	// bookmarkColumns selects every stored Bookmark field
+	bookmarkColumns = `id, user_id, article_id, COALESCE(collection_id, 0), created_at`
+
+	// collectionColumns selects every Collection field of collections c
+	collectionColumns = `c.id, c.user_id, c.name, c.position, COALESCE(c.share_token, ''),
+		(SELECT COUNT(*) FROM bookmarks b WHERE b.collection_id = c.id), c.created_at, c.updated_at`
+)
+
+func scanBookmark(row pgx.Row) (*pb.Bookmark, error) {
+	var b pb.Bookmark
+	var createdAt time.Time
+	if err := row.Scan(&b.Id, &b.UserId, &b.ArticleId, &b.CollectionId, &createdAt); err != nil {
+		return nil, err
+	}
+	b.CreateTime = timestamppb.New(createdAt)
+	return &b, nil
+}
+
+func scanCollection(row pgx.Row) (*pb.Collection, error) {
+	var c pb.Collection
+	var createdAt, updatedAt time.Time
+	if err := row.Scan(&c.Id, &c.UserId, &c.Name, &c.Position, &c.ShareToken, &c.BookmarkCount, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+	c.CreateTime = timestamppb.New(createdAt)
+	c.UpdateTime = timestamppb.New(updatedAt)
+	return &c, nil
+}
+
+func (r *bookmarkPostgresRepo) queryCollections(ctx context.Context, query string, args ...interface{}) ([]*pb.Collection, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (*pb.Collection, error) {
+		return scanCollection(row)
+	})
+}
+
+// listFilter selects the bookmarks of one list: $1 is the user, $2 the collection
+func listFilter(collectionID int32) string {
+	if collectionID == 0 {
+		return `user_id = $1 AND collection_id IS NULL AND $2 = 0`
+	}
+	return `user_id = $1 AND collection_id = $2`
+}
+
+// AddBookmark; ON CONFLICT makes a concurrent duplicate wait for the first insert and then skip
+func (r *bookmarkPostgresRepo) AddBookmark(ctx context.Context, userID, articleID, collectionID int32) (*pb.Bookmark, bool, error) {
+	if collectionID != 0 {
+		if _, err := r.GetCollection(ctx, userID, collectionID); err != nil {
+			return nil, false, err
+		}
+	}
+
+	insert := `
+		INSERT INTO bookmarks (user_id, article_id, collection_id)
+		VALUES ($1, $3, NULLIF($2, 0))
+		ON CONFLICT DO NOTHING
+		RETURNING ` + bookmarkColumns
+	bookmark, err := scanBookmark(r.db.QueryRow(ctx, insert, userID, collectionID, articleID))
+	if err == nil {
+		return bookmark, true, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		// A foreign key violation (the article, or the collection deleted meanwhile) maps to ErrNotFound
+		return nil, false, fmt.Errorf("add bookmark failed: article with ID %d: %w", articleID, mapPgError(err))
+	}
+
+	existing := `SELECT ` + bookmarkColumns + ` FROM bookmarks WHERE ` + listFilter(collectionID) + ` AND article_id = $3`
+	bookmark, err = scanBookmark(r.db.QueryRow(ctx, existing, userID, collectionID, articleID))
+	if err != nil {
+		return nil, false, fmt.Errorf("query bookmark failed: %w", mapPgError(err))
+	}
+	return bookmark, false, nil
+}
+
+// RemoveBookmark
+func (r *bookmarkPostgresRepo) RemoveBookmark(ctx context.Context, userID, articleID, collectionID int32) (bool, error) {
+	query := `DELETE FROM bookmarks WHERE ` + listFilter(collectionID) + ` AND article_id = $3`
+	result, err := r.db.Exec(ctx, query, userID, collectionID, articleID)
+	if err != nil {
+		return false, fmt.Errorf("remove bookmark failed: %w", err)
+	}
+	return result.RowsAffected() > 0, nil
+}
+
+// ListBookmarks pages by ID (keyset pagination), which orders bookmarks like created_at
+func (r *bookmarkPostgresRepo) ListBookmarks(ctx context.Context, userID, collectionID, after, limit int32) ([]*pb.Bookmark, error) {
+	query := `
+		SELECT ` + bookmarkColumns + `
+		FROM bookmarks
+		WHERE ` + listFilter(collectionID) + ` AND ($3 = 0 OR id < $3)
+		ORDER BY id DESC
+		LIMIT $4
+	`
+	rows, err := r.db.Query(ctx, query, userID, collectionID, after, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query bookmarks failed: %w", err)
+	}
+	bookmarks, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*pb.Bookmark, error) {
+		return scanBookmark(row)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query bookmarks failed: %w", err)
+	}
+	return bookmarks, nil
+}
+
+// CreateCollection
+func (r *bookmarkPostgresRepo) CreateCollection(ctx context.Context, userID int32, name string) (*pb.Collection, error) {
+	query := `
+		WITH c AS (
+			INSERT INTO collections (user_id, name, position)
+			VALUES ($1, $2, (SELECT COALESCE(MAX(position) + 1, 0) FROM collections WHERE user_id = $1))
+			RETURNING *
+		)
+		SELECT ` + collectionColumns + ` FROM c
+	`
+	collection, err := scanCollection(r.db.QueryRow(ctx, query, userID, name))
+	if err != nil {
+		return nil, fmt.Errorf("create collection failed: %w", mapPgError(err))
+	}
+	return collection, nil
+}
+
+// GetCollection
+func (r *bookmarkPostgresRepo) GetCollection(ctx context.Context, userID, id int32) (*pb.Collection, error) {
+	query := `SELECT ` + collectionColumns + ` FROM collections c WHERE c.id = $1 AND c.user_id = $2`
+	collection, err := scanCollection(r.db.QueryRow(ctx, query, id, userID))
+	if err != nil {
+		return nil, fmt.Errorf("query collection failed: %w", mapPgError(err))
+	}
+	return collection, nil
+}
+
+// GetSharedCollection
+func (r *bookmarkPostgresRepo) GetSharedCollection(ctx context.Context, token string) (*pb.Collection, error) {
+	query := `SELECT ` + collectionColumns + ` FROM collections c WHERE c.share_token = $1`
+	collection, err := scanCollection(r.db.QueryRow(ctx, query, token))
+	if err != nil {
+		return nil, fmt.Errorf("query collection failed: %w", mapPgError(err))
+	}
+	return collection, nil
+}
+
+// ListCollections
+func (r *bookmarkPostgresRepo) ListCollections(ctx context.Context, userID int32) ([]*pb.Collection, error) {
+	query := `SELECT ` + collectionColumns + ` FROM collections c WHERE c.user_id = $1 ORDER BY c.position, c.id`
+	collections, err := r.queryCollections(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("query collections failed: %w", err)
+	}
+	return collections, nil
+}
+
+// updateCollection runs an UPDATE of collection $1 of user $2 that sets set, with args from $3
+func (r *bookmarkPostgresRepo) updateCollection(ctx context.Context, set string, id, userID int32, args ...interface{}) (*pb.Collection, error) {
+	query := `
+		WITH c AS (
+			UPDATE collections SET ` + set + `, updated_at = CURRENT_TIMESTAMP
+			WHERE id = $1 AND user_id = $2
+			RETURNING *
+		)
+		SELECT ` + collectionColumns + ` FROM c
+	`
+	return scanCollection(r.db.QueryRow(ctx, query, append([]interface{}{id, userID}, args...)...))
+}
+
+// RenameCollection
+func (r *bookmarkPostgresRepo) RenameCollection(ctx context.Context, userID, id int32, name string) (*pb.Collection, error) {
+	collection, err := r.updateCollection(ctx, `name = $3`, id, userID, name)
+	if err != nil {
+		return nil, fmt.Errorf("rename collection failed: %w", mapPgError(err))
+	}
+	return collection, nil
+}
+
+// ReorderCollections with one UPDATE
+func (r *bookmarkPostgresRepo) ReorderCollections(ctx context.Context, userID int32, ids []int32) ([]*pb.Collection, error) {
+	query := `
+		UPDATE collections
+		SET position = array_position($2::int[], id) - 1, updated_at = CURRENT_TIMESTAMP
+		WHERE user_id = $1 AND id = ANY($2)
+	`
+	if _, err := r.db.Exec(ctx, query, userID, ids); err != nil {
+		return nil, fmt.Errorf("reorder collections failed: %w", err)
+	}
+	return r.ListCollections(ctx, userID)
+}
+
+// SetShareToken
+func (r *bookmarkPostgresRepo) SetShareToken(ctx context.Context, userID, id int32, token string) (*pb.Collection, error) {
+	collection, err := r.updateCollection(ctx, `share_token = NULLIF($3, '')`, id, userID, token)
+	if err != nil {
+		return nil, fmt.Errorf("share collection failed: %w", mapPgError(err))
+	}
+	return collection, nil
+}
+
+// DeleteCollection; bookmarks are deleted by ON DELETE CASCADE
+func (r *bookmarkPostgresRepo) DeleteCollection(ctx context.Context, userID, id int32) error {
+	result, err := r.db.Exec(ctx, `DELETE FROM collections WHERE id = $1 AND user_id = $2`, id, userID)
+	if err != nil {
+		return fmt.Errorf("delete collection failed: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("collection with ID %d: %w", id, ErrNotFound)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+
+	pb "github.com/thatlq1812/service-2-article/proto"
+)
+
+// BookmarkRepository stores the articles users save, in a default reading list (collection ID 0)
+// or in named collections. Collections belong to one user: another user's collection is
+// reported as ErrNotFound. Bookmarks are returned without their article.
+type BookmarkRepository interface {
+	// AddBookmark saves an article to a list of userID; added is false (with the existing
+	// bookmark) when it was already there. ErrNotFound if the article or collection does not exist
+	AddBookmark(ctx context.Context, userID, articleID, collectionID int32) (bookmark *pb.Bookmark, added bool, err error)
+
+	// RemoveBookmark removes an article from a list of userID; removed is false when it was not there
+	RemoveBookmark(ctx context.Context, userID, articleID, collectionID int32) (removed bool, err error)
+
+	// ListBookmarks returns up to limit bookmarks of a list of userID, most recently saved first,
+	// starting after the bookmark with ID after (0 for the first page)
+	ListBookmarks(ctx context.Context, userID, collectionID, after, limit int32) ([]*pb.Bookmark, error)
+
+	// CreateCollection adds a collection after the existing ones of userID. ErrConflict if the
+	// user already has a collection with that name
+	CreateCollection(ctx context.Context, userID int32, name string) (*pb.Collection, error)
+
+	// GetCollection get a collection of userID
+	GetCollection(ctx context.Context, userID, id int32) (*pb.Collection, error)
+
+	// GetSharedCollection get the collection shared with token
+	GetSharedCollection(ctx context.Context, token string) (*pb.Collection, error)
+
+	// ListCollections returns every collection of userID by position
+	ListCollections(ctx context.Context, userID int32) ([]*pb.Collection, error)
+
+	// RenameCollection; ErrConflict like CreateCollection
+	RenameCollection(ctx context.Context, userID, id int32, name string) (*pb.Collection, error)
+
+	// ReorderCollections sets the position of each collection of userID to its index in ids;
+	// IDs of other users' collections are ignored
+	ReorderCollections(ctx context.Context, userID int32, ids []int32) ([]*pb.Collection, error)
+
+	// SetShareToken shares a collection of userID with token, or stops sharing it when token is empty
+	SetShareToken(ctx context.Context, userID, id int32, token string) (*pb.Collection, error)
+
+	// DeleteCollection deletes a collection of userID with its bookmarks
+	DeleteCollection(ctx context.Context, userID, id int32) error
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+
+	pb "github.com/thatlq1812/service-2-article/proto"
+)
+
+// commentCacheRepo decorates a CommentRepository so that changes to an article's comment_count
+// invalidate the article entry cached by the article cache repository
+type commentCacheRepo struct {
+	next  CommentRepository
+	cache ArticleCache
+}
+
+// NewCommentCacheRepository wraps next, invalidating cached articles in cache
+func NewCommentCacheRepository(next CommentRepository, cache ArticleCache) CommentRepository {
+	return &commentCacheRepo{next: next, cache: cache}
+}
+
+// GetComment is not cached
+func (r *commentCacheRepo) GetComment(ctx context.Context, id int32) (*pb.Comment, error) {
+	return r.next.GetComment(ctx, id)
+}
+
+// CreateComment and invalidate its article
+func (r *commentCacheRepo) CreateComment(ctx context.Context, comment *pb.Comment) (*pb.Comment, error) {
+	created, err := r.next.CreateComment(ctx, comment)
+	if err != nil {
+		return nil, err
+	}
+	invalidateArticle(ctx, r.cache, created.ArticleId)
+	return created, nil
+}
+
+// UpdateComment does not change comment_count
+func (r *commentCacheRepo) UpdateComment(ctx context.Context, id int32, content string) (*pb.Comment, error) {
+	return r.next.UpdateComment(ctx, id, content)
+}
+
+// SetCommentHidden and invalidate its article
+func (r *commentCacheRepo) SetCommentHidden(ctx context.Context, id int32, hidden bool) (*pb.Comment, error) {
+	updated, err := r.next.SetCommentHidden(ctx, id, hidden)
+	if err != nil {
+		return nil, err
+	}
+	invalidateArticle(ctx, r.cache, updated.ArticleId)
+	return updated, nil
+}
+
+// DeleteComment and invalidate its article
+func (r *commentCacheRepo) DeleteComment(ctx context.Context, id int32) (*pb.Comment, error) {
+	deleted, err := r.next.DeleteComment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	invalidateArticle(ctx, r.cache, deleted.ArticleId)
+	return deleted, nil
+}
+
+// ListComments is not cached
+func (r *commentCacheRepo) ListComments(ctx context.Context, articleID, limit, offset, replyLimit int32) ([]*pb.Comment, int32, error) {
+	return r.next.ListComments(ctx, articleID, limit, offset, replyLimit)
+}
+
+// ListReplies is not cached
+func (r *commentCacheRepo) ListReplies(ctx context.Context, threadID, after, limit int32) ([]*pb.Comment, error) {
+	return r.next.ListReplies(ctx, threadID, after, limit)
+}
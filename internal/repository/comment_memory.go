@@ -0,0 +1,220 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	pb "github.com/thatlq1812/service-2-article/proto"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// counterUpdater is implemented by the in-memory article repository
+type counterUpdater interface {
+	updateCounters(id int32, update func(article *pb.Article))
+}
+
+// commentMemoryRepo implement CommentRepository in memory.
+// Articles are looked up in the article repository it was created with.
+type commentMemoryRepo struct {
+	mu       sync.Mutex
+	articles ArticleRepository
+	comments map[int32]*pb.Comment
+	threads  map[int32]int32 // comment ID -> top-level comment ID
+	nextID   int32
+}
+
+// NewCommentMemoryRepository creates an empty in-memory repository (for tests and local development)
+// for the articles of articles. Comment counts are kept in step when articles is the in-memory
+// article repository.
+func NewCommentMemoryRepository(articles ArticleRepository) CommentRepository {
+	return &commentMemoryRepo{
+		articles: articles,
+		comments: make(map[int32]*pb.Comment),
+		threads:  make(map[int32]int32),
+		nextID:   1,
+	}
+}
+
+// recount stores the number of visible comments of an article
+func (r *commentMemoryRepo) recount(articleID int32) {
+	counters, ok := r.articles.(counterUpdater)
+	if !ok {
+		return
+	}
+	var count int32
+	for _, c := range r.comments {
+		if c.ArticleId == articleID && !c.Hidden {
+			count++
+		}
+	}
+	counters.updateCounters(articleID, func(article *pb.Article) { article.CommentCount = count })
+}
+
+// GetComment
+func (r *commentMemoryRepo) GetComment(ctx context.Context, id int32) (*pb.Comment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c, ok := r.comments[id]
+	if !ok {
+		return nil, fmt.Errorf("comment with ID %d: %w", id, ErrNotFound)
+	}
+	return proto.Clone(c).(*pb.Comment), nil
+}
+
+// CreateComment
+func (r *commentMemoryRepo) CreateComment(ctx context.Context, input *pb.Comment) (*pb.Comment, error) {
+	if _, err := r.articles.GetByID(ctx, input.ArticleId, "id"); err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c := &pb.Comment{
+		Id:        r.nextID,
+		ArticleId: input.ArticleId,
+		ParentId:  input.ParentId,
+		UserId:    input.UserId,
+		Content:   input.Content,
+	}
+	thread := c.Id
+	if c.ParentId != 0 {
+		parent, ok := r.comments[c.ParentId]
+		if !ok || parent.ArticleId != c.ArticleId {
+			return nil, fmt.Errorf("parent comment with ID %d: %w", c.ParentId, ErrNotFound)
+		}
+		c.Depth = parent.Depth + 1
+		thread = r.threads[parent.Id]
+	}
+	now := timestamppb.New(time.Now())
+	c.CreateTime = now
+	c.UpdateTime = now
+
+	r.comments[c.Id] = c
+	r.threads[c.Id] = thread
+	r.nextID++
+	r.recount(c.ArticleId)
+	return proto.Clone(c).(*pb.Comment), nil
+}
+
+// UpdateComment
+func (r *commentMemoryRepo) UpdateComment(ctx context.Context, id int32, content string) (*pb.Comment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c, ok := r.comments[id]
+	if !ok {
+		return nil, fmt.Errorf("comment with ID %d: %w", id, ErrNotFound)
+	}
+	c.Content = content
+	c.UpdateTime = timestamppb.Now()
+	return proto.Clone(c).(*pb.Comment), nil
+}
+
+// SetCommentHidden
+func (r *commentMemoryRepo) SetCommentHidden(ctx context.Context, id int32, hidden bool) (*pb.Comment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c, ok := r.comments[id]
+	if !ok {
+		return nil, fmt.Errorf("comment with ID %d: %w", id, ErrNotFound)
+	}
+	c.Hidden = hidden
+	r.recount(c.ArticleId)
+	return proto.Clone(c).(*pb.Comment), nil
+}
+
+// DeleteComment with its replies, at any depth
+func (r *commentMemoryRepo) DeleteComment(ctx context.Context, id int32) (*pb.Comment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	deleted, ok := r.comments[id]
+	if !ok {
+		return nil, fmt.Errorf("comment with ID %d: %w", id, ErrNotFound)
+	}
+	for pending := []int32{id}; len(pending) > 0; {
+		parentID := pending[0]
+		pending = pending[1:]
+		for _, c := range r.comments {
+			if c.ParentId == parentID {
+				pending = append(pending, c.Id)
+			}
+		}
+		delete(r.comments, parentID)
+		delete(r.threads, parentID)
+	}
+	r.recount(deleted.ArticleId)
+	return deleted, nil
+}
+
+// ListComments; IDs are assigned in creation order, so they order comments like created_at
+func (r *commentMemoryRepo) ListComments(ctx context.Context, articleID, limit, offset, replyLimit int32) ([]*pb.Comment, int32, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var all []*pb.Comment
+	for _, c := range r.comments {
+		if c.ArticleId == articleID && c.ParentId == 0 {
+			all = append(all, c)
+		}
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].Id < all[j].Id })
+
+	total := int32(len(all))
+	if offset < 0 {
+		offset = 0
+	}
+	var roots []*pb.Comment
+	inPage := make(map[int32]*pb.Comment)
+	for i := offset; i < total && int32(len(roots)) < limit; i++ {
+		root := proto.Clone(all[i]).(*pb.Comment)
+		roots = append(roots, root)
+		inPage[root.Id] = root
+	}
+
+	var inThreads []*pb.Comment
+	for id, c := range r.comments {
+		if c.ParentId != 0 && inPage[r.threads[id]] != nil {
+			inThreads = append(inThreads, c)
+		}
+	}
+	sort.Slice(inThreads, func(i, j int) bool { return inThreads[i].Id < inThreads[j].Id })
+
+	var replies []*pb.Comment
+	for _, c := range inThreads {
+		root := inPage[r.threads[c.Id]]
+		if root.ReplyCount < replyLimit {
+			replies = append(replies, proto.Clone(c).(*pb.Comment))
+		}
+		root.ReplyCount++
+	}
+	nestComments(roots, replies)
+	return roots, total, nil
+}
+
+// ListReplies
+func (r *commentMemoryRepo) ListReplies(ctx context.Context, threadID, after, limit int32) ([]*pb.Comment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var replies []*pb.Comment
+	for id, c := range r.comments {
+		if c.ParentId != 0 && r.threads[id] == threadID && id > after {
+			replies = append(replies, c)
+		}
+	}
+	sort.Slice(replies, func(i, j int) bool { return replies[i].Id < replies[j].Id })
+	replies = replies[:min(len(replies), int(max(limit, 0)))]
+	for i, c := range replies {
+		replies[i] = proto.Clone(c).(*pb.Comment)
+	}
+	return replies, nil
+}
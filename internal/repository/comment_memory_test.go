@@ -0,0 +1,26 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/thatlq1812/service-2-article/internal/repository"
+	"github.com/thatlq1812/service-2-article/internal/repository/repotest"
+)
+
+func TestCommentMemoryRepository(t *testing.T) {
+	repotest.RunCommentRepositoryContract(t, func(t *testing.T) (repository.CommentRepository, repository.ArticleRepository) {
+		articles := repository.NewArticleMemoryRepository()
+		return repository.NewCommentMemoryRepository(articles), articles
+	})
+}
+
+// Comment changes must invalidate the cached article, whose comment_count they change
+func TestCommentCacheRepository(t *testing.T) {
+	repotest.RunCommentRepositoryContract(t, func(t *testing.T) (repository.CommentRepository, repository.ArticleRepository) {
+		cache := newMapCache()
+		articles := repository.NewArticleMemoryRepository()
+		comments := repository.NewCommentCacheRepository(repository.NewCommentMemoryRepository(articles), cache)
+		return comments, repository.NewArticleCacheRepository(articles, cache, time.Minute)
+	})
+}
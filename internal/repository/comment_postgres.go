@@ -0,0 +1,266 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/thatlq1812/service-2-article/proto"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// commentPostgresRepo implement CommentRepository with PostgreSQL
+type commentPostgresRepo struct {
+	db *pgxpool.Pool
+}
+
+// NewCommentPostgresRepository
+func NewCommentPostgresRepository(db *pgxpool.Pool) CommentRepository {
+	return &commentPostgresRepo{db: db}
+}
+
+// commentColumns selects every stored Comment field
+const commentColumns = `id, article_id, COALESCE(parent_id, 0), user_id, content, hidden, depth, created_at, updated_at`
+
+func scanComment(row pgx.Row) (*pb.Comment, error) {
+	var c pb.Comment
+	var depth int16
+	var createdAt, updatedAt time.Time
+	if err := row.Scan(&c.Id, &c.ArticleId, &c.ParentId, &c.UserId, &c.Content, &c.Hidden, &depth, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+	c.Depth = int32(depth)
+	c.CreateTime = timestamppb.New(createdAt)
+	c.UpdateTime = timestamppb.New(updatedAt)
+	return &c, nil
+}
+
+func (r *commentPostgresRepo) queryComments(ctx context.Context, query string, args ...interface{}) ([]*pb.Comment, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (*pb.Comment, error) {
+		return scanComment(row)
+	})
+}
+
+// withArticle runs fn in a transaction holding the article row lock, then recounts the
+// article's visible comments. The lock serialises comment changes per article, so every
+// recount sees the changes committed before it.
+func (r *commentPostgresRepo) withArticle(ctx context.Context, articleID int32, fn func(tx pgx.Tx) error) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var id int32
+	if err := tx.QueryRow(ctx, `SELECT id FROM articles WHERE id = $1 FOR NO KEY UPDATE`, articleID).Scan(&id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("article with ID %d: %w", articleID, ErrNotFound)
+		}
+		return err
+	}
+	if err := fn(tx); err != nil {
+		return err
+	}
+	recount := `
+		UPDATE articles
+		SET comment_count = (SELECT COUNT(*) FROM comments WHERE article_id = $1 AND NOT hidden)
+		WHERE id = $1
+	`
+	if _, err := tx.Exec(ctx, recount, articleID); err != nil {
+		return fmt.Errorf("count comments failed: %w", err)
+	}
+	return tx.Commit(ctx)
+}
+
+// GetComment
+func (r *commentPostgresRepo) GetComment(ctx context.Context, id int32) (*pb.Comment, error) {
+	comment, err := scanComment(r.db.QueryRow(ctx, `SELECT `+commentColumns+` FROM comments WHERE id = $1`, id))
+	if err != nil {
+		return nil, fmt.Errorf("query comment failed: %w", mapPgError(err))
+	}
+	return comment, nil
+}
+
+// CreateComment; a reply copies the thread of its parent and nests one level deeper
+func (r *commentPostgresRepo) CreateComment(ctx context.Context, comment *pb.Comment) (*pb.Comment, error) {
+	topLevel := `
+		INSERT INTO comments (article_id, user_id, content)
+		VALUES ($1, $2, $3)
+		RETURNING ` + commentColumns
+	reply := `
+		INSERT INTO comments (article_id, parent_id, thread_id, depth, user_id, content)
+		SELECT article_id, id, COALESCE(thread_id, id), depth + 1, $2, $3
+		FROM comments
+		WHERE id = $4 AND article_id = $1
+		RETURNING ` + commentColumns
+
+	var created *pb.Comment
+	err := r.withArticle(ctx, comment.ArticleId, func(tx pgx.Tx) error {
+		var err error
+		if comment.ParentId == 0 {
+			created, err = scanComment(tx.QueryRow(ctx, topLevel, comment.ArticleId, comment.UserId, comment.Content))
+		} else {
+			created, err = scanComment(tx.QueryRow(ctx, reply, comment.ArticleId, comment.UserId, comment.Content, comment.ParentId))
+			if errors.Is(err, pgx.ErrNoRows) {
+				return fmt.Errorf("parent comment with ID %d: %w", comment.ParentId, ErrNotFound)
+			}
+		}
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create comment failed: %w", mapPgError(err))
+	}
+	return created, nil
+}
+
+// UpdateComment; the comment count does not change
+func (r *commentPostgresRepo) UpdateComment(ctx context.Context, id int32, content string) (*pb.Comment, error) {
+	query := `
+		UPDATE comments SET content = $2, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1
+		RETURNING ` + commentColumns
+	updated, err := scanComment(r.db.QueryRow(ctx, query, id, content))
+	if err != nil {
+		return nil, fmt.Errorf("update comment failed: %w", mapPgError(err))
+	}
+	return updated, nil
+}
+
+// articleOf returns the article of comment id
+func (r *commentPostgresRepo) articleOf(ctx context.Context, id int32) (int32, error) {
+	var articleID int32
+	if err := r.db.QueryRow(ctx, `SELECT article_id FROM comments WHERE id = $1`, id).Scan(&articleID); err != nil {
+		return 0, mapPgError(err)
+	}
+	return articleID, nil
+}
+
+// SetCommentHidden
+func (r *commentPostgresRepo) SetCommentHidden(ctx context.Context, id int32, hidden bool) (*pb.Comment, error) {
+	articleID, err := r.articleOf(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("query comment failed: %w", err)
+	}
+
+	query := `UPDATE comments SET hidden = $2 WHERE id = $1 RETURNING ` + commentColumns
+	var updated *pb.Comment
+	err = r.withArticle(ctx, articleID, func(tx pgx.Tx) error {
+		updated, err = scanComment(tx.QueryRow(ctx, query, id, hidden))
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("moderate comment failed: %w", mapPgError(err))
+	}
+	return updated, nil
+}
+
+// DeleteComment; replies are deleted by ON DELETE CASCADE
+func (r *commentPostgresRepo) DeleteComment(ctx context.Context, id int32) (*pb.Comment, error) {
+	articleID, err := r.articleOf(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("query comment failed: %w", err)
+	}
+
+	query := `DELETE FROM comments WHERE id = $1 RETURNING ` + commentColumns
+	var deleted *pb.Comment
+	err = r.withArticle(ctx, articleID, func(tx pgx.Tx) error {
+		deleted, err = scanComment(tx.QueryRow(ctx, query, id))
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("delete comment failed: %w", mapPgError(err))
+	}
+	return deleted, nil
+}
+
+// ListComments loads a page of top-level comments, then the reply counts and the first
+// replyLimit replies of their threads
+func (r *commentPostgresRepo) ListComments(ctx context.Context, articleID, limit, offset, replyLimit int32) ([]*pb.Comment, int32, error) {
+	query := `
+		SELECT ` + commentColumns + `
+		FROM comments
+		WHERE article_id = $1 AND parent_id IS NULL
+		ORDER BY created_at, id
+		LIMIT $2 OFFSET $3
+	`
+	roots, err := r.queryComments(ctx, query, articleID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("query comments failed: %w", err)
+	}
+
+	var total int32
+	countQuery := `SELECT COUNT(*) FROM comments WHERE article_id = $1 AND parent_id IS NULL`
+	if err := r.db.QueryRow(ctx, countQuery, articleID).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("count comments failed: %w", err)
+	}
+	if len(roots) == 0 {
+		return roots, total, nil
+	}
+
+	threads := make([]int32, len(roots))
+	byID := make(map[int32]*pb.Comment, len(roots))
+	for i, c := range roots {
+		threads[i] = c.Id
+		byID[c.Id] = c
+	}
+
+	rows, err := r.db.Query(ctx, `SELECT thread_id, COUNT(*) FROM comments WHERE thread_id = ANY($1) GROUP BY thread_id`, threads)
+	if err != nil {
+		return nil, 0, fmt.Errorf("count replies failed: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var threadID, count int32
+		if err := rows.Scan(&threadID, &count); err != nil {
+			return nil, 0, fmt.Errorf("scan reply count failed: %w", err)
+		}
+		byID[threadID].ReplyCount = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("count replies failed: %w", err)
+	}
+
+	// The first replies of each thread, read from the (thread_id, id) index
+	repliesQuery := `
+		SELECT replies.*
+		FROM unnest($1::int[]) AS threads(root_id)
+		CROSS JOIN LATERAL (
+			SELECT ` + commentColumns + `
+			FROM comments
+			WHERE thread_id = threads.root_id
+			ORDER BY id
+			LIMIT $2
+		) replies
+		ORDER BY replies.id
+	`
+	replies, err := r.queryComments(ctx, repliesQuery, threads, replyLimit)
+	if err != nil {
+		return nil, 0, fmt.Errorf("query replies failed: %w", err)
+	}
+	nestComments(roots, replies)
+	return roots, total, nil
+}
+
+// ListReplies pages through one thread by ID
+func (r *commentPostgresRepo) ListReplies(ctx context.Context, threadID, after, limit int32) ([]*pb.Comment, error) {
+	query := `
+		SELECT ` + commentColumns + `
+		FROM comments
+		WHERE thread_id = $1 AND id > $2
+		ORDER BY id
+		LIMIT $3
+	`
+	replies, err := r.queryComments(ctx, query, threadID, after, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query replies failed: %w", err)
+	}
+	return replies, nil
+}
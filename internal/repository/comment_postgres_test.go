@@ -0,0 +1,21 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/thatlq1812/service-2-article/internal/dbtest"
+	"github.com/thatlq1812/service-2-article/internal/repository"
+	"github.com/thatlq1812/service-2-article/internal/repository/repotest"
+)
+
+// Every table is emptied between subtests
+func TestCommentPostgresRepository(t *testing.T) {
+	pool := dbtest.OpenPool(t)
+
+	repotest.RunCommentRepositoryContract(t, func(t *testing.T) (repository.CommentRepository, repository.ArticleRepository) {
+		dbtest.ResetTables(t, pool)
+		return repository.NewCommentPostgresRepository(pool), repository.NewArticlePostgresRepository(pool)
+	})
+}
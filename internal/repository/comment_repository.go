@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+
+	pb "github.com/thatlq1812/service-2-article/proto"
+)
+
+// CommentRepository stores comments on articles and keeps Article.comment_count, the number
+// of visible (not hidden) comments, in step with them.
+// Comments are returned without their user; replies are only nested by ListComments.
+type CommentRepository interface {
+	// GetComment get comment by ID
+	GetComment(ctx context.Context, id int32) (*pb.Comment, error)
+
+	// CreateComment stores comment's article_id, parent_id (0 for top level), user_id and content;
+	// id, depth and timestamps are assigned. ErrNotFound if the article or the parent comment
+	// (in the same article) does not exist
+	CreateComment(ctx context.Context, comment *pb.Comment) (*pb.Comment, error)
+
+	// UpdateComment replaces the content of a comment
+	UpdateComment(ctx context.Context, id int32, content string) (*pb.Comment, error)
+
+	// SetCommentHidden hides or shows a comment
+	SetCommentHidden(ctx context.Context, id int32, hidden bool) (*pb.Comment, error)
+
+	// DeleteComment deletes a comment with all of its replies and returns the deleted comment
+	DeleteComment(ctx context.Context, id int32) (*pb.Comment, error)
+
+	// ListComments returns a page of the top-level comments of an article, oldest first, and the
+	// number of top-level comments. Each has reply_count set and the first replyLimit replies of
+	// its thread (in ID order, at any depth) nested, oldest first. A reply's parent is always
+	// older, so every nested reply has its parent.
+	ListComments(ctx context.Context, articleID, limit, offset, replyLimit int32) ([]*pb.Comment, int32, error)
+
+	// ListReplies returns up to limit replies in the thread of the top-level comment threadID
+	// with an ID above after, in ID order and not nested
+	ListReplies(ctx context.Context, threadID, after, limit int32) ([]*pb.Comment, error)
+}
+
+// nestComments attaches replies (oldest first) to their parents among roots and their replies.
+// Replies whose parent is not there, e.g. deleted meanwhile, are dropped.
+func nestComments(roots, replies []*pb.Comment) {
+	byID := make(map[int32]*pb.Comment, len(roots)+len(replies))
+	for _, c := range roots {
+		byID[c.Id] = c
+	}
+	for _, c := range replies {
+		byID[c.Id] = c
+	}
+	for _, c := range replies {
+		if parent, ok := byID[c.ParentId]; ok {
+			parent.Replies = append(parent.Replies, c)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// Repository errors, matched with errors.Is by callers
+var (
+	ErrNotFound  = errors.New("not found")
+	ErrConflict  = errors.New("conflict")
+	ErrForbidden = errors.New("forbidden")
+	// ErrLimitExceeded is returned when an insert would pass a per-user limit
+	ErrLimitExceeded = errors.New("limit exceeded")
+)
+
+// PostgreSQL error codes (https://www.postgresql.org/docs/current/errcodes-appendix.html)
+const (
+	pgUniqueViolation     = "23505"
+	pgForeignKeyViolation = "23503"
+)
+
+// mapPgError wraps driver errors with the matching repository error,
+// keeping the original error in the chain for logging
+func mapPgError(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("%w: %w", ErrNotFound, err)
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case pgUniqueViolation:
+			return fmt.Errorf("%w: %w", ErrConflict, err)
+		case pgForeignKeyViolation:
+			// The referenced row (e.g. the article) does not exist
+			return fmt.Errorf("%w: %w", ErrNotFound, err)
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+func TestMapPgError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{"no rows", pgx.ErrNoRows, ErrNotFound},
+		{"unique violation", &pgconn.PgError{Code: pgUniqueViolation}, ErrConflict},
+		{"foreign key violation", &pgconn.PgError{Code: pgForeignKeyViolation}, ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mapPgError(tt.err)
+			if !errors.Is(got, tt.want) {
+				t.Errorf("mapPgError() = %v, want %v", got, tt.want)
+			}
+			if !errors.Is(got, tt.err) {
+				t.Errorf("mapPgError() = %v, want the driver error kept in the chain", got)
+			}
+		})
+	}
+
+	other := &pgconn.PgError{Code: "42P01"}
+	if got := mapPgError(other); got != other {
+		t.Errorf("mapPgError(other) = %v, want it unchanged", got)
+	}
+}
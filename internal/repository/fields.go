@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	pb "github.com/thatlq1812/service-2-article/proto"
+)
+
+// alwaysLoaded are returned whatever fields are requested (needed to identify the article and its author)
+var alwaysLoaded = map[string]bool{"id": true, "user_id": true}
+
+// allArticleFields lists every Article field in proto order
+var allArticleFields = loadFields(nil)
+
+// loadFields normalises requested Article field names for a read.
+// nil means every field; otherwise the alwaysLoaded fields are added.
+// The result follows proto field order; unknown names are dropped.
+func loadFields(fields []string) []string {
+	requested := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		requested[f] = true
+	}
+
+	descriptors := (&pb.Article{}).ProtoReflect().Descriptor().Fields()
+	result := make([]string, 0, descriptors.Len())
+	for i := 0; i < descriptors.Len(); i++ {
+		name := string(descriptors.Get(i).Name())
+		if len(fields) == 0 || requested[name] || alwaysLoaded[name] {
+			result = append(result, name)
+		}
+	}
+	return result
+}
+
+// formatTime renders the deprecated string timestamps: RFC3339 in UTC
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// projectArticle clears every field of article that a read of fields would not load
+func projectArticle(article *pb.Article, fields []string) *pb.Article {
+	if len(fields) == 0 {
+		return article
+	}
+
+	keep := make(map[string]bool)
+	for _, f := range loadFields(fields) {
+		keep[f] = true
+	}
+
+	m := article.ProtoReflect()
+	var clear []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		if !keep[string(fd.Name())] {
+			clear = append(clear, fd)
+		}
+		return true
+	})
+	for _, fd := range clear {
+		m.Clear(fd)
+	}
+	return article
+}
+
+// importTimes returns the created_at and updated_at to store for an imported article
+func importTimes(article *pb.Article, now time.Time) (time.Time, time.Time) {
+	createdAt := now
+	if article.CreateTime != nil {
+		createdAt = article.CreateTime.AsTime()
+	}
+	updatedAt := createdAt
+	if article.UpdateTime != nil {
+		updatedAt = article.UpdateTime.AsTime()
+	}
+	return createdAt, updatedAt
+}
+
+// orderByIDs orders articles like ids, once per ID
+func orderByIDs(articles []*pb.Article, ids []int32) []*pb.Article {
+	byID := make(map[int32]*pb.Article, len(articles))
+	for _, article := range articles {
+		byID[article.Id] = article
+	}
+	ordered := make([]*pb.Article, 0, len(articles))
+	for _, id := range ids {
+		if article, ok := byID[id]; ok {
+			ordered = append(ordered, article)
+			delete(byID, id)
+		}
+	}
+	return ordered
+}
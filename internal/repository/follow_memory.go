@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+
+	pb "github.com/thatlq1812/service-2-article/proto"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// followMemoryRepo implement FollowRepository in memory.
+// Feeds are read from the article repository it was created with.
+type followMemoryRepo struct {
+	mu       sync.Mutex
+	articles ArticleRepository
+	follows  map[int32]*pb.Follow
+	nextID   int32
+}
+
+// NewFollowMemoryRepository creates an empty in-memory repository (for tests and local development)
+// whose feeds hold the articles of articles
+func NewFollowMemoryRepository(articles ArticleRepository) FollowRepository {
+	return &followMemoryRepo{
+		articles: articles,
+		follows:  make(map[int32]*pb.Follow),
+		nextID:   1,
+	}
+}
+
+// find returns the follow of authorID by userID, or nil
+func (r *followMemoryRepo) find(userID, authorID int32) *pb.Follow {
+	for _, f := range r.follows {
+		if f.UserId == userID && f.AuthorId == authorID {
+			return f
+		}
+	}
+	return nil
+}
+
+// Follow counts and inserts under one lock
+func (r *followMemoryRepo) Follow(ctx context.Context, userID, authorID, limit int32) (*pb.Follow, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if existing := r.find(userID, authorID); existing != nil {
+		return proto.Clone(existing).(*pb.Follow), false, nil
+	}
+	if limit > 0 && r.countFollowing(userID) >= limit {
+		return nil, false, fmt.Errorf("user %d follows %d authors: %w", userID, limit, ErrLimitExceeded)
+	}
+	follow := &pb.Follow{Id: r.nextID, UserId: userID, AuthorId: authorID, CreateTime: timestamppb.Now()}
+	r.follows[follow.Id] = follow
+	r.nextID++
+	return proto.Clone(follow).(*pb.Follow), true, nil
+}
+
+// Unfollow
+func (r *followMemoryRepo) Unfollow(ctx context.Context, userID, authorID int32) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing := r.find(userID, authorID)
+	if existing == nil {
+		return false, nil
+	}
+	delete(r.follows, existing.Id)
+	return true, nil
+}
+
+// ListFollowing
+func (r *followMemoryRepo) ListFollowing(ctx context.Context, userID, after, limit int32) ([]*pb.Follow, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var follows []*pb.Follow
+	for _, f := range r.follows {
+		if f.UserId == userID && (after == 0 || f.Id < after) {
+			follows = append(follows, proto.Clone(f).(*pb.Follow))
+		}
+	}
+	sort.Slice(follows, func(i, j int) bool { return follows[i].Id > follows[j].Id })
+	if int32(len(follows)) > limit {
+		follows = follows[:limit]
+	}
+	return follows, nil
+}
+
+// CountFollowing
+func (r *followMemoryRepo) CountFollowing(ctx context.Context, userID int32) (int32, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.countFollowing(userID), nil
+}
+
+// countFollowing counts the follows of userID; the caller holds r.mu
+func (r *followMemoryRepo) countFollowing(userID int32) int32 {
+	var count int32
+	for _, f := range r.follows {
+		if f.UserId == userID {
+			count++
+		}
+	}
+	return count
+}
+
+// Feed lists every article of each followed author, then merges them
+func (r *followMemoryRepo) Feed(ctx context.Context, userID int32, after FeedPosition, limit int32, fields ...string) ([]FeedEntry, error) {
+	r.mu.Lock()
+	var authors []int32
+	for _, f := range r.follows {
+		if f.UserId == userID {
+			authors = append(authors, f.AuthorId)
+		}
+	}
+	r.mu.Unlock()
+
+	var entries []FeedEntry
+	for _, authorID := range authors {
+		articles, _, err := r.articles.ListByUser(ctx, authorID, math.MaxInt32, 0)
+		if err != nil {
+			return nil, err
+		}
+		for _, article := range articles {
+			position := FeedPosition{CreatedAt: article.CreateTime.AsTime(), ID: article.Id}
+			if after.IsZero() || position.olderThan(after) {
+				entries = append(entries, FeedEntry{Article: article, Position: position})
+			}
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool { return entries[j].Position.olderThan(entries[i].Position) })
+	if int32(len(entries)) > limit {
+		entries = entries[:limit]
+	}
+	for i := range entries {
+		entries[i].Article = projectArticle(entries[i].Article, fields)
+	}
+	return entries, nil
+}
+
+// olderThan reports whether p follows q in the newest-first feed order
+func (p FeedPosition) olderThan(q FeedPosition) bool {
+	if p.CreatedAt.Equal(q.CreatedAt) {
+		return p.ID < q.ID
+	}
+	return p.CreatedAt.Before(q.CreatedAt)
+}
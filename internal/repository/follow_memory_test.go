@@ -0,0 +1,15 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/thatlq1812/service-2-article/internal/repository"
+	"github.com/thatlq1812/service-2-article/internal/repository/repotest"
+)
+
+func TestFollowMemoryRepository(t *testing.T) {
+	repotest.RunFollowRepositoryContract(t, func(t *testing.T) (repository.FollowRepository, repository.ArticleRepository) {
+		articles := repository.NewArticleMemoryRepository()
+		return repository.NewFollowMemoryRepository(articles), articles
+	})
+}
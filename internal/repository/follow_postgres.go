@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/thatlq1812/service-2-article/proto"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// followPostgresRepo implement FollowRepository with PostgreSQL
+type followPostgresRepo struct {
+	db *pgxpool.Pool
+}
+
+// NewFollowPostgresRepository
+func NewFollowPostgresRepository(db *pgxpool.Pool) FollowRepository {
+	return &followPostgresRepo{db: db}
+}
+
+// followColumns selects every Follow field
+const followColumns = `id, user_id, author_id, created_at`
+
+func scanFollow(row pgx.Row) (*pb.Follow, error) {
+	var f pb.Follow
+	var createdAt time.Time
+	if err := row.Scan(&f.Id, &f.UserId, &f.AuthorId, &createdAt); err != nil {
+		return nil, err
+	}
+	f.CreateTime = timestamppb.New(createdAt)
+	return &f, nil
+}
+
+// followLockClass namespaces the per-user advisory locks that serialise follows of one user
+const followLockClass int32 = 7_254_050
+
+// Follow holds a transaction advisory lock on userID while it counts and inserts, so concurrent
+// follows cannot pass the limit together. ON CONFLICT makes a concurrent duplicate skip.
+func (r *followPostgresRepo) Follow(ctx context.Context, userID, authorID, limit int32) (*pb.Follow, bool, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("follow author failed: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock($1, $2)`, followLockClass, userID); err != nil {
+		return nil, false, fmt.Errorf("lock follows failed: %w", err)
+	}
+	insert := `
+		INSERT INTO follows (user_id, author_id)
+		SELECT $1, $2
+		WHERE $3 = 0 OR (SELECT COUNT(*) FROM follows WHERE user_id = $1) < $3
+		ON CONFLICT DO NOTHING
+		RETURNING ` + followColumns
+	follow, err := scanFollow(tx.QueryRow(ctx, insert, userID, authorID, limit))
+	if err == nil {
+		return follow, true, tx.Commit(ctx)
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, false, fmt.Errorf("follow author failed: %w", err)
+	}
+
+	// Nothing inserted: either the follow exists or the limit is reached
+	existing := `SELECT ` + followColumns + ` FROM follows WHERE user_id = $1 AND author_id = $2`
+	follow, err = scanFollow(tx.QueryRow(ctx, existing, userID, authorID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, false, fmt.Errorf("user %d follows %d authors: %w", userID, limit, ErrLimitExceeded)
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("query follow failed: %w", err)
+	}
+	return follow, false, nil
+}
+
+// Unfollow
+func (r *followPostgresRepo) Unfollow(ctx context.Context, userID, authorID int32) (bool, error) {
+	result, err := r.db.Exec(ctx, `DELETE FROM follows WHERE user_id = $1 AND author_id = $2`, userID, authorID)
+	if err != nil {
+		return false, fmt.Errorf("unfollow author failed: %w", err)
+	}
+	return result.RowsAffected() > 0, nil
+}
+
+// ListFollowing pages by ID (keyset pagination), which orders follows like created_at
+func (r *followPostgresRepo) ListFollowing(ctx context.Context, userID, after, limit int32) ([]*pb.Follow, error) {
+	query := `
+		SELECT ` + followColumns + `
+		FROM follows
+		WHERE user_id = $1 AND ($2 = 0 OR id < $2)
+		ORDER BY id DESC
+		LIMIT $3
+	`
+	rows, err := r.db.Query(ctx, query, userID, after, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query follows failed: %w", err)
+	}
+	follows, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*pb.Follow, error) {
+		return scanFollow(row)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query follows failed: %w", err)
+	}
+	return follows, nil
+}
+
+// CountFollowing
+func (r *followPostgresRepo) CountFollowing(ctx context.Context, userID int32) (int32, error) {
+	var count int32
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM follows WHERE user_id = $1`, userID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("count follows failed: %w", err)
+	}
+	return count, nil
+}
+
+// Feed reads fan-out-on-read: the newest limit articles of each followed author come from
+// idx_articles_feed, so a page costs one short index range per author, then the merged page
+// is joined back to articles for the requested fields
+func (r *followPostgresRepo) Feed(ctx context.Context, userID int32, after FeedPosition, limit int32, fields ...string) ([]FeedEntry, error) {
+	fields = loadFields(fields)
+	args := []interface{}{userID, limit}
+	keyset := ""
+	if !after.IsZero() {
+		keyset = `AND (created_at, id) < ($3, $4)`
+		args = append(args, after.CreatedAt, after.ID)
+	}
+	query := `
+		WITH page AS (
+			SELECT latest.id, latest.created_at AS feed_time
+			FROM follows f
+			CROSS JOIN LATERAL (
+				SELECT id, created_at
+				FROM articles
+				WHERE user_id = f.author_id ` + keyset + `
+				ORDER BY created_at DESC, id DESC
+				LIMIT $2
+			) latest
+			WHERE f.user_id = $1
+			ORDER BY latest.created_at DESC, latest.id DESC
+			LIMIT $2
+		)
+		SELECT ` + selectColumns(fields) + `, page.feed_time
+		FROM articles
+		JOIN page USING (id)
+		ORDER BY page.feed_time DESC, id DESC
+	`
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query feed failed: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []FeedEntry
+	for rows.Next() {
+		var createdAt time.Time
+		article, err := scanArticleFields(trailingRow{Row: rows, extra: []interface{}{&createdAt}}, fields)
+		if err != nil {
+			return nil, fmt.Errorf("scan feed article failed: %w", err)
+		}
+		entries = append(entries, FeedEntry{Article: article, Position: FeedPosition{CreatedAt: createdAt, ID: article.Id}})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("query feed failed: %w", err)
+	}
+	return entries, nil
+}
@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/thatlq1812/service-2-article/proto"
+)
+
+// FollowRepository stores the authors users follow and reads their feeds: the articles of the
+// followed authors, merged newest first. Users and authors are User Service IDs.
+type FollowRepository interface {
+	// Follow makes userID follow authorID; added is false (with the existing follow) when it already did.
+	// userID may follow at most limit authors (0 for no limit): ErrLimitExceeded when a new follow
+	// would pass it. The check and the insert are atomic.
+	Follow(ctx context.Context, userID, authorID, limit int32) (follow *pb.Follow, added bool, err error)
+
+	// Unfollow; removed is false when userID did not follow authorID
+	Unfollow(ctx context.Context, userID, authorID int32) (removed bool, err error)
+
+	// ListFollowing returns up to limit follows of userID, most recent first,
+	// starting after the follow with ID after (0 for the first page)
+	ListFollowing(ctx context.Context, userID, after, limit int32) ([]*pb.Follow, error)
+
+	// CountFollowing returns the number of authors userID follows
+	CountFollowing(ctx context.Context, userID int32) (int32, error)
+
+	// Feed returns up to limit articles of the authors userID follows, newest first (created_at,
+	// then ID), starting after position after (zero for the first page). The articles carry only
+	// fields (all when none are given) and no author.
+	Feed(ctx context.Context, userID int32, after FeedPosition, limit int32, fields ...string) ([]FeedEntry, error)
+}
+
+// FeedPosition is the place of an article in a feed
+type FeedPosition struct {
+	CreatedAt time.Time
+	ID        int32
+}
+
+// IsZero reports whether p is the start of the feed
+func (p FeedPosition) IsZero() bool {
+	return p.CreatedAt.IsZero() && p.ID == 0
+}
+
+// FeedEntry is an article of a feed with its position, which pages the feed whatever fields are loaded
+type FeedEntry struct {
+	Article  *pb.Article
+	Position FeedPosition
+}
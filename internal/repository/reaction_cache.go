@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+
+	pb "github.com/thatlq1812/service-2-article/proto"
+)
+
+// reactionCacheRepo decorates a ReactionRepository so that changes to an article's reaction
+// counters invalidate the article entry cached by the article cache repository
+type reactionCacheRepo struct {
+	next  ReactionRepository
+	cache ArticleCache
+}
+
+// NewReactionCacheRepository wraps next, invalidating cached articles in cache
+func NewReactionCacheRepository(next ReactionRepository, cache ArticleCache) ReactionRepository {
+	return &reactionCacheRepo{next: next, cache: cache}
+}
+
+// AddReaction and invalidate its article when it was added
+func (r *reactionCacheRepo) AddReaction(ctx context.Context, articleID, userID int32, reactionType pb.ReactionType) (bool, error) {
+	added, err := r.next.AddReaction(ctx, articleID, userID, reactionType)
+	if err != nil {
+		return false, err
+	}
+	if added {
+		invalidateArticle(ctx, r.cache, articleID)
+	}
+	return added, nil
+}
+
+// RemoveReaction and invalidate its article when it was removed
+func (r *reactionCacheRepo) RemoveReaction(ctx context.Context, articleID, userID int32, reactionType pb.ReactionType) (bool, error) {
+	removed, err := r.next.RemoveReaction(ctx, articleID, userID, reactionType)
+	if err != nil {
+		return false, err
+	}
+	if removed {
+		invalidateArticle(ctx, r.cache, articleID)
+	}
+	return removed, nil
+}
+
+// ListReactions is not cached
+func (r *reactionCacheRepo) ListReactions(ctx context.Context, articleID int32, reactionType pb.ReactionType, limit, offset int32) ([]*pb.Reaction, int32, error) {
+	return r.next.ListReactions(ctx, articleID, reactionType, limit, offset)
+}
+
+// UserReactions is not cached
+func (r *reactionCacheRepo) UserReactions(ctx context.Context, articleID, userID int32) ([]pb.ReactionType, error) {
+	return r.next.UserReactions(ctx, articleID, userID)
+}
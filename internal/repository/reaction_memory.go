@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	pb "github.com/thatlq1812/service-2-article/proto"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// reactionKey is the primary key of a reaction
+type reactionKey struct {
+	articleID, userID int32
+	reactionType      pb.ReactionType
+}
+
+// memoryReaction keeps the insertion order, which orders reactions like created_at
+type memoryReaction struct {
+	createdAt time.Time
+	seq       int64
+}
+
+// reactionMemoryRepo implement ReactionRepository in memory.
+// Articles are looked up in the article repository it was created with.
+type reactionMemoryRepo struct {
+	mu        sync.Mutex
+	articles  ArticleRepository
+	reactions map[reactionKey]memoryReaction
+	seq       int64
+}
+
+// NewReactionMemoryRepository creates an empty in-memory repository (for tests and local development)
+// for the articles of articles. Reaction counts are kept in step when articles is the in-memory
+// article repository.
+func NewReactionMemoryRepository(articles ArticleRepository) ReactionRepository {
+	return &reactionMemoryRepo{
+		articles:  articles,
+		reactions: make(map[reactionKey]memoryReaction),
+	}
+}
+
+// recount stores the reaction counters of an article
+func (r *reactionMemoryRepo) recount(articleID int32) {
+	counters, ok := r.articles.(counterUpdater)
+	if !ok {
+		return
+	}
+	counts := make(map[int32]int32)
+	var total int32
+	for key := range r.reactions {
+		if key.articleID == articleID {
+			counts[int32(key.reactionType)]++
+			total++
+		}
+	}
+	counters.updateCounters(articleID, func(article *pb.Article) {
+		article.ReactionCount = total
+		article.ReactionCounts = reactionCountList(counts)
+	})
+}
+
+// AddReaction
+func (r *reactionMemoryRepo) AddReaction(ctx context.Context, articleID, userID int32, reactionType pb.ReactionType) (bool, error) {
+	if _, err := r.articles.GetByID(ctx, articleID, "id"); err != nil {
+		return false, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := reactionKey{articleID: articleID, userID: userID, reactionType: reactionType}
+	if _, ok := r.reactions[key]; ok {
+		return false, nil
+	}
+	r.seq++
+	r.reactions[key] = memoryReaction{createdAt: time.Now(), seq: r.seq}
+	r.recount(articleID)
+	return true, nil
+}
+
+// RemoveReaction
+func (r *reactionMemoryRepo) RemoveReaction(ctx context.Context, articleID, userID int32, reactionType pb.ReactionType) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := reactionKey{articleID: articleID, userID: userID, reactionType: reactionType}
+	if _, ok := r.reactions[key]; !ok {
+		return false, nil
+	}
+	delete(r.reactions, key)
+	r.recount(articleID)
+	return true, nil
+}
+
+// ListReactions
+func (r *reactionMemoryRepo) ListReactions(ctx context.Context, articleID int32, reactionType pb.ReactionType, limit, offset int32) ([]*pb.Reaction, int32, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var keys []reactionKey
+	for key := range r.reactions {
+		if key.articleID == articleID && (reactionType == pb.ReactionType_REACTION_TYPE_UNSPECIFIED || key.reactionType == reactionType) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return r.reactions[keys[i]].seq > r.reactions[keys[j]].seq })
+
+	total := int32(len(keys))
+	if offset < 0 {
+		offset = 0
+	}
+	var page []*pb.Reaction
+	for i := offset; i < total && int32(len(page)) < limit; i++ {
+		key := keys[i]
+		page = append(page, &pb.Reaction{
+			ArticleId:  key.articleID,
+			UserId:     key.userID,
+			Type:       key.reactionType,
+			CreateTime: timestamppb.New(r.reactions[key].createdAt),
+		})
+	}
+	return page, total, nil
+}
+
+// UserReactions
+func (r *reactionMemoryRepo) UserReactions(ctx context.Context, articleID, userID int32) ([]pb.ReactionType, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var types []pb.ReactionType
+	for key := range r.reactions {
+		if key.articleID == articleID && key.userID == userID {
+			types = append(types, key.reactionType)
+		}
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	return types, nil
+}
@@ -0,0 +1,26 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/thatlq1812/service-2-article/internal/repository"
+	"github.com/thatlq1812/service-2-article/internal/repository/repotest"
+)
+
+func TestReactionMemoryRepository(t *testing.T) {
+	repotest.RunReactionRepositoryContract(t, func(t *testing.T) (repository.ReactionRepository, repository.ArticleRepository) {
+		articles := repository.NewArticleMemoryRepository()
+		return repository.NewReactionMemoryRepository(articles), articles
+	})
+}
+
+// Reaction changes must invalidate the cached article, whose counters they change
+func TestReactionCacheRepository(t *testing.T) {
+	repotest.RunReactionRepositoryContract(t, func(t *testing.T) (repository.ReactionRepository, repository.ArticleRepository) {
+		cache := newMapCache()
+		articles := repository.NewArticleMemoryRepository()
+		reactions := repository.NewReactionCacheRepository(repository.NewReactionMemoryRepository(articles), cache)
+		return reactions, repository.NewArticleCacheRepository(articles, cache, time.Minute)
+	})
+}
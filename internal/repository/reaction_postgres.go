@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	pb "github.com/thatlq1812/service-2-article/proto"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// reactionPostgresRepo implement ReactionRepository with PostgreSQL
+type reactionPostgresRepo struct {
+	db *pgxpool.Pool
+}
+
+// NewReactionPostgresRepository
+func NewReactionPostgresRepository(db *pgxpool.Pool) ReactionRepository {
+	return &reactionPostgresRepo{db: db}
+}
+
+// countReaction adds delta to the counters of an article. The UPDATE locks the article row, so
+// concurrent changes are applied one after the other and never lose an increment.
+func countReaction(ctx context.Context, tx pgx.Tx, articleID int32, reactionType pb.ReactionType, delta int32) error {
+	query := `
+		UPDATE articles
+		SET reaction_count = reaction_count + $3,
+			reaction_counts = jsonb_set(reaction_counts, ARRAY[$2::text],
+				to_jsonb(COALESCE((reaction_counts->>$2::text)::int, 0) + $3))
+		WHERE id = $1
+	`
+	key := strconv.Itoa(int(reactionType))
+	if _, err := tx.Exec(ctx, query, articleID, key, delta); err != nil {
+		return fmt.Errorf("count reactions failed: %w", err)
+	}
+	return nil
+}
+
+// changeReaction runs query, which inserts or deletes one reaction and returns 1 when it did,
+// and counts the change with delta in the same transaction
+func (r *reactionPostgresRepo) changeReaction(ctx context.Context, query string, articleID, userID int32, reactionType pb.ReactionType, delta int32) (bool, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	var one int
+	err = tx.QueryRow(ctx, query, articleID, userID, int16(reactionType)).Scan(&one)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("article with ID %d: %w", articleID, mapPgError(err))
+	}
+	if err := countReaction(ctx, tx, articleID, reactionType, delta); err != nil {
+		return false, err
+	}
+	return true, tx.Commit(ctx)
+}
+
+// AddReaction; ON CONFLICT makes a concurrent duplicate wait for the first insert and then skip
+func (r *reactionPostgresRepo) AddReaction(ctx context.Context, articleID, userID int32, reactionType pb.ReactionType) (bool, error) {
+	query := `
+		INSERT INTO article_reactions (article_id, user_id, type)
+		VALUES ($1, $2, $3)
+		ON CONFLICT DO NOTHING
+		RETURNING 1
+	`
+	added, err := r.changeReaction(ctx, query, articleID, userID, reactionType, 1)
+	if err != nil {
+		return false, fmt.Errorf("add reaction failed: %w", err)
+	}
+	return added, nil
+}
+
+// RemoveReaction
+func (r *reactionPostgresRepo) RemoveReaction(ctx context.Context, articleID, userID int32, reactionType pb.ReactionType) (bool, error) {
+	query := `
+		DELETE FROM article_reactions
+		WHERE article_id = $1 AND user_id = $2 AND type = $3
+		RETURNING 1
+	`
+	removed, err := r.changeReaction(ctx, query, articleID, userID, reactionType, -1)
+	if err != nil {
+		return false, fmt.Errorf("remove reaction failed: %w", err)
+	}
+	return removed, nil
+}
+
+// ListReactions
+func (r *reactionPostgresRepo) ListReactions(ctx context.Context, articleID int32, reactionType pb.ReactionType, limit, offset int32) ([]*pb.Reaction, int32, error) {
+	query := `
+		SELECT article_id, user_id, type, created_at
+		FROM article_reactions
+		WHERE article_id = $1 AND ($2 = 0 OR type = $2)
+		ORDER BY created_at DESC, user_id, type
+		LIMIT $3 OFFSET $4
+	`
+	rows, err := r.db.Query(ctx, query, articleID, int16(reactionType), limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("query reactions failed: %w", err)
+	}
+	reactions, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*pb.Reaction, error) {
+		var reaction pb.Reaction
+		var reactionType int16
+		var createdAt time.Time
+		if err := row.Scan(&reaction.ArticleId, &reaction.UserId, &reactionType, &createdAt); err != nil {
+			return nil, err
+		}
+		reaction.Type = pb.ReactionType(reactionType)
+		reaction.CreateTime = timestamppb.New(createdAt)
+		return &reaction, nil
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("query reactions failed: %w", err)
+	}
+
+	var total int32
+	countQuery := `SELECT COUNT(*) FROM article_reactions WHERE article_id = $1 AND ($2 = 0 OR type = $2)`
+	if err := r.db.QueryRow(ctx, countQuery, articleID, int16(reactionType)).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("count reactions failed: %w", err)
+	}
+	return reactions, total, nil
+}
+
+// UserReactions
+func (r *reactionPostgresRepo) UserReactions(ctx context.Context, articleID, userID int32) ([]pb.ReactionType, error) {
+	query := `SELECT type FROM article_reactions WHERE article_id = $1 AND user_id = $2 ORDER BY type`
+	rows, err := r.db.Query(ctx, query, articleID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("query reactions failed: %w", err)
+	}
+	types, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (pb.ReactionType, error) {
+		var reactionType int16
+		err := row.Scan(&reactionType)
+		return pb.ReactionType(reactionType), err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query reactions failed: %w", err)
+	}
+	return types, nil
+}
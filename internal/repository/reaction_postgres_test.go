@@ -0,0 +1,21 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/thatlq1812/service-2-article/internal/dbtest"
+	"github.com/thatlq1812/service-2-article/internal/repository"
+	"github.com/thatlq1812/service-2-article/internal/repository/repotest"
+)
+
+// Every table is emptied between subtests
+func TestReactionPostgresRepository(t *testing.T) {
+	pool := dbtest.OpenPool(t)
+
+	repotest.RunReactionRepositoryContract(t, func(t *testing.T) (repository.ReactionRepository, repository.ArticleRepository) {
+		dbtest.ResetTables(t, pool)
+		return repository.NewReactionPostgresRepository(pool), repository.NewArticlePostgresRepository(pool)
+	})
+}
@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+	"sort"
+
+	pb "github.com/thatlq1812/service-2-article/proto"
+)
+
+// ReactionRepository stores reactions to articles, at most one of each type per user and article,
+// and keeps Article.reaction_count and Article.reaction_counts in step with them.
+// Reactions are returned without their user.
+type ReactionRepository interface {
+	// AddReaction adds a reaction of userID; added is false when it was already there.
+	// ErrNotFound if the article does not exist
+	AddReaction(ctx context.Context, articleID, userID int32, reactionType pb.ReactionType) (added bool, err error)
+
+	// RemoveReaction removes a reaction of userID; removed is false when there was none
+	RemoveReaction(ctx context.Context, articleID, userID int32, reactionType pb.ReactionType) (removed bool, err error)
+
+	// ListReactions returns a page of the reactions to an article (of reactionType unless
+	// UNSPECIFIED), newest first, and their number
+	ListReactions(ctx context.Context, articleID int32, reactionType pb.ReactionType, limit, offset int32) ([]*pb.Reaction, int32, error)
+
+	// UserReactions returns the reaction types userID added to an article, in type order
+	UserReactions(ctx context.Context, articleID, userID int32) ([]pb.ReactionType, error)
+}
+
+// reactionCountList converts counts by ReactionType number to Article.reaction_counts:
+// in type order, without zero counts
+func reactionCountList(counts map[int32]int32) []*pb.ReactionCount {
+	var list []*pb.ReactionCount
+	for t, n := range counts {
+		if n > 0 {
+			list = append(list, &pb.ReactionCount{Type: pb.ReactionType(t), Count: n})
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Type < list[j].Type })
+	return list
+}
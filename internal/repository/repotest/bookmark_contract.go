@@ -0,0 +1,201 @@
+package repotest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/thatlq1812/service-2-article/internal/repository"
+	pb "github.com/thatlq1812/service-2-article/proto"
+)
+
+// BookmarkFactory returns an empty bookmark repository and the article repository it bookmarks
+type BookmarkFactory func(t *testing.T) (repository.BookmarkRepository, repository.ArticleRepository)
+
+// RunBookmarkRepositoryContract runs the shared bookmark suite against newRepos
+func RunBookmarkRepositoryContract(t *testing.T, newRepos BookmarkFactory) {
+	t.Run("AddRemoveBookmark", func(t *testing.T) { testAddRemoveBookmark(t, newRepos) })
+	t.Run("ListBookmarksByCursor", func(t *testing.T) { testListBookmarks(t, newRepos) })
+	t.Run("Collections", func(t *testing.T) { testCollections(t, newRepos) })
+	t.Run("ShareCollection", func(t *testing.T) { testShareCollection(t, newRepos) })
+}
+
+func mustBookmark(t *testing.T, repo repository.BookmarkRepository, userID, articleID, collectionID int32) *pb.Bookmark {
+	t.Helper()
+	b, _, err := repo.AddBookmark(context.Background(), userID, articleID, collectionID)
+	if err != nil {
+		t.Fatalf("AddBookmark(%d, %d, %d) failed: %v", userID, articleID, collectionID, err)
+	}
+	return b
+}
+
+func mustCollection(t *testing.T, repo repository.BookmarkRepository, userID int32, name string) *pb.Collection {
+	t.Helper()
+	c, err := repo.CreateCollection(context.Background(), userID, name)
+	if err != nil {
+		t.Fatalf("CreateCollection(%q) failed: %v", name, err)
+	}
+	return c
+}
+
+// bookmarkedArticles lists the article IDs of a whole list
+func bookmarkedArticles(t *testing.T, repo repository.BookmarkRepository, userID, collectionID int32) []int32 {
+	t.Helper()
+	bookmarks, err := repo.ListBookmarks(context.Background(), userID, collectionID, 0, 100)
+	if err != nil {
+		t.Fatalf("ListBookmarks failed: %v", err)
+	}
+	ids := make([]int32, 0, len(bookmarks))
+	for _, b := range bookmarks {
+		ids = append(ids, b.ArticleId)
+	}
+	return ids
+}
+
+func testAddRemoveBookmark(t *testing.T, newRepos BookmarkFactory) {
+	ctx := context.Background()
+	bookmarks, articles := newRepos(t)
+	articleID := mustCreate(t, articles, "Saved", "Content", 1)
+	reading := mustCollection(t, bookmarks, 5, "Reading")
+
+	saved, added, err := bookmarks.AddBookmark(ctx, 5, articleID, 0)
+	if err != nil || !added || saved.Id <= 0 || saved.ArticleId != articleID || saved.CollectionId != 0 || saved.CreateTime == nil {
+		t.Fatalf("AddBookmark = %+v, %v, %v; want a new bookmark in the default list", saved, added, err)
+	}
+	again, added, err := bookmarks.AddBookmark(ctx, 5, articleID, 0)
+	if err != nil || added || again.Id != saved.Id {
+		t.Errorf("AddBookmark(again) = %+v, %v, %v; want the existing bookmark", again, added, err)
+	}
+	// The same article can be saved to a collection and by another user
+	if _, added, err := bookmarks.AddBookmark(ctx, 5, articleID, reading.Id); err != nil || !added {
+		t.Errorf("AddBookmark(collection) = %v, %v; want added", added, err)
+	}
+	mustBookmark(t, bookmarks, 6, articleID, 0)
+
+	if _, _, err := bookmarks.AddBookmark(ctx, 5, 999, 0); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("AddBookmark(missing article) = %v, want ErrNotFound", err)
+	}
+	if _, _, err := bookmarks.AddBookmark(ctx, 6, articleID, reading.Id); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("AddBookmark(other user's collection) = %v, want ErrNotFound", err)
+	}
+
+	removed, err := bookmarks.RemoveBookmark(ctx, 5, articleID, 0)
+	if err != nil || !removed {
+		t.Fatalf("RemoveBookmark = %v, %v; want removed", removed, err)
+	}
+	if again, err := bookmarks.RemoveBookmark(ctx, 5, articleID, 0); err != nil || again {
+		t.Errorf("RemoveBookmark(again) = %v, %v; want not removed", again, err)
+	}
+	if ids := bookmarkedArticles(t, bookmarks, 5, reading.Id); len(ids) != 1 {
+		t.Errorf("collection bookmarks = %v, want the article kept", ids)
+	}
+	if ids := bookmarkedArticles(t, bookmarks, 6, 0); len(ids) != 1 {
+		t.Errorf("other user's bookmarks = %v, want the article kept", ids)
+	}
+}
+
+func testListBookmarks(t *testing.T, newRepos BookmarkFactory) {
+	ctx := context.Background()
+	bookmarks, articles := newRepos(t)
+	var ids []int32
+	for _, title := range []string{"One", "Two", "Three"} {
+		id := mustCreate(t, articles, title, "Content", 1)
+		mustBookmark(t, bookmarks, 5, id, 0)
+		ids = append(ids, id)
+	}
+
+	first, err := bookmarks.ListBookmarks(ctx, 5, 0, 0, 2)
+	if err != nil || len(first) != 2 || first[0].ArticleId != ids[2] || first[1].ArticleId != ids[1] {
+		t.Fatalf("ListBookmarks(first page) = %v, %v; want the 2 most recent", first, err)
+	}
+	rest, err := bookmarks.ListBookmarks(ctx, 5, 0, first[1].Id, 2)
+	if err != nil || len(rest) != 1 || rest[0].ArticleId != ids[0] {
+		t.Errorf("ListBookmarks(after) = %v, %v; want the oldest", rest, err)
+	}
+	if other, _ := bookmarks.ListBookmarks(ctx, 6, 0, 0, 10); len(other) != 0 {
+		t.Errorf("ListBookmarks(other user) = %v, want none", other)
+	}
+}
+
+func testCollections(t *testing.T, newRepos BookmarkFactory) {
+	ctx := context.Background()
+	bookmarks, articles := newRepos(t)
+	articleID := mustCreate(t, articles, "Saved", "Content", 1)
+
+	a := mustCollection(t, bookmarks, 5, "A")
+	b := mustCollection(t, bookmarks, 5, "B")
+	c := mustCollection(t, bookmarks, 5, "C")
+	mustCollection(t, bookmarks, 6, "A")
+	if a.Position != 0 || c.Position != 2 || a.CreateTime == nil {
+		t.Errorf("positions = %d, %d; want 0, 2", a.Position, c.Position)
+	}
+	if _, err := bookmarks.CreateCollection(ctx, 5, "A"); !errors.Is(err, repository.ErrConflict) {
+		t.Errorf("CreateCollection(duplicate name) = %v, want ErrConflict", err)
+	}
+
+	renamed, err := bookmarks.RenameCollection(ctx, 5, b.Id, "Later")
+	if err != nil || renamed.Name != "Later" {
+		t.Errorf("RenameCollection = %+v, %v; want Later", renamed, err)
+	}
+	if _, err := bookmarks.RenameCollection(ctx, 5, b.Id, "A"); !errors.Is(err, repository.ErrConflict) {
+		t.Errorf("RenameCollection(taken name) = %v, want ErrConflict", err)
+	}
+	if _, err := bookmarks.RenameCollection(ctx, 6, b.Id, "Mine"); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("RenameCollection(other user) = %v, want ErrNotFound", err)
+	}
+
+	reordered, err := bookmarks.ReorderCollections(ctx, 5, []int32{c.Id, a.Id, b.Id})
+	if err != nil || len(reordered) != 3 || reordered[0].Id != c.Id || reordered[1].Id != a.Id || reordered[2].Position != 2 {
+		t.Fatalf("ReorderCollections = %v, %v; want C, A, Later", reordered, err)
+	}
+
+	mustBookmark(t, bookmarks, 5, articleID, a.Id)
+	got, err := bookmarks.GetCollection(ctx, 5, a.Id)
+	if err != nil || got.BookmarkCount != 1 || got.Position != 1 {
+		t.Errorf("GetCollection = %+v, %v; want 1 bookmark at position 1", got, err)
+	}
+
+	if err := bookmarks.DeleteCollection(ctx, 6, a.Id); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("DeleteCollection(other user) = %v, want ErrNotFound", err)
+	}
+	if err := bookmarks.DeleteCollection(ctx, 5, a.Id); err != nil {
+		t.Fatalf("DeleteCollection failed: %v", err)
+	}
+	if _, err := bookmarks.GetCollection(ctx, 5, a.Id); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("GetCollection(deleted) = %v, want ErrNotFound", err)
+	}
+	if ids := bookmarkedArticles(t, bookmarks, 5, a.Id); len(ids) != 0 {
+		t.Errorf("bookmarks of deleted collection = %v, want none", ids)
+	}
+	if list, _ := bookmarks.ListCollections(ctx, 5); len(list) != 2 {
+		t.Errorf("ListCollections = %v, want 2 left", list)
+	}
+}
+
+func testShareCollection(t *testing.T, newRepos BookmarkFactory) {
+	ctx := context.Background()
+	bookmarks, _ := newRepos(t)
+	c := mustCollection(t, bookmarks, 5, "Shared")
+
+	if _, err := bookmarks.GetSharedCollection(ctx, "token-1"); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("GetSharedCollection(before sharing) = %v, want ErrNotFound", err)
+	}
+	shared, err := bookmarks.SetShareToken(ctx, 5, c.Id, "token-1")
+	if err != nil || shared.ShareToken != "token-1" {
+		t.Fatalf("SetShareToken = %+v, %v; want token-1", shared, err)
+	}
+	got, err := bookmarks.GetSharedCollection(ctx, "token-1")
+	if err != nil || got.Id != c.Id || got.UserId != 5 {
+		t.Errorf("GetSharedCollection = %+v, %v; want the shared collection", got, err)
+	}
+
+	if _, err := bookmarks.SetShareToken(ctx, 5, c.Id, ""); err != nil {
+		t.Fatalf("SetShareToken(revoke) failed: %v", err)
+	}
+	if _, err := bookmarks.GetSharedCollection(ctx, "token-1"); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("GetSharedCollection(revoked) = %v, want ErrNotFound", err)
+	}
+	if _, err := bookmarks.GetSharedCollection(ctx, ""); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("GetSharedCollection(empty token) = %v, want ErrNotFound", err)
+	}
+}
@@ -0,0 +1,217 @@
+package repotest
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/thatlq1812/service-2-article/internal/repository"
+	pb "github.com/thatlq1812/service-2-article/proto"
+)
+
+// CommentFactory returns an empty comment repository and the article repository it comments on
+type CommentFactory func(t *testing.T) (repository.CommentRepository, repository.ArticleRepository)
+
+// RunCommentRepositoryContract runs the shared comment suite against newRepos
+func RunCommentRepositoryContract(t *testing.T, newRepos CommentFactory) {
+	t.Run("CreateAndReply", func(t *testing.T) { testCreateAndReply(t, newRepos) })
+	t.Run("ListNestsRepliesAndPaginates", func(t *testing.T) { testListComments(t, newRepos) })
+	t.Run("ListCapsRepliesPerThread", func(t *testing.T) { testListReplies(t, newRepos) })
+	t.Run("HideUpdateDelete", func(t *testing.T) { testHideUpdateDelete(t, newRepos) })
+}
+
+func mustComment(t *testing.T, repo repository.CommentRepository, articleID, parentID int32, content string) *pb.Comment {
+	t.Helper()
+	c, err := repo.CreateComment(context.Background(), &pb.Comment{ArticleId: articleID, ParentId: parentID, UserId: 5, Content: content})
+	if err != nil {
+		t.Fatalf("CreateComment(%q) failed: %v", content, err)
+	}
+	return c
+}
+
+// commentCount reads Article.comment_count through the article repository
+func commentCount(t *testing.T, articles repository.ArticleRepository, id int32) int32 {
+	t.Helper()
+	article, err := articles.GetByID(context.Background(), id)
+	if err != nil {
+		t.Fatalf("GetByID(%d) failed: %v", id, err)
+	}
+	return article.CommentCount
+}
+
+func testCreateAndReply(t *testing.T, newRepos CommentFactory) {
+	ctx := context.Background()
+	comments, articles := newRepos(t)
+	articleID := mustCreate(t, articles, "Commented", "Content", 1)
+	otherID := mustCreate(t, articles, "Other", "Content", 1)
+
+	top := mustComment(t, comments, articleID, 0, "First")
+	if top.Id <= 0 || top.ParentId != 0 || top.Depth != 0 || top.UserId != 5 || top.CreateTime == nil {
+		t.Fatalf("CreateComment = %+v, want a top-level comment with id and create_time", top)
+	}
+	reply := mustComment(t, comments, articleID, top.Id, "Reply")
+	nested := mustComment(t, comments, articleID, reply.Id, "Nested")
+	if reply.ParentId != top.Id || reply.Depth != 1 || nested.Depth != 2 {
+		t.Errorf("reply depths = %d, %d; want 1, 2", reply.Depth, nested.Depth)
+	}
+
+	if _, err := comments.CreateComment(ctx, &pb.Comment{ArticleId: 999, UserId: 5, Content: "x"}); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("CreateComment(missing article) = %v, want ErrNotFound", err)
+	}
+	if _, err := comments.CreateComment(ctx, &pb.Comment{ArticleId: otherID, ParentId: top.Id, UserId: 5, Content: "x"}); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("CreateComment(parent in another article) = %v, want ErrNotFound", err)
+	}
+
+	got, err := comments.GetComment(ctx, nested.Id)
+	if err != nil || got.Content != "Nested" || got.ArticleId != articleID {
+		t.Errorf("GetComment = %+v, %v; want the nested reply", got, err)
+	}
+	if _, err := comments.GetComment(ctx, 999); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("GetComment(missing) = %v, want ErrNotFound", err)
+	}
+	if n := commentCount(t, articles, articleID); n != 3 {
+		t.Errorf("comment_count = %d, want 3", n)
+	}
+	if n := commentCount(t, articles, otherID); n != 0 {
+		t.Errorf("comment_count of other article = %d, want 0", n)
+	}
+}
+
+func testListComments(t *testing.T, newRepos CommentFactory) {
+	comments, articles := newRepos(t)
+	articleID := mustCreate(t, articles, "Commented", "Content", 1)
+
+	first := mustComment(t, comments, articleID, 0, "First")
+	second := mustComment(t, comments, articleID, 0, "Second")
+	mustComment(t, comments, articleID, 0, "Third")
+	a := mustComment(t, comments, articleID, first.Id, "First/a")
+	mustComment(t, comments, articleID, first.Id, "First/b")
+	mustComment(t, comments, articleID, a.Id, "First/a/i")
+
+	page, total, err := comments.ListComments(context.Background(), articleID, 2, 0, 10)
+	if err != nil {
+		t.Fatalf("ListComments failed: %v", err)
+	}
+	if total != 3 || len(page) != 2 || page[0].Id != first.Id || page[1].Id != second.Id {
+		t.Fatalf("ListComments = %d comments of %d, want the first 2 of 3, oldest first", len(page), total)
+	}
+	if page[0].ReplyCount != 3 || page[1].ReplyCount != 0 {
+		t.Errorf("reply counts = %d, %d; want 3, 0", page[0].ReplyCount, page[1].ReplyCount)
+	}
+	replies := page[0].Replies
+	if len(replies) != 2 || replies[0].Content != "First/a" || replies[1].Content != "First/b" {
+		t.Fatalf("replies = %v, want First/a and First/b", replies)
+	}
+	if deep := replies[0].Replies; len(deep) != 1 || deep[0].Content != "First/a/i" {
+		t.Errorf("nested replies = %v, want First/a/i", deep)
+	}
+	if len(page[1].Replies) != 0 {
+		t.Errorf("Second has replies %v, want none", page[1].Replies)
+	}
+
+	last, _, err := comments.ListComments(context.Background(), articleID, 2, 2, 10)
+	if err != nil || len(last) != 1 || last[0].Content != "Third" {
+		t.Errorf("ListComments(page 2) = %v, %v; want Third", last, err)
+	}
+}
+
+func testListReplies(t *testing.T, newRepos CommentFactory) {
+	ctx := context.Background()
+	comments, articles := newRepos(t)
+	articleID := mustCreate(t, articles, "Commented", "Content", 1)
+
+	popular := mustComment(t, comments, articleID, 0, "Popular")
+	quiet := mustComment(t, comments, articleID, 0, "Quiet")
+	a := mustComment(t, comments, articleID, popular.Id, "a")
+	mustComment(t, comments, articleID, a.Id, "a/i")
+	mustComment(t, comments, articleID, quiet.Id, "quiet reply")
+	mustComment(t, comments, articleID, popular.Id, "b")
+	mustComment(t, comments, articleID, a.Id, "a/ii")
+	mustComment(t, comments, articleID, popular.Id, "c")
+
+	page, _, err := comments.ListComments(ctx, articleID, 10, 0, 2)
+	if err != nil || len(page) != 2 {
+		t.Fatalf("ListComments = %v, %v; want 2 comments", page, err)
+	}
+	if page[0].ReplyCount != 5 || page[1].ReplyCount != 1 {
+		t.Errorf("reply counts = %d, %d; want 5, 1", page[0].ReplyCount, page[1].ReplyCount)
+	}
+	// The first 2 replies of the thread in ID order, nested under their parents
+	if r := page[0].Replies; len(r) != 1 || r[0].Content != "a" || len(r[0].Replies) != 1 || r[0].Replies[0].Content != "a/i" {
+		t.Fatalf("replies of Popular = %v, want a with a/i", r)
+	}
+	if r := page[1].Replies; len(r) != 1 || r[0].Content != "quiet reply" {
+		t.Errorf("replies of Quiet = %v, want quiet reply", r)
+	}
+
+	// The rest of the thread, flat and in ID order, from the last reply returned
+	after := page[0].Replies[0].Replies[0].Id
+	var rest []string
+	for {
+		replies, err := comments.ListReplies(ctx, popular.Id, after, 2)
+		if err != nil {
+			t.Fatalf("ListReplies failed: %v", err)
+		}
+		for _, r := range replies {
+			if len(r.Replies) != 0 {
+				t.Errorf("ListReplies nested %v under %q, want a flat list", r.Replies, r.Content)
+			}
+			rest = append(rest, r.Content)
+		}
+		if len(replies) < 2 {
+			break
+		}
+		after = replies[len(replies)-1].Id
+	}
+	if want := []string{"b", "a/ii", "c"}; !slices.Equal(rest, want) {
+		t.Errorf("ListReplies pages = %v, want %v", rest, want)
+	}
+}
+
+func testHideUpdateDelete(t *testing.T, newRepos CommentFactory) {
+	ctx := context.Background()
+	comments, articles := newRepos(t)
+	articleID := mustCreate(t, articles, "Commented", "Content", 1)
+
+	top := mustComment(t, comments, articleID, 0, "Top")
+	reply := mustComment(t, comments, articleID, top.Id, "Reply")
+	other := mustComment(t, comments, articleID, 0, "Other")
+
+	hidden, err := comments.SetCommentHidden(ctx, other.Id, true)
+	if err != nil || !hidden.Hidden {
+		t.Fatalf("SetCommentHidden = %+v, %v; want hidden", hidden, err)
+	}
+	if n := commentCount(t, articles, articleID); n != 2 {
+		t.Errorf("comment_count after hiding = %d, want 2", n)
+	}
+
+	updated, err := comments.UpdateComment(ctx, reply.Id, "Edited")
+	if err != nil || updated.Content != "Edited" || updated.UpdateTime == nil {
+		t.Fatalf("UpdateComment = %+v, %v; want the new content", updated, err)
+	}
+	if _, err := comments.UpdateComment(ctx, 999, "x"); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("UpdateComment(missing) = %v, want ErrNotFound", err)
+	}
+
+	deleted, err := comments.DeleteComment(ctx, top.Id)
+	if err != nil || deleted.Id != top.Id || deleted.ArticleId != articleID {
+		t.Fatalf("DeleteComment = %+v, %v; want the deleted comment", deleted, err)
+	}
+	if _, err := comments.GetComment(ctx, reply.Id); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("GetComment(reply of deleted) = %v, want ErrNotFound", err)
+	}
+	if _, err := comments.DeleteComment(ctx, top.Id); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("DeleteComment(again) = %v, want ErrNotFound", err)
+	}
+	if n := commentCount(t, articles, articleID); n != 0 {
+		t.Errorf("comment_count after delete = %d, want 0 (only the hidden comment is left)", n)
+	}
+
+	if _, err := comments.SetCommentHidden(ctx, other.Id, false); err != nil {
+		t.Fatalf("SetCommentHidden(false) failed: %v", err)
+	}
+	if n := commentCount(t, articles, articleID); n != 1 {
+		t.Errorf("comment_count after showing = %d, want 1", n)
+	}
+}
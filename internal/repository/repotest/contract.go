@@ -0,0 +1,401 @@
+// Package repotest provides a contract test suite that every
+// repository.ArticleRepository implementation must pass.
+package repotest
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/thatlq1812/service-2-article/internal/repository"
+	pb "github.com/thatlq1812/service-2-article/proto"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Factory returns an empty repository for a single subtest
+type Factory func(t *testing.T) repository.ArticleRepository
+
+// RunArticleRepositoryContract runs the shared contract suite against newRepo
+func RunArticleRepositoryContract(t *testing.T, newRepo Factory) {
+	t.Run("CreateAndGet", func(t *testing.T) { testCreateAndGet(t, newRepo(t)) })
+	t.Run("GetNotFound", func(t *testing.T) { testGetNotFound(t, newRepo(t)) })
+	t.Run("Update", func(t *testing.T) { testUpdate(t, newRepo(t)) })
+	t.Run("UpdateNotFound", func(t *testing.T) { testUpdateNotFound(t, newRepo(t)) })
+	t.Run("Delete", func(t *testing.T) { testDelete(t, newRepo(t)) })
+	t.Run("DeleteNotFound", func(t *testing.T) { testDeleteNotFound(t, newRepo(t)) })
+	t.Run("ListAllOrderAndPagination", func(t *testing.T) { testListAll(t, newRepo(t)) })
+	t.Run("ListByUser", func(t *testing.T) { testListByUser(t, newRepo(t)) })
+	t.Run("ListByIDs", func(t *testing.T) { testListByIDs(t, newRepo(t)) })
+	t.Run("LoadSelectedFields", func(t *testing.T) { testLoadSelectedFields(t, newRepo(t)) })
+	t.Run("Export", func(t *testing.T) { testExport(t, newRepo(t)) })
+	t.Run("ImportKeepsTimestamps", func(t *testing.T) { testImport(t, newRepo(t)) })
+	t.Run("RelatedIDs", func(t *testing.T) { testRelatedIDs(t, newRepo(t)) })
+}
+
+func mustCreate(t *testing.T, repo repository.ArticleRepository, title, content string, userID int32) int32 {
+	t.Helper()
+	article, err := repo.Create(context.Background(), &pb.Article{Title: title, Content: content, UserId: userID})
+	if err != nil {
+		t.Fatalf("Create(%q) failed: %v", title, err)
+	}
+	return article.Id
+}
+
+func testCreateAndGet(t *testing.T, repo repository.ArticleRepository) {
+	ctx := context.Background()
+
+	created, err := repo.Create(ctx, &pb.Article{
+		Title:              "Title",
+		Content:            "*Content*",
+		UserId:             7,
+		ContentFormat:      pb.ContentFormat_CONTENT_FORMAT_MARKDOWN,
+		ContentHtml:        "<p><em>Content</em></p>",
+		Excerpt:            "Content",
+		WordCount:          1,
+		ReadingTimeMinutes: 1,
+	})
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if created.Id <= 0 {
+		t.Errorf("Create returned id %d, want > 0", created.Id)
+	}
+	if created.Title != "Title" || created.Content != "*Content*" || created.UserId != 7 {
+		t.Errorf("Create returned %+v, want title/content/user_id to match input", created)
+	}
+	if created.ContentFormat != pb.ContentFormat_CONTENT_FORMAT_MARKDOWN || created.ContentHtml != "<p><em>Content</em></p>" {
+		t.Errorf("Create returned format %s, html %q; want input values", created.ContentFormat, created.ContentHtml)
+	}
+	if created.Excerpt != "Content" || created.WordCount != 1 || created.ReadingTimeMinutes != 1 {
+		t.Errorf("Create returned excerpt %q, word_count %d, reading_time %d; want input values",
+			created.Excerpt, created.WordCount, created.ReadingTimeMinutes)
+	}
+	if created.CreatedAt == "" || created.UpdatedAt == "" {
+		t.Errorf("Create returned empty timestamps: created_at=%q, updated_at=%q", created.CreatedAt, created.UpdatedAt)
+	}
+	if created.CreateTime == nil || created.UpdateTime == nil {
+		t.Fatalf("Create returned nil create_time/update_time")
+	}
+	if want := created.CreateTime.AsTime().UTC().Format(time.RFC3339); created.CreatedAt != want {
+		t.Errorf("Create created_at = %q, want %q (create_time in UTC)", created.CreatedAt, want)
+	}
+
+	got, err := repo.GetByID(ctx, created.Id)
+	if err != nil {
+		t.Fatalf("GetByID failed: %v", err)
+	}
+	if got.Id != created.Id || got.Title != created.Title || got.Content != created.Content ||
+		got.UserId != created.UserId || got.CreatedAt != created.CreatedAt ||
+		!got.CreateTime.AsTime().Equal(created.CreateTime.AsTime()) ||
+		got.ContentFormat != created.ContentFormat || got.ContentHtml != created.ContentHtml ||
+		got.Excerpt != created.Excerpt || got.WordCount != created.WordCount {
+		t.Errorf("GetByID = %+v, want %+v", got, created)
+	}
+}
+
+func testGetNotFound(t *testing.T, repo repository.ArticleRepository) {
+	_, err := repo.GetByID(context.Background(), 999999)
+	if !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("GetByID(missing) error = %v, want repository.ErrNotFound", err)
+	}
+}
+
+func testUpdate(t *testing.T, repo repository.ArticleRepository) {
+	ctx := context.Background()
+	id := mustCreate(t, repo, "Old title", "Old content", 3)
+
+	updated, err := repo.Update(ctx, &pb.Article{
+		Id:            id,
+		Title:         "New title",
+		Content:       "<p>New content</p>",
+		ContentFormat: pb.ContentFormat_CONTENT_FORMAT_HTML,
+		ContentHtml:   "<p>New content</p>",
+	})
+	if err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	if updated.Title != "New title" || updated.Content != "<p>New content</p>" || updated.UserId != 3 ||
+		updated.ContentFormat != pb.ContentFormat_CONTENT_FORMAT_HTML || updated.ContentHtml != "<p>New content</p>" {
+		t.Errorf("Update returned %+v, want new title/content/format and unchanged user_id", updated)
+	}
+
+	got, err := repo.GetByID(ctx, id)
+	if err != nil {
+		t.Fatalf("GetByID after Update failed: %v", err)
+	}
+	if got.Title != "New title" || got.Content != "<p>New content</p>" || got.ContentHtml != "<p>New content</p>" {
+		t.Errorf("GetByID after Update = %+v, want updated values", got)
+	}
+}
+
+func testUpdateNotFound(t *testing.T, repo repository.ArticleRepository) {
+	_, err := repo.Update(context.Background(), &pb.Article{Id: 999999, Title: "Title", Content: "Content"})
+	if !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("Update(missing) error = %v, want repository.ErrNotFound", err)
+	}
+}
+
+func testDelete(t *testing.T, repo repository.ArticleRepository) {
+	ctx := context.Background()
+	id := mustCreate(t, repo, "Title", "Content", 1)
+
+	if err := repo.Delete(ctx, id); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if _, err := repo.GetByID(ctx, id); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("GetByID after Delete error = %v, want repository.ErrNotFound", err)
+	}
+}
+
+func testDeleteNotFound(t *testing.T, repo repository.ArticleRepository) {
+	if err := repo.Delete(context.Background(), 999999); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("Delete(missing) error = %v, want repository.ErrNotFound", err)
+	}
+}
+
+func testListAll(t *testing.T, repo repository.ArticleRepository) {
+	ctx := context.Background()
+	first := mustCreate(t, repo, "First", "Content", 1)
+	second := mustCreate(t, repo, "Second", "Content", 2)
+	third := mustCreate(t, repo, "Third", "Content", 1)
+
+	articles, total, err := repo.ListAll(ctx, 10, 0)
+	if err != nil {
+		t.Fatalf("ListAll failed: %v", err)
+	}
+	if total != 3 {
+		t.Errorf("ListAll total = %d, want 3", total)
+	}
+	assertIDs(t, "ListAll", articles, third, second, first)
+
+	page, total, err := repo.ListAll(ctx, 2, 1)
+	if err != nil {
+		t.Fatalf("ListAll(limit=2, offset=1) failed: %v", err)
+	}
+	if total != 3 {
+		t.Errorf("ListAll(limit=2, offset=1) total = %d, want 3", total)
+	}
+	assertIDs(t, "ListAll(limit=2, offset=1)", page, second, first)
+
+	empty, total, err := repo.ListAll(ctx, 10, 10)
+	if err != nil {
+		t.Fatalf("ListAll(offset past end) failed: %v", err)
+	}
+	if len(empty) != 0 || total != 3 {
+		t.Errorf("ListAll(offset past end) = %d articles, total %d; want 0 articles, total 3", len(empty), total)
+	}
+}
+
+func testListByUser(t *testing.T, repo repository.ArticleRepository) {
+	ctx := context.Background()
+	first := mustCreate(t, repo, "First", "Content", 1)
+	mustCreate(t, repo, "Other user", "Content", 2)
+	third := mustCreate(t, repo, "Third", "Content", 1)
+
+	articles, total, err := repo.ListByUser(ctx, 1, 10, 0)
+	if err != nil {
+		t.Fatalf("ListByUser failed: %v", err)
+	}
+	if total != 2 {
+		t.Errorf("ListByUser total = %d, want 2", total)
+	}
+	assertIDs(t, "ListByUser", articles, third, first)
+
+	articles, total, err = repo.ListByUser(ctx, 42, 10, 0)
+	if err != nil {
+		t.Fatalf("ListByUser(no articles) failed: %v", err)
+	}
+	if len(articles) != 0 || total != 0 {
+		t.Errorf("ListByUser(no articles) = %d articles, total %d; want none", len(articles), total)
+	}
+}
+
+func testListByIDs(t *testing.T, repo repository.ArticleRepository) {
+	first := mustCreate(t, repo, "First", "Content", 1)
+	second := mustCreate(t, repo, "Second", "Content", 2)
+
+	// Missing IDs are skipped and duplicates returned once
+	articles, err := repo.ListByIDs(context.Background(), []int32{second, 999, first, second}, "title")
+	if err != nil {
+		t.Fatalf("ListByIDs failed: %v", err)
+	}
+	assertIDs(t, "ListByIDs", articles, second, first)
+	if articles[0].Title != "Second" || articles[0].Content != "" {
+		t.Errorf("ListByIDs(title) = %+v, want only the title loaded", articles[0])
+	}
+}
+
+func testLoadSelectedFields(t *testing.T, repo repository.ArticleRepository) {
+	ctx := context.Background()
+	id := mustCreate(t, repo, "Title", "Content", 5)
+
+	got, err := repo.GetByID(ctx, id, "title")
+	if err != nil {
+		t.Fatalf("GetByID(title) failed: %v", err)
+	}
+	if got.Id != id || got.UserId != 5 || got.Title != "Title" {
+		t.Errorf("GetByID(title) = %+v, want id, user_id and title loaded", got)
+	}
+	if got.Content != "" || got.CreatedAt != "" || got.CreateTime != nil {
+		t.Errorf("GetByID(title) loaded content %q, created_at %q; want them left empty", got.Content, got.CreatedAt)
+	}
+
+	timestamps, err := repo.GetByID(ctx, id, "create_time")
+	if err != nil {
+		t.Fatalf("GetByID(create_time) failed: %v", err)
+	}
+	if timestamps.CreateTime == nil || timestamps.UpdateTime != nil {
+		t.Errorf("GetByID(create_time) = %+v, want only create_time among the timestamps", timestamps)
+	}
+
+	articles, _, err := repo.ListAll(ctx, 10, 0, "excerpt", "word_count")
+	if err != nil {
+		t.Fatalf("ListAll(excerpt, word_count) failed: %v", err)
+	}
+	if len(articles) != 1 || articles[0].Title != "" || articles[0].Content != "" || articles[0].Id != id {
+		t.Errorf("ListAll(excerpt, word_count) = %v, want only the selected fields plus id and user_id", articles)
+	}
+
+	full, err := repo.GetByID(ctx, id)
+	if err != nil {
+		t.Fatalf("GetByID failed: %v", err)
+	}
+	if full.Content != "Content" {
+		t.Errorf("GetByID after a partial read content = %q, want every field loaded", full.Content)
+	}
+}
+
+// exportIDs runs Export and returns the exported IDs
+func exportIDs(t *testing.T, repo repository.ArticleRepository, filter repository.ExportFilter, fields ...string) []int32 {
+	t.Helper()
+
+	var ids []int32
+	err := repo.Export(context.Background(), filter, fields, func(article *pb.Article) error {
+		if len(fields) > 0 && article.Content != "" {
+			t.Errorf("Export(%v) loaded content of article %d", fields, article.Id)
+		}
+		ids = append(ids, article.Id)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Export(%+v) failed: %v", filter, err)
+	}
+	return ids
+}
+
+func testExport(t *testing.T, repo repository.ArticleRepository) {
+	first := mustCreate(t, repo, "First", "Content", 1)
+	second := mustCreate(t, repo, "Second", "Content", 2)
+	third := mustCreate(t, repo, "Third", "Content", 1)
+
+	if got := exportIDs(t, repo, repository.ExportFilter{}); !slices.Equal(got, []int32{first, second, third}) {
+		t.Errorf("Export returned ids %v, want %v (id order)", got, []int32{first, second, third})
+	}
+	if got := exportIDs(t, repo, repository.ExportFilter{UserID: 1}, "title"); !slices.Equal(got, []int32{first, third}) {
+		t.Errorf("Export(user 1) returned ids %v, want %v", got, []int32{first, third})
+	}
+	future := repository.ExportFilter{CreatedAfter: time.Now().Add(time.Hour)}
+	if got := exportIDs(t, repo, future); len(got) != 0 {
+		t.Errorf("Export(created after now) returned ids %v, want none", got)
+	}
+	past := repository.ExportFilter{CreatedBefore: time.Now().Add(-time.Hour)}
+	if got := exportIDs(t, repo, past); len(got) != 0 {
+		t.Errorf("Export(created before an hour ago) returned ids %v, want none", got)
+	}
+
+	// An error from fn stops the export and is returned as-is
+	stop := errors.New("stop")
+	calls := 0
+	err := repo.Export(context.Background(), repository.ExportFilter{}, nil, func(*pb.Article) error {
+		calls++
+		return stop
+	})
+	if err != stop || calls != 1 {
+		t.Errorf("Export with failing fn = %v after %d calls, want %v after 1", err, calls, stop)
+	}
+}
+
+func testImport(t *testing.T, repo repository.ArticleRepository) {
+	ctx := context.Background()
+	created := time.Date(2019, 3, 1, 12, 0, 0, 0, time.UTC)
+	updated := created.Add(48 * time.Hour)
+
+	err := repo.Import(ctx, []*pb.Article{
+		{Title: "Old", Content: "Imported", UserId: 7, CreateTime: timestamppb.New(created), UpdateTime: timestamppb.New(updated)},
+		{Title: "Undated", Content: "Imported", UserId: 8},
+	})
+	if err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+
+	articles, total, err := repo.ListAll(ctx, 10, 0)
+	if err != nil || total != 2 {
+		t.Fatalf("ListAll after Import = %d articles, %v; want 2", total, err)
+	}
+	// The undated article is created now, so it sorts first
+	undated, old := articles[0], articles[1]
+	if old.Title != "Old" || old.UserId != 7 {
+		t.Fatalf("ListAll after Import = %v, want the dated article last", articles)
+	}
+	if !old.CreateTime.AsTime().Equal(created) || !old.UpdateTime.AsTime().Equal(updated) {
+		t.Errorf("imported timestamps = %v, %v; want %v, %v", old.CreateTime.AsTime(), old.UpdateTime.AsTime(), created, updated)
+	}
+	if undated.UserId != 8 || !undated.UpdateTime.AsTime().Equal(undated.CreateTime.AsTime()) {
+		t.Errorf("undated import = %+v, want user 8 and update_time = create_time", undated)
+	}
+}
+
+func testRelatedIDs(t *testing.T, repo repository.ArticleRepository) {
+	ctx := context.Background()
+	source := mustCreate(t, repo, "Tuning PostgreSQL indexes", "Indexes make PostgreSQL queries fast.", 1)
+	similar := mustCreate(t, repo, "A PostgreSQL index guide", "How indexes speed up PostgreSQL queries.", 2)
+	sameAuthor := mustCreate(t, repo, "Baking sourdough bread", "Flour and salt.", 1)
+	sameAuthorSimilar := mustCreate(t, repo, "More PostgreSQL indexes", "Tuning PostgreSQL indexes again.", 1)
+	unrelated := mustCreate(t, repo, "Gardening tips", "Water tomatoes daily.", 3)
+
+	// Similar and by the same author ranks first; the source and unrelated articles are left out
+	ids, err := repo.RelatedIDs(ctx, source, 10)
+	if err != nil {
+		t.Fatalf("RelatedIDs failed: %v", err)
+	}
+	if len(ids) != 3 || ids[0] != sameAuthorSimilar || !slices.Contains(ids, similar) || !slices.Contains(ids, sameAuthor) {
+		t.Errorf("RelatedIDs = %v, want %d first, then %d and %d", ids, sameAuthorSimilar, similar, sameAuthor)
+	}
+
+	ids, err = repo.RelatedIDs(ctx, source, 1)
+	if err != nil || !slices.Equal(ids, []int32{sameAuthorSimilar}) {
+		t.Errorf("RelatedIDs(limit 1) = %v, %v; want [%d]", ids, err, sameAuthorSimilar)
+	}
+
+	ids, err = repo.RelatedIDs(ctx, 999999, 10)
+	if err != nil || len(ids) != 0 {
+		t.Errorf("RelatedIDs(missing) = %v, %v; want none", ids, err)
+	}
+
+	// Editing the source changes its related articles
+	if _, err := repo.Update(ctx, &pb.Article{Id: source, Title: "Gardening with tomatoes", Content: "Water daily."}); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	ids, err = repo.RelatedIDs(ctx, source, 10)
+	if err != nil {
+		t.Fatalf("RelatedIDs after Update failed: %v", err)
+	}
+	if !slices.Contains(ids, unrelated) || slices.Contains(ids, similar) {
+		t.Errorf("RelatedIDs after Update = %v, want %d and not %d", ids, unrelated, similar)
+	}
+}
+
+func assertIDs(t *testing.T, name string, articles []*pb.Article, want ...int32) {
+	t.Helper()
+
+	got := make([]int32, 0, len(articles))
+	for _, article := range articles {
+		got = append(got, article.Id)
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("%s returned ids %v, want %v", name, got, want)
+	}
+}
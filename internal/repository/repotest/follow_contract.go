@@ -0,0 +1,175 @@
+package repotest
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/thatlq1812/service-2-article/internal/repository"
+	pb "github.com/thatlq1812/service-2-article/proto"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// FollowFactory returns an empty follow repository and the article repository its feeds read
+type FollowFactory func(t *testing.T) (repository.FollowRepository, repository.ArticleRepository)
+
+// RunFollowRepositoryContract runs the shared follow suite against newRepos
+func RunFollowRepositoryContract(t *testing.T, newRepos FollowFactory) {
+	t.Run("FollowUnfollow", func(t *testing.T) { testFollowUnfollow(t, newRepos) })
+	t.Run("FollowLimit", func(t *testing.T) { testFollowLimit(t, newRepos) })
+	t.Run("FeedByCursor", func(t *testing.T) { testFeed(t, newRepos) })
+}
+
+func mustFollow(t *testing.T, repo repository.FollowRepository, userID, authorID int32) *pb.Follow {
+	t.Helper()
+	f, _, err := repo.Follow(context.Background(), userID, authorID, 0)
+	if err != nil {
+		t.Fatalf("Follow(%d, %d) failed: %v", userID, authorID, err)
+	}
+	return f
+}
+
+func testFollowUnfollow(t *testing.T, newRepos FollowFactory) {
+	ctx := context.Background()
+	follows, _ := newRepos(t)
+
+	first, added, err := follows.Follow(ctx, 1, 10, 0)
+	if err != nil || !added || first.UserId != 1 || first.AuthorId != 10 || first.CreateTime == nil {
+		t.Fatalf("Follow = %v, %v, %v; want a new follow", first, added, err)
+	}
+	again, added, err := follows.Follow(ctx, 1, 10, 0)
+	if err != nil || added || again.Id != first.Id {
+		t.Errorf("Follow(again) = %v, %v, %v; want the existing follow", again, added, err)
+	}
+	second := mustFollow(t, follows, 1, 11)
+	mustFollow(t, follows, 2, 10)
+
+	// Most recently followed first, paged by ID
+	page, err := follows.ListFollowing(ctx, 1, 0, 1)
+	if err != nil || len(page) != 1 || page[0].Id != second.Id {
+		t.Fatalf("ListFollowing page 1 = %v, %v; want the second follow", page, err)
+	}
+	page, err = follows.ListFollowing(ctx, 1, page[0].Id, 10)
+	if err != nil || len(page) != 1 || page[0].Id != first.Id {
+		t.Errorf("ListFollowing page 2 = %v, %v; want the first follow", page, err)
+	}
+	if count, err := follows.CountFollowing(ctx, 1); err != nil || count != 2 {
+		t.Errorf("CountFollowing = %d, %v; want 2", count, err)
+	}
+
+	if removed, err := follows.Unfollow(ctx, 1, 10); err != nil || !removed {
+		t.Errorf("Unfollow = %v, %v; want removed", removed, err)
+	}
+	if removed, err := follows.Unfollow(ctx, 1, 10); err != nil || removed {
+		t.Errorf("Unfollow(again) = %v, %v; want not removed", removed, err)
+	}
+	if count, _ := follows.CountFollowing(ctx, 1); count != 1 {
+		t.Errorf("CountFollowing after Unfollow = %d, want 1", count)
+	}
+	if count, _ := follows.CountFollowing(ctx, 2); count != 1 {
+		t.Errorf("CountFollowing(other user) = %d, want 1", count)
+	}
+}
+
+func testFollowLimit(t *testing.T, newRepos FollowFactory) {
+	ctx := context.Background()
+	follows, _ := newRepos(t)
+
+	// Concurrent follows of different authors cannot pass the limit together
+	const limit = 3
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	added, limited := 0, 0
+	for authorID := int32(10); authorID < 20; authorID++ {
+		wg.Go(func() {
+			_, ok, err := follows.Follow(ctx, 1, authorID, limit)
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case errors.Is(err, repository.ErrLimitExceeded):
+				limited++
+			case err != nil:
+				t.Errorf("Follow(author %d) failed: %v", authorID, err)
+			case ok:
+				added++
+			}
+		})
+	}
+	wg.Wait()
+	if added != limit || limited != 10-limit {
+		t.Errorf("concurrent Follow added %d and limited %d, want %d and %d", added, limited, limit, 10-limit)
+	}
+	if count, _ := follows.CountFollowing(ctx, 1); count != limit {
+		t.Errorf("CountFollowing = %d, want %d", count, limit)
+	}
+
+	// At the limit, existing follows are still returned and other users are not affected
+	page, _ := follows.ListFollowing(ctx, 1, 0, 1)
+	if len(page) != 1 {
+		t.Fatalf("ListFollowing = %v, want a follow", page)
+	}
+	if again, ok, err := follows.Follow(ctx, 1, page[0].AuthorId, limit); err != nil || ok || again.Id != page[0].Id {
+		t.Errorf("Follow(existing at limit) = %v, %v, %v; want the existing follow", again, ok, err)
+	}
+	if _, ok, err := follows.Follow(ctx, 2, 10, limit); err != nil || !ok {
+		t.Errorf("Follow(other user) = %v, %v; want added", ok, err)
+	}
+}
+
+func testFeed(t *testing.T, newRepos FollowFactory) {
+	ctx := context.Background()
+	follows, articles := newRepos(t)
+
+	start := time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC)
+	at := func(hours int) *timestamppb.Timestamp {
+		return timestamppb.New(start.Add(time.Duration(hours) * time.Hour))
+	}
+	err := articles.Import(ctx, []*pb.Article{
+		{Title: "a1", Content: "Content", UserId: 10, CreateTime: at(1)},
+		{Title: "a3", Content: "Content", UserId: 10, CreateTime: at(3)},
+		{Title: "b2", Content: "Content", UserId: 11, CreateTime: at(2)},
+		{Title: "b3", Content: "Content", UserId: 11, CreateTime: at(3)},
+		{Title: "b4", Content: "Content", UserId: 11, CreateTime: at(4)},
+		{Title: "c5", Content: "Content", UserId: 12, CreateTime: at(5)}, // Not followed
+	})
+	if err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+	mustFollow(t, follows, 1, 10)
+	mustFollow(t, follows, 1, 11)
+
+	// Newest first across authors; a3 and b3 share a time, so the higher ID comes first
+	var titles []string
+	var after repository.FeedPosition
+	for page := 0; page < 5; page++ {
+		entries, err := follows.Feed(ctx, 1, after, 2, "title")
+		if err != nil {
+			t.Fatalf("Feed page %d failed: %v", page, err)
+		}
+		for _, entry := range entries {
+			titles = append(titles, entry.Article.Title)
+			if entry.Article.Content != "" || entry.Article.CreateTime != nil {
+				t.Errorf("Feed(title) article = %v, want only the title loaded", entry.Article)
+			}
+		}
+		if len(entries) < 2 {
+			break
+		}
+		after = entries[len(entries)-1].Position
+	}
+	if want := []string{"b4", "b3", "a3", "b2", "a1"}; !slices.Equal(titles, want) {
+		t.Errorf("Feed pages = %v, want %v", titles, want)
+	}
+	if !after.CreatedAt.Equal(start.Add(2 * time.Hour)) {
+		t.Errorf("Feed position = %v, want the time of b2", after.CreatedAt)
+	}
+
+	entries, err := follows.Feed(ctx, 2, repository.FeedPosition{}, 10)
+	if err != nil || len(entries) != 0 {
+		t.Errorf("Feed(no follows) = %v, %v; want empty", entries, err)
+	}
+}
@@ -0,0 +1,176 @@
+package repotest
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/thatlq1812/service-2-article/internal/repository"
+	pb "github.com/thatlq1812/service-2-article/proto"
+)
+
+// ReactionFactory returns an empty reaction repository and the article repository it reacts to
+type ReactionFactory func(t *testing.T) (repository.ReactionRepository, repository.ArticleRepository)
+
+// RunReactionRepositoryContract runs the shared reaction suite against newRepos
+func RunReactionRepositoryContract(t *testing.T, newRepos ReactionFactory) {
+	t.Run("AddRemoveAndCount", func(t *testing.T) { testAddRemoveReaction(t, newRepos) })
+	t.Run("ListReactions", func(t *testing.T) { testListReactions(t, newRepos) })
+	t.Run("ConcurrentReactions", func(t *testing.T) { testConcurrentReactions(t, newRepos) })
+	t.Run("ListPopular", func(t *testing.T) { testListPopular(t, newRepos) })
+}
+
+const (
+	like       = pb.ReactionType_REACTION_TYPE_LIKE
+	love       = pb.ReactionType_REACTION_TYPE_LOVE
+	insightful = pb.ReactionType_REACTION_TYPE_INSIGHTFUL
+)
+
+func mustReact(t *testing.T, repo repository.ReactionRepository, articleID, userID int32, reactionType pb.ReactionType) {
+	t.Helper()
+	if _, err := repo.AddReaction(context.Background(), articleID, userID, reactionType); err != nil {
+		t.Fatalf("AddReaction(%d, %d, %s) failed: %v", articleID, userID, reactionType, err)
+	}
+}
+
+// reactionCounts reads Article.reaction_count and reaction_counts (by type) through the article repository
+func reactionCounts(t *testing.T, articles repository.ArticleRepository, id int32) (int32, map[pb.ReactionType]int32) {
+	t.Helper()
+	article, err := articles.GetByID(context.Background(), id)
+	if err != nil {
+		t.Fatalf("GetByID(%d) failed: %v", id, err)
+	}
+	counts := make(map[pb.ReactionType]int32)
+	for _, c := range article.ReactionCounts {
+		counts[c.Type] = c.Count
+	}
+	return article.ReactionCount, counts
+}
+
+func testAddRemoveReaction(t *testing.T, newRepos ReactionFactory) {
+	ctx := context.Background()
+	reactions, articles := newRepos(t)
+	articleID := mustCreate(t, articles, "Liked", "Content", 1)
+
+	added, err := reactions.AddReaction(ctx, articleID, 5, like)
+	if err != nil || !added {
+		t.Fatalf("AddReaction = %v, %v; want added", added, err)
+	}
+	if again, err := reactions.AddReaction(ctx, articleID, 5, like); err != nil || again {
+		t.Errorf("AddReaction(again) = %v, %v; want not added", again, err)
+	}
+	mustReact(t, reactions, articleID, 5, insightful)
+	mustReact(t, reactions, articleID, 6, like)
+	if _, err := reactions.AddReaction(ctx, 999, 5, like); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("AddReaction(missing article) = %v, want ErrNotFound", err)
+	}
+
+	total, counts := reactionCounts(t, articles, articleID)
+	if total != 3 || counts[like] != 2 || counts[insightful] != 1 || len(counts) != 2 {
+		t.Errorf("reaction counts = %d %v, want 3: 2 LIKE, 1 INSIGHTFUL", total, counts)
+	}
+	mine, err := reactions.UserReactions(ctx, articleID, 5)
+	if err != nil || len(mine) != 2 || mine[0] != like || mine[1] != insightful {
+		t.Errorf("UserReactions = %v, %v; want LIKE, INSIGHTFUL", mine, err)
+	}
+
+	removed, err := reactions.RemoveReaction(ctx, articleID, 5, insightful)
+	if err != nil || !removed {
+		t.Fatalf("RemoveReaction = %v, %v; want removed", removed, err)
+	}
+	if again, err := reactions.RemoveReaction(ctx, articleID, 5, insightful); err != nil || again {
+		t.Errorf("RemoveReaction(again) = %v, %v; want not removed", again, err)
+	}
+	total, counts = reactionCounts(t, articles, articleID)
+	if total != 2 || counts[like] != 2 || len(counts) != 1 {
+		t.Errorf("reaction counts after remove = %d %v, want 2 LIKE only", total, counts)
+	}
+
+	// Updating the article keeps its counters
+	updated, err := articles.Update(ctx, &pb.Article{Id: articleID, Title: "Edited", Content: "Content"})
+	if err != nil || updated.ReactionCount != 2 {
+		t.Errorf("Update = %+v, %v; want reaction_count 2 kept", updated, err)
+	}
+}
+
+func testListReactions(t *testing.T, newRepos ReactionFactory) {
+	ctx := context.Background()
+	reactions, articles := newRepos(t)
+	articleID := mustCreate(t, articles, "Liked", "Content", 1)
+	otherID := mustCreate(t, articles, "Other", "Content", 1)
+
+	mustReact(t, reactions, articleID, 5, like)
+	mustReact(t, reactions, articleID, 6, love)
+	mustReact(t, reactions, articleID, 7, like)
+	mustReact(t, reactions, otherID, 5, like)
+
+	all, total, err := reactions.ListReactions(ctx, articleID, pb.ReactionType_REACTION_TYPE_UNSPECIFIED, 2, 0)
+	if err != nil || total != 3 || len(all) != 2 {
+		t.Fatalf("ListReactions = %d of %d, %v; want 2 of 3", len(all), total, err)
+	}
+	if all[0].UserId != 7 || all[1].UserId != 6 || all[0].ArticleId != articleID || all[0].CreateTime == nil {
+		t.Errorf("ListReactions users = %d, %d; want newest first (7, 6)", all[0].UserId, all[1].UserId)
+	}
+
+	likes, total, err := reactions.ListReactions(ctx, articleID, like, 10, 0)
+	if err != nil || total != 2 || len(likes) != 2 || likes[0].Type != like || likes[1].UserId != 5 {
+		t.Errorf("ListReactions(LIKE) = %v of %d, %v; want the 2 likes", likes, total, err)
+	}
+}
+
+// Many users reacting at once, each twice, must be counted exactly once each
+func testConcurrentReactions(t *testing.T, newRepos ReactionFactory) {
+	reactions, articles := newRepos(t)
+	articleID := mustCreate(t, articles, "Popular", "Content", 1)
+
+	const users = 20
+	var wg sync.WaitGroup
+	errs := make(chan error, 2*users)
+	for i := 0; i < 2*users; i++ {
+		wg.Add(1)
+		go func(userID int32) {
+			defer wg.Done()
+			if _, err := reactions.AddReaction(context.Background(), articleID, userID, like); err != nil {
+				errs <- err
+			}
+		}(int32(i%users) + 1)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatalf("AddReaction failed: %v", err)
+	}
+
+	if total, counts := reactionCounts(t, articles, articleID); total != users || counts[like] != users {
+		t.Errorf("reaction counts = %d %v, want %d likes", total, counts, users)
+	}
+}
+
+func testListPopular(t *testing.T, newRepos ReactionFactory) {
+	ctx := context.Background()
+	reactions, articles := newRepos(t)
+	quiet := mustCreate(t, articles, "Quiet", "Content", 1)
+	liked := mustCreate(t, articles, "Liked", "Content", 1)
+	loved := mustCreate(t, articles, "Loved", "Content", 2)
+	newest := mustCreate(t, articles, "Newest", "Content", 1)
+
+	mustReact(t, reactions, liked, 5, like)
+	mustReact(t, reactions, loved, 5, love)
+	mustReact(t, reactions, loved, 6, love)
+
+	page, total, err := articles.ListPopular(ctx, 0, 10, 0, "id", "reaction_count")
+	if err != nil || total != 4 {
+		t.Fatalf("ListPopular = %d articles, %v; want 4", total, err)
+	}
+	assertIDs(t, "ListPopular", page, loved, liked, newest, quiet)
+	if page[0].ReactionCount != 2 {
+		t.Errorf("reaction_count = %d, want 2", page[0].ReactionCount)
+	}
+
+	page, total, err = articles.ListPopular(ctx, 1, 2, 0, "id")
+	if err != nil || total != 3 {
+		t.Fatalf("ListPopular(user 1) = %d articles, %v; want 3", total, err)
+	}
+	assertIDs(t, "ListPopular(user 1)", page, liked, newest)
+}
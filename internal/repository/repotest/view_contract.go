@@ -0,0 +1,133 @@
+package repotest
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/thatlq1812/service-2-article/internal/repository"
+	"github.com/thatlq1812/service-2-article/internal/views"
+)
+
+// ViewFactory returns an empty view repository and the article repository it counts views of
+type ViewFactory func(t *testing.T) (repository.ViewRepository, repository.ArticleRepository)
+
+// RunViewRepositoryContract runs the shared view suite against newRepos
+func RunViewRepositoryContract(t *testing.T, newRepos ViewFactory) {
+	t.Run("AddViews", func(t *testing.T) { testAddViews(t, newRepos) })
+	t.Run("ListTrending", func(t *testing.T) { testListTrending(t, newRepos) })
+	t.Run("PruneViews", func(t *testing.T) { testPruneViews(t, newRepos) })
+}
+
+// viewCount reads Article.view_count through the article repository
+func viewCount(t *testing.T, articles repository.ArticleRepository, id int32) int64 {
+	t.Helper()
+	article, err := articles.GetByID(context.Background(), id, "view_count")
+	if err != nil {
+		t.Fatalf("GetByID(%d) failed: %v", id, err)
+	}
+	return article.ViewCount
+}
+
+func testAddViews(t *testing.T, newRepos ViewFactory) {
+	ctx := context.Background()
+	viewRepo, articles := newRepos(t)
+	id := mustCreate(t, articles, "Viewed", "Content", 1)
+	hour := time.Now().UTC().Truncate(time.Hour)
+
+	err := viewRepo.AddViews(ctx, []views.Count{
+		{ArticleID: id, Hour: hour.Add(-time.Hour), Views: 3},
+		{ArticleID: id, Hour: hour, Views: 2},
+		{ArticleID: 999, Hour: hour, Views: 7}, // Deleted meanwhile: dropped
+	})
+	if err != nil {
+		t.Fatalf("AddViews failed: %v", err)
+	}
+	if err := viewRepo.AddViews(ctx, []views.Count{{ArticleID: id, Hour: hour, Views: 1}}); err != nil {
+		t.Fatalf("AddViews(again) failed: %v", err)
+	}
+	if got := viewCount(t, articles, id); got != 6 {
+		t.Errorf("view_count = %d, want 6", got)
+	}
+
+	trending, err := viewRepo.ListTrending(ctx, hour.Add(-time.Hour), hour, time.Hour, 10)
+	if err != nil || len(trending) != 1 || trending[0].WindowViews != 6 {
+		t.Errorf("ListTrending = %v, %v; want the article with 6 views", trending, err)
+	}
+}
+
+func testListTrending(t *testing.T, newRepos ViewFactory) {
+	ctx := context.Background()
+	viewRepo, articles := newRepos(t)
+	older := mustCreate(t, articles, "Older", "Content", 1)
+	recent := mustCreate(t, articles, "Recent", "Content", 2)
+	outside := mustCreate(t, articles, "Outside", "Content", 1)
+	now := time.Now().UTC().Truncate(time.Hour)
+
+	err := viewRepo.AddViews(ctx, []views.Count{
+		{ArticleID: older, Hour: now.Add(-18 * time.Hour), Views: 16}, // Three half-lives: 2
+		{ArticleID: recent, Hour: now, Views: 3},
+		{ArticleID: outside, Hour: now.Add(-30 * time.Hour), Views: 100},
+	})
+	if err != nil {
+		t.Fatalf("AddViews failed: %v", err)
+	}
+
+	trending, err := viewRepo.ListTrending(ctx, now.Add(-24*time.Hour), now, 6*time.Hour, 10, "title")
+	if err != nil {
+		t.Fatalf("ListTrending failed: %v", err)
+	}
+	if len(trending) != 2 {
+		t.Fatalf("ListTrending = %v, want 2 articles in the window", trending)
+	}
+	first, second := trending[0], trending[1]
+	if first.Article.Article.Id != recent || math.Abs(first.Score-3) > 1e-6 || first.WindowViews != 3 {
+		t.Errorf("first = %v, want the recent article with score 3", first)
+	}
+	if second.Article.Article.Id != older || math.Abs(second.Score-2) > 1e-6 || second.WindowViews != 16 {
+		t.Errorf("second = %v, want the older article with score 2 and 16 views", second)
+	}
+	if a := first.Article.Article; a.Title != "Recent" || a.Content != "" || a.UserId != 2 {
+		t.Errorf("article = %v, want only title, id and user_id", a)
+	}
+
+	if top, err := viewRepo.ListTrending(ctx, now.Add(-24*time.Hour), now, 6*time.Hour, 1); err != nil || len(top) != 1 || top[0].Article.Article.Id != recent {
+		t.Errorf("ListTrending(limit 1) = %v, %v; want the recent article", top, err)
+	}
+
+	// Deleted articles drop out
+	if err := articles.Delete(ctx, recent); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if rest, err := viewRepo.ListTrending(ctx, now.Add(-24*time.Hour), now, 6*time.Hour, 10); err != nil || len(rest) != 1 || rest[0].Article.Article.Id != older {
+		t.Errorf("ListTrending after delete = %v, %v; want the older article only", rest, err)
+	}
+}
+
+func testPruneViews(t *testing.T, newRepos ViewFactory) {
+	ctx := context.Background()
+	viewRepo, articles := newRepos(t)
+	id := mustCreate(t, articles, "Viewed", "Content", 1)
+	now := time.Now().UTC().Truncate(time.Hour)
+
+	err := viewRepo.AddViews(ctx, []views.Count{
+		{ArticleID: id, Hour: now.Add(-48 * time.Hour), Views: 5},
+		{ArticleID: id, Hour: now, Views: 1},
+	})
+	if err != nil {
+		t.Fatalf("AddViews failed: %v", err)
+	}
+
+	pruned, err := viewRepo.PruneViews(ctx, now.Add(-24*time.Hour))
+	if err != nil || pruned != 1 {
+		t.Fatalf("PruneViews = %d, %v; want 1", pruned, err)
+	}
+	trending, err := viewRepo.ListTrending(ctx, now.Add(-7*24*time.Hour), now, time.Hour, 10)
+	if err != nil || len(trending) != 1 || trending[0].WindowViews != 1 {
+		t.Errorf("ListTrending after prune = %v, %v; want 1 view left", trending, err)
+	}
+	if got := viewCount(t, articles, id); got != 6 {
+		t.Errorf("view_count after prune = %d, want 6 (kept)", got)
+	}
+}
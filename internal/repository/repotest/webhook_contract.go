@@ -0,0 +1,207 @@
+package repotest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thatlq1812/service-2-article/internal/outbox"
+	"github.com/thatlq1812/service-2-article/internal/repository"
+	"github.com/thatlq1812/service-2-article/internal/webhook"
+	pb "github.com/thatlq1812/service-2-article/proto"
+)
+
+// WebhookFactory returns an empty webhook repository for a single subtest
+type WebhookFactory func(t *testing.T) repository.WebhookRepository
+
+// RunWebhookRepositoryContract runs the shared webhook suite against newRepo
+func RunWebhookRepositoryContract(t *testing.T, newRepo WebhookFactory) {
+	t.Run("CreateListDelete", func(t *testing.T) { testWebhookCRUD(t, newRepo(t)) })
+	t.Run("EnqueueMatchesFilters", func(t *testing.T) { testEnqueueFilters(t, newRepo(t)) })
+	t.Run("ClaimRecordRetry", func(t *testing.T) { testClaimRecordRetry(t, newRepo(t)) })
+}
+
+const contractSecret = "0123456789abcdef"
+
+func mustCreateWebhook(t *testing.T, repo repository.WebhookRepository, w *pb.Webhook) *pb.Webhook {
+	t.Helper()
+	created, err := repo.CreateWebhook(context.Background(), w, contractSecret)
+	if err != nil {
+		t.Fatalf("CreateWebhook failed: %v", err)
+	}
+	return created
+}
+
+// articleEvent returns an event with outbox id id for an article by userID
+func articleEvent(id int64, event func(*pb.Article) *pb.ArticleEvent, userID int32) *pb.ArticleEvent {
+	e := event(&pb.Article{Id: int32(id), UserId: userID, Title: "Title", Content: "Content"})
+	e.Id = id
+	return e
+}
+
+func deliveryCount(t *testing.T, repo repository.WebhookRepository, userID, webhookID int32) int32 {
+	t.Helper()
+	_, total, err := repo.ListDeliveries(context.Background(), userID, webhookID, pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED, 10, 0)
+	if err != nil {
+		t.Fatalf("ListDeliveries(%d) failed: %v", webhookID, err)
+	}
+	return total
+}
+
+func testWebhookCRUD(t *testing.T, repo repository.WebhookRepository) {
+	ctx := context.Background()
+
+	created := mustCreateWebhook(t, repo, &pb.Webhook{UserId: 1, Url: "https://a.example.com", EventTypes: []string{outbox.EventCreated}, AuthorId: 3})
+	if created.Id <= 0 || created.CreateTime == nil {
+		t.Fatalf("CreateWebhook = %+v, want an id and create_time", created)
+	}
+	if created.Url != "https://a.example.com" || created.AuthorId != 3 || len(created.EventTypes) != 1 {
+		t.Errorf("CreateWebhook = %+v, want the input fields", created)
+	}
+	mustCreateWebhook(t, repo, &pb.Webhook{UserId: 2, Url: "https://b.example.com"})
+
+	webhooks, err := repo.ListWebhooks(ctx, 1)
+	if err != nil || len(webhooks) != 1 || webhooks[0].Id != created.Id {
+		t.Fatalf("ListWebhooks(1) = %v, %v; want only the first webhook", webhooks, err)
+	}
+
+	if err := repo.DeleteWebhook(ctx, 2, created.Id); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("DeleteWebhook(other owner) = %v, want ErrNotFound", err)
+	}
+	if _, _, err := repo.ListDeliveries(ctx, 2, created.Id, 0, 10, 0); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("ListDeliveries(other owner) = %v, want ErrNotFound", err)
+	}
+	if err := repo.DeleteWebhook(ctx, 1, created.Id); err != nil {
+		t.Fatalf("DeleteWebhook failed: %v", err)
+	}
+	if webhooks, _ := repo.ListWebhooks(ctx, 1); len(webhooks) != 0 {
+		t.Errorf("ListWebhooks after delete = %v, want none", webhooks)
+	}
+}
+
+func testEnqueueFilters(t *testing.T, repo repository.WebhookRepository) {
+	ctx := context.Background()
+
+	all := mustCreateWebhook(t, repo, &pb.Webhook{UserId: 1, Url: "https://all.example.com"})
+	created := mustCreateWebhook(t, repo, &pb.Webhook{UserId: 1, Url: "https://created.example.com", EventTypes: []string{outbox.EventCreated}})
+	author := mustCreateWebhook(t, repo, &pb.Webhook{UserId: 1, Url: "https://author.example.com", AuthorId: 7})
+
+	err := repo.Enqueue(ctx,
+		articleEvent(1, outbox.Created, 7),
+		articleEvent(2, outbox.Updated, 7),
+		articleEvent(3, outbox.Deleted, 8),
+	)
+	if err != nil {
+		t.Fatalf("Enqueue failed: %v", err)
+	}
+	// The same event again is not queued twice
+	if err := repo.Enqueue(ctx, articleEvent(1, outbox.Created, 7)); err != nil {
+		t.Fatalf("Enqueue(duplicate) failed: %v", err)
+	}
+
+	for _, tc := range []struct {
+		name      string
+		webhookID int32
+		want      int32
+	}{
+		{"no filters", all.Id, 3},
+		{"event type", created.Id, 1},
+		{"author", author.Id, 2},
+	} {
+		if got := deliveryCount(t, repo, 1, tc.webhookID); got != tc.want {
+			t.Errorf("%s: %d deliveries, want %d", tc.name, got, tc.want)
+		}
+	}
+
+	deliveries, _, err := repo.ListDeliveries(ctx, 1, all.Id, 0, 10, 0)
+	if err != nil {
+		t.Fatalf("ListDeliveries failed: %v", err)
+	}
+	if deliveries[0].EventId != 3 || deliveries[2].EventId != 1 {
+		t.Errorf("ListDeliveries event ids = %d..%d, want newest (3) first", deliveries[0].EventId, deliveries[2].EventId)
+	}
+	if d := deliveries[0]; d.Status != pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING || d.EventType != outbox.EventDeleted || d.ArticleId != 3 || d.NextAttemptTime == nil {
+		t.Errorf("delivery = %+v, want a PENDING ArticleDeleted delivery for article 3", d)
+	}
+}
+
+func testClaimRecordRetry(t *testing.T, repo repository.WebhookRepository) {
+	ctx := context.Background()
+	hook := mustCreateWebhook(t, repo, &pb.Webhook{UserId: 1, Url: "https://claim.example.com"})
+	if err := repo.Enqueue(ctx, articleEvent(1, outbox.Created, 1), articleEvent(2, outbox.Created, 1)); err != nil {
+		t.Fatalf("Enqueue failed: %v", err)
+	}
+
+	claimed, err := repo.ClaimDeliveries(ctx, 10, time.Minute)
+	if err != nil || len(claimed) != 2 {
+		t.Fatalf("ClaimDeliveries = %v, %v; want 2 deliveries", claimed, err)
+	}
+	first := claimed[0]
+	if first.URL != hook.Url || first.Secret != contractSecret || len(first.Payload) == 0 || first.Attempts != 0 {
+		t.Errorf("claimed delivery = %+v, want the webhook URL, secret and a payload", first)
+	}
+	// Leased deliveries are hidden from other workers
+	if again, _ := repo.ClaimDeliveries(ctx, 10, time.Minute); len(again) != 0 {
+		t.Errorf("ClaimDeliveries while leased = %d deliveries, want 0", len(again))
+	}
+
+	now := time.Now()
+	delivered := webhook.Result{
+		Attempt: webhook.Attempt{Time: now, StatusCode: 200, Duration: 20 * time.Millisecond},
+		Status:  pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DELIVERED,
+	}
+	if err := repo.RecordAttempt(ctx, first.ID, delivered); err != nil {
+		t.Fatalf("RecordAttempt(delivered) failed: %v", err)
+	}
+	dead := webhook.Result{
+		Attempt: webhook.Attempt{Time: now, StatusCode: 500, Error: "webhook returned 500"},
+		Status:  pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD,
+	}
+	if err := repo.RecordAttempt(ctx, claimed[1].ID, dead); err != nil {
+		t.Fatalf("RecordAttempt(dead) failed: %v", err)
+	}
+
+	byStatus := func(status pb.WebhookDeliveryStatus) []*pb.WebhookDelivery {
+		t.Helper()
+		deliveries, _, err := repo.ListDeliveries(ctx, 1, hook.Id, status, 10, 0)
+		if err != nil {
+			t.Fatalf("ListDeliveries(%s) failed: %v", status, err)
+		}
+		return deliveries
+	}
+	got := byStatus(pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DELIVERED)
+	if len(got) != 1 || got[0].Id != first.ID || got[0].DeliveredTime == nil || got[0].Attempts != 1 {
+		t.Fatalf("DELIVERED deliveries = %v, want the first one with delivered_time", got)
+	}
+	if log := got[0].AttemptLog; len(log) != 1 || log[0].StatusCode != 200 || log[0].DurationMs != 20 {
+		t.Errorf("attempt log = %v, want one 200 attempt of 20ms", log)
+	}
+	deadList := byStatus(pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD)
+	if len(deadList) != 1 || deadList[0].LastError != "webhook returned 500" {
+		t.Fatalf("DEAD deliveries = %v, want the second one with its error", deadList)
+	}
+
+	if _, err := repo.RetryDelivery(ctx, 1, first.ID); !errors.Is(err, repository.ErrConflict) {
+		t.Errorf("RetryDelivery(delivered) = %v, want ErrConflict", err)
+	}
+	if _, err := repo.RetryDelivery(ctx, 2, claimed[1].ID); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("RetryDelivery(other owner) = %v, want ErrNotFound", err)
+	}
+	retried, err := repo.RetryDelivery(ctx, 1, claimed[1].ID)
+	if err != nil {
+		t.Fatalf("RetryDelivery failed: %v", err)
+	}
+	if retried.Status != pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING || retried.Attempts != 0 || len(retried.AttemptLog) != 1 {
+		t.Errorf("RetryDelivery = %+v, want PENDING with 0 attempts and the old attempt kept", retried)
+	}
+	if again, _ := repo.ClaimDeliveries(ctx, 10, time.Minute); len(again) != 1 || again[0].ID != claimed[1].ID {
+		t.Errorf("ClaimDeliveries after retry = %v, want the retried delivery", again)
+	}
+
+	// Only finished deliveries are pruned
+	pruned, err := repo.PruneDeliveries(ctx, time.Now().Add(time.Minute))
+	if err != nil || pruned != 1 {
+		t.Errorf("PruneDeliveries = %d, %v; want 1 (the delivered one)", pruned, err)
+	}
+}
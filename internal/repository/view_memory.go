@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/thatlq1812/service-2-article/internal/views"
+	pb "github.com/thatlq1812/service-2-article/proto"
+)
+
+// viewBucket identifies the views of an article in one hour
+type viewBucket struct {
+	articleID int32
+	hour      int64 // Unix seconds
+}
+
+// viewMemoryRepo implement ViewRepository in memory.
+// Articles are looked up in the article repository it was created with.
+type viewMemoryRepo struct {
+	mu       sync.Mutex
+	articles ArticleRepository
+	buckets  map[viewBucket]int64
+}
+
+// NewViewMemoryRepository creates an empty in-memory repository (for tests and local development)
+// for the articles of articles. view_count is kept in step when articles is the in-memory
+// article repository.
+func NewViewMemoryRepository(articles ArticleRepository) ViewRepository {
+	return &viewMemoryRepo{articles: articles, buckets: make(map[viewBucket]int64)}
+}
+
+// decayedViews weights views of the hour starting at hour by 0.5^(age/halfLife)
+func decayedViews(views int64, hour, now time.Time, halfLife time.Duration) float64 {
+	return float64(views) * math.Pow(0.5, now.Sub(hour).Seconds()/halfLife.Seconds())
+}
+
+// AddViews
+func (r *viewMemoryRepo) AddViews(ctx context.Context, counts []views.Count) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	counters, _ := r.articles.(counterUpdater)
+	for _, c := range counts {
+		if _, err := r.articles.GetByID(ctx, c.ArticleID, "id"); err != nil {
+			if errors.Is(err, ErrNotFound) {
+				continue
+			}
+			return err
+		}
+		r.buckets[viewBucket{articleID: c.ArticleID, hour: c.Hour.Unix()}] += c.Views
+		if counters != nil {
+			counters.updateCounters(c.ArticleID, func(article *pb.Article) {
+				article.ViewCount += c.Views
+			})
+		}
+	}
+	return nil
+}
+
+// ListTrending
+func (r *viewMemoryRepo) ListTrending(ctx context.Context, since, now time.Time, halfLife time.Duration, limit int32, fields ...string) ([]*pb.TrendingArticle, error) {
+	r.mu.Lock()
+	scores := make(map[int32]*pb.TrendingArticle)
+	for bucket, n := range r.buckets {
+		hour := time.Unix(bucket.hour, 0)
+		if hour.Before(since) {
+			continue
+		}
+		entry, ok := scores[bucket.articleID]
+		if !ok {
+			entry = &pb.TrendingArticle{}
+			scores[bucket.articleID] = entry
+		}
+		entry.Score += decayedViews(n, hour, now, halfLife)
+		entry.WindowViews += n
+	}
+	r.mu.Unlock()
+
+	ids := make([]int32, 0, len(scores))
+	for id := range scores {
+		ids = append(ids, id)
+	}
+	// Deleted articles are not returned, so they drop out of the ranking
+	articles, err := r.articles.ListByIDs(ctx, ids, fields...)
+	if err != nil {
+		return nil, err
+	}
+	trending := make([]*pb.TrendingArticle, len(articles))
+	for i, article := range articles {
+		trending[i] = scores[article.Id]
+		trending[i].Article = &pb.ArticleWithUser{Article: article}
+	}
+	sort.Slice(trending, func(i, j int) bool {
+		a, b := trending[i], trending[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		return a.Article.Article.Id > b.Article.Article.Id
+	})
+	if int32(len(trending)) > limit {
+		trending = trending[:limit]
+	}
+	return trending, nil
+}
+
+// PruneViews
+func (r *viewMemoryRepo) PruneViews(ctx context.Context, before time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var pruned int64
+	for bucket := range r.buckets {
+		if bucket.hour < before.Unix() {
+			delete(r.buckets, bucket)
+			pruned++
+		}
+	}
+	return pruned, nil
+}
@@ -0,0 +1,15 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/thatlq1812/service-2-article/internal/repository"
+	"github.com/thatlq1812/service-2-article/internal/repository/repotest"
+)
+
+func TestViewMemoryRepository(t *testing.T) {
+	repotest.RunViewRepositoryContract(t, func(t *testing.T) (repository.ViewRepository, repository.ArticleRepository) {
+		articles := repository.NewArticleMemoryRepository()
+		return repository.NewViewMemoryRepository(articles), articles
+	})
+}
@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/thatlq1812/service-2-article/internal/views"
+	pb "github.com/thatlq1812/service-2-article/proto"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// viewPostgresRepo implement ViewRepository with PostgreSQL
+type viewPostgresRepo struct {
+	db *pgxpool.Pool
+}
+
+// NewViewPostgresRepository
+func NewViewPostgresRepository(db *pgxpool.Pool) ViewRepository {
+	return &viewPostgresRepo{db: db}
+}
+
+// AddViews writes every count in one statement. The article rows are locked in ID order first,
+// so flushes from several replicas wait for each other instead of deadlocking, and articles
+// deleted meanwhile are skipped.
+func (r *viewPostgresRepo) AddViews(ctx context.Context, counts []views.Count) error {
+	counts = slices.Clone(counts)
+	slices.SortFunc(counts, func(a, b views.Count) int {
+		if c := cmp.Compare(a.ArticleID, b.ArticleID); c != 0 {
+			return c
+		}
+		return a.Hour.Compare(b.Hour)
+	})
+	ids := make([]int32, len(counts))
+	hours := make([]time.Time, len(counts))
+	totals := make([]int64, len(counts))
+	for i, c := range counts {
+		ids[i], hours[i], totals[i] = c.ArticleID, c.Hour, c.Views
+	}
+
+	query := `
+		WITH locked AS (
+			SELECT id FROM articles WHERE id = ANY($1) ORDER BY id FOR UPDATE
+		), counts AS (
+			SELECT c.article_id, c.hour, c.views
+			FROM unnest($1::int[], $2::timestamptz[], $3::bigint[]) AS c(article_id, hour, views)
+			JOIN locked ON locked.id = c.article_id
+		), buckets AS (
+			INSERT INTO article_views (article_id, hour, views)
+			SELECT article_id, hour, views FROM counts
+			ON CONFLICT (article_id, hour) DO UPDATE SET views = article_views.views + EXCLUDED.views
+		)
+		UPDATE articles
+		SET view_count = view_count + totals.views
+		FROM (SELECT article_id, SUM(views) AS views FROM counts GROUP BY article_id) totals
+		WHERE articles.id = totals.article_id
+	`
+	if _, err := r.db.Exec(ctx, query, ids, hours, totals); err != nil {
+		return fmt.Errorf("add views failed: %w", err)
+	}
+	return nil
+}
+
+// trailingRow scans the columns that follow the article fields of a row into extra
+type trailingRow struct {
+	pgx.Row
+	extra []interface{}
+}
+
+func (r trailingRow) Scan(dest ...interface{}) error {
+	return r.Row.Scan(append(dest, r.extra...)...)
+}
+
+// ListTrending scores the buckets of the window per article, then loads the top articles
+func (r *viewPostgresRepo) ListTrending(ctx context.Context, since, now time.Time, halfLife time.Duration, limit int32, fields ...string) ([]*pb.TrendingArticle, error) {
+	fields = loadFields(fields)
+	query := `
+		SELECT ` + selectColumns(fields) + `, ranked.score, ranked.window_views
+		FROM (
+			SELECT article_id,
+				SUM(views * power(0.5, EXTRACT(EPOCH FROM ($2::timestamptz - hour))::float8 / $3::float8))::float8 AS score,
+				SUM(views)::bigint AS window_views
+			FROM article_views
+			WHERE hour >= $1
+			GROUP BY article_id
+		) ranked
+		JOIN articles ON articles.id = ranked.article_id
+		ORDER BY ranked.score DESC, ranked.article_id DESC
+		LIMIT $4
+	`
+	rows, err := r.db.Query(ctx, query, since, now, halfLife.Seconds(), limit)
+	if err != nil {
+		return nil, fmt.Errorf("list trending articles failed: %w", err)
+	}
+	defer rows.Close()
+
+	var trending []*pb.TrendingArticle
+	for rows.Next() {
+		var entry pb.TrendingArticle
+		article, err := scanArticleFields(trailingRow{Row: rows, extra: []interface{}{&entry.Score, &entry.WindowViews}}, fields)
+		if err != nil {
+			return nil, fmt.Errorf("scan trending article failed: %w", err)
+		}
+		entry.Article = &pb.ArticleWithUser{Article: article}
+		trending = append(trending, &entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list trending articles failed: %w", err)
+	}
+	return trending, nil
+}
+
+// PruneViews
+func (r *viewPostgresRepo) PruneViews(ctx context.Context, before time.Time) (int64, error) {
+	tag, err := r.db.Exec(ctx, `DELETE FROM article_views WHERE hour < $1`, before)
+	if err != nil {
+		return 0, fmt.Errorf("prune views failed: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/thatlq1812/service-2-article/internal/views"
+	pb "github.com/thatlq1812/service-2-article/proto"
+)
+
+// ViewRepository stores deduplicated article views in hourly buckets and keeps
+// Article.view_count in step with them. It is the views.Store of the view counter.
+type ViewRepository interface {
+	// AddViews adds counts to the articles' buckets and view_count; counts of articles that no
+	// longer exist are dropped
+	AddViews(ctx context.Context, counts []views.Count) error
+
+	// ListTrending ranks the articles viewed since since, highest score first, then highest ID.
+	// A bucket's views are weighted by 0.5^(age/halfLife), with its age at now. The articles
+	// carry only fields (all when none are given) and no author.
+	ListTrending(ctx context.Context, since, now time.Time, halfLife time.Duration, limit int32, fields ...string) ([]*pb.TrendingArticle, error)
+
+	// PruneViews deletes the buckets of hours before before; view_count is kept
+	PruneViews(ctx context.Context, before time.Time) (int64, error)
+}
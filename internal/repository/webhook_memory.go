@@ -0,0 +1,275 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/thatlq1812/service-2-article/internal/outbox"
+	"github.com/thatlq1812/service-2-article/internal/webhook"
+	pb "github.com/thatlq1812/service-2-article/proto"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// memoryWebhook keeps the secret next to the stored webhook
+type memoryWebhook struct {
+	webhook *pb.Webhook
+	secret  string
+}
+
+// memoryDelivery keeps the queue fields the API does not return
+type memoryDelivery struct {
+	delivery    *pb.WebhookDelivery
+	payload     []byte
+	nextAttempt time.Time
+}
+
+// webhookMemoryRepo implement WebhookRepository in memory.
+// Nothing queues deliveries automatically: call Enqueue with the events to deliver.
+type webhookMemoryRepo struct {
+	mu             sync.Mutex
+	webhooks       map[int32]*memoryWebhook
+	deliveries     map[int64]*memoryDelivery
+	nextWebhookID  int32
+	nextDeliveryID int64
+}
+
+// NewWebhookMemoryRepository creates an empty in-memory repository (for tests and local development)
+func NewWebhookMemoryRepository() WebhookRepository {
+	return &webhookMemoryRepo{
+		webhooks:       make(map[int32]*memoryWebhook),
+		deliveries:     make(map[int64]*memoryDelivery),
+		nextWebhookID:  1,
+		nextDeliveryID: 1,
+	}
+}
+
+// CreateWebhook
+func (r *webhookMemoryRepo) CreateWebhook(ctx context.Context, input *pb.Webhook, secret string) (*pb.Webhook, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	w := proto.Clone(input).(*pb.Webhook)
+	w.Id = r.nextWebhookID
+	w.CreateTime = timestamppb.Now()
+	r.webhooks[w.Id] = &memoryWebhook{webhook: w, secret: secret}
+	r.nextWebhookID++
+	return proto.Clone(w).(*pb.Webhook), nil
+}
+
+// ListWebhooks
+func (r *webhookMemoryRepo) ListWebhooks(ctx context.Context, userID int32) ([]*pb.Webhook, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var webhooks []*pb.Webhook
+	for _, stored := range r.webhooks {
+		if stored.webhook.UserId == userID {
+			webhooks = append(webhooks, proto.Clone(stored.webhook).(*pb.Webhook))
+		}
+	}
+	sort.Slice(webhooks, func(i, j int) bool { return webhooks[i].Id < webhooks[j].Id })
+	return webhooks, nil
+}
+
+// DeleteWebhook, with its deliveries
+func (r *webhookMemoryRepo) DeleteWebhook(ctx context.Context, userID, id int32) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if stored, ok := r.webhooks[id]; !ok || stored.webhook.UserId != userID {
+		return fmt.Errorf("webhook with ID %d: %w", id, ErrNotFound)
+	}
+	delete(r.webhooks, id)
+	for deliveryID, d := range r.deliveries {
+		if d.delivery.WebhookId == id {
+			delete(r.deliveries, deliveryID)
+		}
+	}
+	return nil
+}
+
+// ListDeliveries
+func (r *webhookMemoryRepo) ListDeliveries(ctx context.Context, userID, webhookID int32, status pb.WebhookDeliveryStatus, limit, offset int32) ([]*pb.WebhookDelivery, int32, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if stored, ok := r.webhooks[webhookID]; !ok || stored.webhook.UserId != userID {
+		return nil, 0, fmt.Errorf("webhook with ID %d: %w", webhookID, ErrNotFound)
+	}
+
+	var matched []*memoryDelivery
+	for _, d := range r.deliveries {
+		if d.delivery.WebhookId == webhookID && (status == pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED || d.delivery.Status == status) {
+			matched = append(matched, d)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool { return matched[i].delivery.Id > matched[j].delivery.Id })
+
+	total := int32(len(matched))
+	var page []*pb.WebhookDelivery
+	for i := offset; i < total && i < offset+limit; i++ {
+		page = append(page, matched[i].view())
+	}
+	return page, total, nil
+}
+
+// view returns a copy of the delivery as the API shows it
+func (d *memoryDelivery) view() *pb.WebhookDelivery {
+	delivery := proto.Clone(d.delivery).(*pb.WebhookDelivery)
+	if delivery.Status == pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING {
+		delivery.NextAttemptTime = timestamppb.New(d.nextAttempt)
+	}
+	return delivery
+}
+
+// RetryDelivery
+func (r *webhookMemoryRepo) RetryDelivery(ctx context.Context, userID int32, id int64) (*pb.WebhookDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	d, ok := r.deliveries[id]
+	if !ok || r.webhooks[d.delivery.WebhookId].webhook.UserId != userID {
+		return nil, fmt.Errorf("delivery with ID %d: %w", id, ErrNotFound)
+	}
+	if d.delivery.Status != pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD {
+		return nil, fmt.Errorf("delivery with ID %d is not dead: %w", id, ErrConflict)
+	}
+	d.delivery.Status = pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING
+	d.delivery.Attempts = 0
+	d.nextAttempt = time.Now()
+	return d.view(), nil
+}
+
+// Enqueue
+func (r *webhookMemoryRepo) Enqueue(ctx context.Context, events ...*pb.ArticleEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	for _, event := range events {
+		payload, err := webhook.Payload(event)
+		if err != nil {
+			return fmt.Errorf("build webhook payload failed: %w", err)
+		}
+		eventType := outbox.Type(event)
+		var authorID int32
+		if article := outbox.Article(event); article != nil {
+			authorID = article.UserId
+		}
+
+		for _, stored := range r.webhooks {
+			w := stored.webhook
+			if !webhookMatches(w.EventTypes, w.AuthorId, eventType, authorID) || r.queued(w.Id, event.Id) {
+				continue
+			}
+			r.deliveries[r.nextDeliveryID] = &memoryDelivery{
+				delivery: &pb.WebhookDelivery{
+					Id:         r.nextDeliveryID,
+					WebhookId:  w.Id,
+					EventId:    event.Id,
+					EventType:  eventType,
+					ArticleId:  event.ArticleId,
+					Status:     pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING,
+					CreateTime: timestamppb.New(now),
+				},
+				payload:     payload,
+				nextAttempt: now,
+			}
+			r.nextDeliveryID++
+		}
+	}
+	return nil
+}
+
+// queued reports whether event eventID already has a delivery to webhookID
+func (r *webhookMemoryRepo) queued(webhookID int32, eventID int64) bool {
+	for _, d := range r.deliveries {
+		if d.delivery.WebhookId == webhookID && d.delivery.EventId == eventID {
+			return true
+		}
+	}
+	return false
+}
+
+// PruneDeliveries
+func (r *webhookMemoryRepo) PruneDeliveries(ctx context.Context, before time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var pruned int64
+	for id, d := range r.deliveries {
+		if d.delivery.Status != pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING && d.delivery.CreateTime.AsTime().Before(before) {
+			delete(r.deliveries, id)
+			pruned++
+		}
+	}
+	return pruned, nil
+}
+
+// ClaimDeliveries
+func (r *webhookMemoryRepo) ClaimDeliveries(ctx context.Context, limit int, lease time.Duration) ([]webhook.Delivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	var due []*memoryDelivery
+	for _, d := range r.deliveries {
+		if d.delivery.Status == pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING && !d.nextAttempt.After(now) {
+			due = append(due, d)
+		}
+	}
+	slices.SortFunc(due, func(a, b *memoryDelivery) int { return a.nextAttempt.Compare(b.nextAttempt) })
+	if len(due) > limit {
+		due = due[:limit]
+	}
+
+	claimed := make([]webhook.Delivery, len(due))
+	for i, d := range due {
+		d.nextAttempt = now.Add(lease)
+		stored := r.webhooks[d.delivery.WebhookId]
+		claimed[i] = webhook.Delivery{
+			ID:        d.delivery.Id,
+			WebhookID: d.delivery.WebhookId,
+			URL:       stored.webhook.Url,
+			Secret:    stored.secret,
+			EventType: d.delivery.EventType,
+			Payload:   d.payload,
+			Attempts:  d.delivery.Attempts,
+		}
+	}
+	return claimed, nil
+}
+
+// RecordAttempt
+func (r *webhookMemoryRepo) RecordAttempt(ctx context.Context, id int64, result webhook.Result) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	d, ok := r.deliveries[id]
+	if !ok {
+		return fmt.Errorf("delivery with ID %d: %w", id, ErrNotFound)
+	}
+	delivery := d.delivery
+	delivery.AttemptLog = append(delivery.AttemptLog, &pb.WebhookAttempt{
+		Time:       timestamppb.New(result.Time),
+		StatusCode: result.StatusCode,
+		Error:      result.Error,
+		DurationMs: int32(result.Duration.Milliseconds()),
+	})
+	delivery.Status = result.Status
+	delivery.Attempts++
+	delivery.LastError = result.Error
+	switch result.Status {
+	case pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING:
+		d.nextAttempt = result.NextAttempt
+	case pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DELIVERED:
+		delivery.DeliveredTime = timestamppb.New(result.Time)
+	}
+	return nil
+}
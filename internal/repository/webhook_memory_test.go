@@ -0,0 +1,14 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/thatlq1812/service-2-article/internal/repository"
+	"github.com/thatlq1812/service-2-article/internal/repository/repotest"
+)
+
+func TestWebhookMemoryRepository(t *testing.T) {
+	repotest.RunWebhookRepositoryContract(t, func(t *testing.T) repository.WebhookRepository {
+		return repository.NewWebhookMemoryRepository()
+	})
+}
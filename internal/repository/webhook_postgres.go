@@ -0,0 +1,349 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thatlq1812/service-2-article/internal/outbox"
+	"github.com/thatlq1812/service-2-article/internal/webhook"
+	pb "github.com/thatlq1812/service-2-article/proto"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// webhookPostgresRepo implement WebhookRepository with PostgreSQL
+type webhookPostgresRepo struct {
+	db *pgxpool.Pool
+}
+
+// NewWebhookPostgresRepository
+func NewWebhookPostgresRepository(db *pgxpool.Pool) WebhookRepository {
+	return &webhookPostgresRepo{db: db}
+}
+
+// webhookColumns selects every Webhook field; the secret is only read by ClaimDeliveries
+const webhookColumns = `id, user_id, url, event_types, author_id, created_at`
+
+func scanWebhook(row pgx.Row) (*pb.Webhook, error) {
+	var w pb.Webhook
+	var createdAt time.Time
+	if err := row.Scan(&w.Id, &w.UserId, &w.Url, &w.EventTypes, &w.AuthorId, &createdAt); err != nil {
+		return nil, err
+	}
+	w.CreateTime = timestamppb.New(createdAt)
+	return &w, nil
+}
+
+// CreateWebhook
+func (r *webhookPostgresRepo) CreateWebhook(ctx context.Context, w *pb.Webhook, secret string) (*pb.Webhook, error) {
+	query := `
+		INSERT INTO webhooks (user_id, url, secret, event_types, author_id)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING ` + webhookColumns
+	eventTypes := w.EventTypes
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+	created, err := scanWebhook(r.db.QueryRow(ctx, query, w.UserId, w.Url, secret, eventTypes, w.AuthorId))
+	if err != nil {
+		return nil, fmt.Errorf("create webhook failed: %w", mapPgError(err))
+	}
+	return created, nil
+}
+
+// ListWebhooks
+func (r *webhookPostgresRepo) ListWebhooks(ctx context.Context, userID int32) ([]*pb.Webhook, error) {
+	rows, err := r.db.Query(ctx, `SELECT `+webhookColumns+` FROM webhooks WHERE user_id = $1 ORDER BY id`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("query webhooks failed: %w", err)
+	}
+	webhooks, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*pb.Webhook, error) {
+		return scanWebhook(row)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query webhooks failed: %w", err)
+	}
+	return webhooks, nil
+}
+
+// DeleteWebhook; its deliveries and attempts are deleted by ON DELETE CASCADE
+func (r *webhookPostgresRepo) DeleteWebhook(ctx context.Context, userID, id int32) error {
+	result, err := r.db.Exec(ctx, `DELETE FROM webhooks WHERE id = $1 AND user_id = $2`, id, userID)
+	if err != nil {
+		return fmt.Errorf("delete webhook failed: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("webhook with ID %d: %w", id, ErrNotFound)
+	}
+	return nil
+}
+
+// deliveryColumns selects every WebhookDelivery field but the attempt log
+const deliveryColumns = `d.id, d.webhook_id, d.event_id, d.event_type, d.article_id, d.status,
+	d.attempts, d.next_attempt_at, d.last_error, d.created_at, d.delivered_at`
+
+func scanDelivery(row pgx.Row) (*pb.WebhookDelivery, error) {
+	var d pb.WebhookDelivery
+	var status int16
+	var nextAttemptAt, createdAt time.Time
+	var deliveredAt *time.Time
+	err := row.Scan(&d.Id, &d.WebhookId, &d.EventId, &d.EventType, &d.ArticleId, &status,
+		&d.Attempts, &nextAttemptAt, &d.LastError, &createdAt, &deliveredAt)
+	if err != nil {
+		return nil, err
+	}
+	d.Status = pb.WebhookDeliveryStatus(status)
+	if d.Status == pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING {
+		d.NextAttemptTime = timestamppb.New(nextAttemptAt)
+	}
+	d.CreateTime = timestamppb.New(createdAt)
+	if deliveredAt != nil {
+		d.DeliveredTime = timestamppb.New(*deliveredAt)
+	}
+	return &d, nil
+}
+
+// ownWebhook returns ErrNotFound unless userID owns webhook id
+func (r *webhookPostgresRepo) ownWebhook(ctx context.Context, userID, id int32) error {
+	var owner int32
+	err := r.db.QueryRow(ctx, `SELECT user_id FROM webhooks WHERE id = $1`, id).Scan(&owner)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && owner != userID) {
+		return fmt.Errorf("webhook with ID %d: %w", id, ErrNotFound)
+	}
+	if err != nil {
+		return fmt.Errorf("query webhook failed: %w", err)
+	}
+	return nil
+}
+
+// ListDeliveries
+func (r *webhookPostgresRepo) ListDeliveries(ctx context.Context, userID, webhookID int32, status pb.WebhookDeliveryStatus, limit, offset int32) ([]*pb.WebhookDelivery, int32, error) {
+	if err := r.ownWebhook(ctx, userID, webhookID); err != nil {
+		return nil, 0, err
+	}
+
+	// status 0 (UNSPECIFIED) lists every status
+	query := `
+		SELECT ` + deliveryColumns + `
+		FROM webhook_deliveries d
+		WHERE d.webhook_id = $1 AND ($2 = 0 OR d.status = $2)
+		ORDER BY d.id DESC
+		LIMIT $3 OFFSET $4
+	`
+	rows, err := r.db.Query(ctx, query, webhookID, int16(status), limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("query deliveries failed: %w", err)
+	}
+	deliveries, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*pb.WebhookDelivery, error) {
+		return scanDelivery(row)
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("query deliveries failed: %w", err)
+	}
+	if err := r.loadAttempts(ctx, deliveries); err != nil {
+		return nil, 0, err
+	}
+
+	var total int32
+	countQuery := `SELECT COUNT(*) FROM webhook_deliveries WHERE webhook_id = $1 AND ($2 = 0 OR status = $2)`
+	if err := r.db.QueryRow(ctx, countQuery, webhookID, int16(status)).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("count deliveries failed: %w", err)
+	}
+	return deliveries, total, nil
+}
+
+// loadAttempts fills the attempt logs of deliveries with one query
+func (r *webhookPostgresRepo) loadAttempts(ctx context.Context, deliveries []*pb.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	byID := make(map[int64]*pb.WebhookDelivery, len(deliveries))
+	ids := make([]int64, len(deliveries))
+	for i, d := range deliveries {
+		byID[d.Id] = d
+		ids[i] = d.Id
+	}
+
+	rows, err := r.db.Query(ctx, `
+		SELECT delivery_id, attempted_at, status_code, error, duration_ms
+		FROM webhook_attempts
+		WHERE delivery_id = ANY($1)
+		ORDER BY id
+	`, ids)
+	if err != nil {
+		return fmt.Errorf("query delivery attempts failed: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var deliveryID int64
+		var attemptedAt time.Time
+		var attempt pb.WebhookAttempt
+		if err := rows.Scan(&deliveryID, &attemptedAt, &attempt.StatusCode, &attempt.Error, &attempt.DurationMs); err != nil {
+			return fmt.Errorf("scan delivery attempt failed: %w", err)
+		}
+		attempt.Time = timestamppb.New(attemptedAt)
+		byID[deliveryID].AttemptLog = append(byID[deliveryID].AttemptLog, &attempt)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("query delivery attempts failed: %w", err)
+	}
+	return nil
+}
+
+// RetryDelivery
+func (r *webhookPostgresRepo) RetryDelivery(ctx context.Context, userID int32, id int64) (*pb.WebhookDelivery, error) {
+	query := `
+		UPDATE webhook_deliveries d
+		SET status = $3, attempts = 0, next_attempt_at = CURRENT_TIMESTAMP
+		FROM webhooks w
+		WHERE d.id = $1 AND w.id = d.webhook_id AND w.user_id = $2 AND d.status = $4
+		RETURNING ` + deliveryColumns
+	delivery, err := scanDelivery(r.db.QueryRow(ctx, query, id, userID,
+		int16(pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING), int16(pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD)))
+	if errors.Is(err, pgx.ErrNoRows) {
+		// Tell a delivery that is not dead apart from one that does not exist (for this user)
+		var exists bool
+		err := r.db.QueryRow(ctx, `
+			SELECT EXISTS (
+				SELECT 1 FROM webhook_deliveries d JOIN webhooks w ON w.id = d.webhook_id
+				WHERE d.id = $1 AND w.user_id = $2
+			)
+		`, id, userID).Scan(&exists)
+		if err != nil {
+			return nil, fmt.Errorf("query delivery failed: %w", err)
+		}
+		if exists {
+			return nil, fmt.Errorf("delivery with ID %d is not dead: %w", id, ErrConflict)
+		}
+		return nil, fmt.Errorf("delivery with ID %d: %w", id, ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("retry delivery failed: %w", err)
+	}
+	if err := r.loadAttempts(ctx, []*pb.WebhookDelivery{delivery}); err != nil {
+		return nil, err
+	}
+	return delivery, nil
+}
+
+// Enqueue queues events outside an article transaction
+func (r *webhookPostgresRepo) Enqueue(ctx context.Context, events ...*pb.ArticleEvent) error {
+	return enqueueDeliveries(ctx, r.db, events)
+}
+
+// batchSender is implemented by *pgxpool.Pool and pgx.Tx
+type batchSender interface {
+	SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults
+}
+
+// enqueueDeliveries inserts one delivery per event and matching webhook, in one round trip.
+// The article repository calls it in the transaction that writes the events to the outbox.
+func enqueueDeliveries(ctx context.Context, db batchSender, events []*pb.ArticleEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	query := `
+		INSERT INTO webhook_deliveries (webhook_id, event_id, event_type, article_id, payload)
+		SELECT id, $1::BIGINT, $2::VARCHAR, $3::INTEGER, $4::BYTEA
+		FROM webhooks
+		WHERE (author_id = 0 OR author_id = $5::INTEGER)
+			AND (cardinality(event_types) = 0 OR $2 = ANY(event_types))
+		ON CONFLICT (webhook_id, event_id) DO NOTHING
+	`
+	batch := &pgx.Batch{}
+	for _, event := range events {
+		payload, err := webhook.Payload(event)
+		if err != nil {
+			return fmt.Errorf("build webhook payload failed: %w", err)
+		}
+		var authorID int32
+		if article := outbox.Article(event); article != nil {
+			authorID = article.UserId
+		}
+		batch.Queue(query, event.Id, outbox.Type(event), event.ArticleId, payload, authorID)
+	}
+	if err := db.SendBatch(ctx, batch).Close(); err != nil {
+		return fmt.Errorf("enqueue webhook deliveries failed: %w", err)
+	}
+	return nil
+}
+
+// ClaimDeliveries leases due deliveries by pushing next_attempt_at forward. SKIP LOCKED
+// lets several workers claim concurrently without waiting for each other.
+func (r *webhookPostgresRepo) ClaimDeliveries(ctx context.Context, limit int, lease time.Duration) ([]webhook.Delivery, error) {
+	query := `
+		UPDATE webhook_deliveries d
+		SET next_attempt_at = CURRENT_TIMESTAMP + make_interval(secs => $3)
+		FROM webhooks w
+		WHERE w.id = d.webhook_id AND d.id IN (
+			SELECT id FROM webhook_deliveries
+			WHERE status = $1 AND next_attempt_at <= CURRENT_TIMESTAMP
+			ORDER BY next_attempt_at
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING d.id, d.webhook_id, w.url, w.secret, d.event_type, d.payload, d.attempts
+	`
+	rows, err := r.db.Query(ctx, query, int16(pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING), limit, lease.Seconds())
+	if err != nil {
+		return nil, fmt.Errorf("claim deliveries failed: %w", err)
+	}
+	deliveries, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (webhook.Delivery, error) {
+		var d webhook.Delivery
+		err := row.Scan(&d.ID, &d.WebhookID, &d.URL, &d.Secret, &d.EventType, &d.Payload, &d.Attempts)
+		return d, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("claim deliveries failed: %w", err)
+	}
+	return deliveries, nil
+}
+
+// RecordAttempt logs the attempt and updates the delivery in one transaction
+func (r *webhookPostgresRepo) RecordAttempt(ctx context.Context, id int64, result webhook.Result) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO webhook_attempts (delivery_id, attempted_at, status_code, error, duration_ms)
+		VALUES ($1, $2, $3, $4, $5)
+	`, id, result.Time, result.StatusCode, result.Error, result.Duration.Milliseconds())
+	if err != nil {
+		return fmt.Errorf("insert delivery attempt failed: %w", mapPgError(err))
+	}
+
+	var nextAttemptAt, deliveredAt *time.Time
+	switch result.Status {
+	case pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING:
+		nextAttemptAt = &result.NextAttempt
+	case pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DELIVERED:
+		deliveredAt = &result.Time
+	}
+	_, err = tx.Exec(ctx, `
+		UPDATE webhook_deliveries
+		SET status = $2, attempts = attempts + 1, last_error = $3,
+			next_attempt_at = COALESCE($4, next_attempt_at), delivered_at = $5
+		WHERE id = $1
+	`, id, int16(result.Status), result.Error, nextAttemptAt, deliveredAt)
+	if err != nil {
+		return fmt.Errorf("update delivery failed: %w", err)
+	}
+	return tx.Commit(ctx)
+}
+
+// PruneDeliveries
+func (r *webhookPostgresRepo) PruneDeliveries(ctx context.Context, before time.Time) (int64, error) {
+	result, err := r.db.Exec(ctx, `DELETE FROM webhook_deliveries WHERE status <> $1 AND created_at < $2`,
+		int16(pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING), before)
+	if err != nil {
+		return 0, fmt.Errorf("prune deliveries failed: %w", err)
+	}
+	return result.RowsAffected(), nil
+}
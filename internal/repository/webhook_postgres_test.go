@@ -0,0 +1,59 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/thatlq1812/service-2-article/internal/dbtest"
+	"github.com/thatlq1812/service-2-article/internal/repository"
+	"github.com/thatlq1812/service-2-article/internal/repository/repotest"
+	pb "github.com/thatlq1812/service-2-article/proto"
+)
+
+// Every table is emptied between subtests
+func TestWebhookPostgresRepository(t *testing.T) {
+	ctx := context.Background()
+	pool := dbtest.OpenPool(t)
+
+	repotest.RunWebhookRepositoryContract(t, func(t *testing.T) repository.WebhookRepository {
+		dbtest.ResetTables(t, pool)
+		return repository.NewWebhookPostgresRepository(pool)
+	})
+
+	t.Run("ArticleChangesQueueDeliveries", func(t *testing.T) {
+		dbtest.ResetTables(t, pool)
+		webhooks := repository.NewWebhookPostgresRepository(pool)
+		articles := repository.NewArticlePostgresRepository(pool)
+
+		hook, err := webhooks.CreateWebhook(ctx, &pb.Webhook{UserId: 1, Url: "https://example.com/hook", AuthorId: 7}, "0123456789abcdef")
+		if err != nil {
+			t.Fatalf("CreateWebhook failed: %v", err)
+		}
+		article, err := articles.Create(ctx, &pb.Article{Title: "Mine", Content: "Content", UserId: 7})
+		if err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+		if _, err := articles.Create(ctx, &pb.Article{Title: "Other author", Content: "Content", UserId: 8}); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+		if err := articles.Import(ctx, []*pb.Article{{Title: "Imported", Content: "Content", UserId: 7}}); err != nil {
+			t.Fatalf("Import failed: %v", err)
+		}
+		if err := articles.Delete(ctx, article.Id); err != nil {
+			t.Fatalf("Delete failed: %v", err)
+		}
+
+		deliveries, total, err := webhooks.ListDeliveries(ctx, 1, hook.Id, 0, 10, 0)
+		if err != nil {
+			t.Fatalf("ListDeliveries failed: %v", err)
+		}
+		if total != 3 {
+			t.Fatalf("ListDeliveries total = %d, want 3 (create, import and delete by author 7)", total)
+		}
+		if deliveries[0].EventType != "ArticleDeleted" || deliveries[0].EventId == 0 {
+			t.Errorf("newest delivery = %+v, want the ArticleDeleted event with its outbox id", deliveries[0])
+		}
+	})
+}
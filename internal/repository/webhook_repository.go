@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/thatlq1812/service-2-article/internal/webhook"
+	pb "github.com/thatlq1812/service-2-article/proto"
+)
+
+// WebhookRepository stores webhook subscriptions and their delivery queue.
+// The PostgreSQL article repository queues deliveries in the transaction of each change;
+// the webhook.Store methods are used by the delivery worker.
+type WebhookRepository interface {
+	// CreateWebhook stores webhook (user_id, url, event_types, author_id) with its signing secret
+	CreateWebhook(ctx context.Context, webhook *pb.Webhook, secret string) (*pb.Webhook, error)
+
+	// ListWebhooks returns the webhooks owned by userID, oldest first
+	ListWebhooks(ctx context.Context, userID int32) ([]*pb.Webhook, error)
+
+	// DeleteWebhook deletes a webhook and its deliveries; ErrNotFound unless userID owns it
+	DeleteWebhook(ctx context.Context, userID, id int32) error
+
+	// ListDeliveries returns the deliveries of a webhook, newest first, with their attempt logs.
+	// An UNSPECIFIED status lists all of them; ErrNotFound unless userID owns the webhook.
+	ListDeliveries(ctx context.Context, userID, webhookID int32, status pb.WebhookDeliveryStatus, limit, offset int32) ([]*pb.WebhookDelivery, int32, error)
+
+	// RetryDelivery makes a DEAD delivery PENDING again with a fresh set of attempts.
+	// ErrNotFound unless userID owns its webhook; ErrConflict if it is not DEAD.
+	RetryDelivery(ctx context.Context, userID int32, id int64) (*pb.WebhookDelivery, error)
+
+	// Enqueue queues a delivery of every event (with its outbox id) to each matching webhook;
+	// a webhook gets an event at most once
+	Enqueue(ctx context.Context, events ...*pb.ArticleEvent) error
+
+	webhook.Store
+}
+
+// webhookMatches reports whether a webhook filtering on eventTypes and authorID gets an
+// event of eventType for an article by userID; empty filters match everything
+func webhookMatches(eventTypes []string, authorID int32, eventType string, userID int32) bool {
+	if authorID != 0 && authorID != userID {
+		return false
+	}
+	if len(eventTypes) == 0 {
+		return true
+	}
+	for _, t := range eventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,151 @@
+package response
+
+import (
+	"log"
+	"strings"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// ErrorDomain identifies this service in google.rpc.ErrorInfo
+const ErrorDomain = "article-service.agrios"
+
+// Stable ErrorInfo reasons; clients may switch on these
+const (
+	ReasonArticleNotFound    = "ARTICLE_NOT_FOUND"
+	ReasonArticleConflict    = "ARTICLE_CONFLICT"
+	ReasonAuthorNotFound     = "AUTHOR_NOT_FOUND"
+	ReasonTokenRevoked       = "TOKEN_REVOKED"
+	ReasonValidationFailed   = "VALIDATION_FAILED"
+	ReasonRateLimited        = "RATE_LIMITED"
+	ReasonUserServiceDown    = "USER_SERVICE_UNAVAILABLE"
+	ReasonUserServiceFailed  = "USER_SERVICE_ERROR"
+	ReasonResumeTokenExpired = "RESUME_TOKEN_EXPIRED"
+	ReasonWebhookNotFound    = "WEBHOOK_NOT_FOUND"
+	ReasonDeliveryNotFound   = "DELIVERY_NOT_FOUND"
+	ReasonDeliveryNotDead    = "DELIVERY_NOT_DEAD"
+	ReasonCommentNotFound    = "COMMENT_NOT_FOUND"
+	ReasonCollectionNotFound = "COLLECTION_NOT_FOUND"
+	ReasonCollectionExists   = "COLLECTION_EXISTS"
+)
+
+// defaultRetryDelay is suggested to clients for transient failures without a known delay
+const defaultRetryDelay = time.Second
+
+// FieldViolation describes one invalid request field
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// BadRequest builds a google.rpc.BadRequest detail from field violations
+func BadRequest(violations ...FieldViolation) *errdetails.BadRequest {
+	detail := &errdetails.BadRequest{}
+	for _, v := range violations {
+		detail.FieldViolations = append(detail.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Description,
+		})
+	}
+	return detail
+}
+
+// RetryInfo builds a google.rpc.RetryInfo detail telling clients how long to wait
+func RetryInfo(delay time.Duration) *errdetails.RetryInfo {
+	return &errdetails.RetryInfo{RetryDelay: durationpb.New(delay)}
+}
+
+// ErrorInfo builds a google.rpc.ErrorInfo detail with a stable reason in this service's domain
+func ErrorInfo(reason string, metadata map[string]string) *errdetails.ErrorInfo {
+	return &errdetails.ErrorInfo{
+		Reason:   reason,
+		Domain:   ErrorDomain,
+		Metadata: metadata,
+	}
+}
+
+// PreconditionFailure builds a google.rpc.PreconditionFailure detail for conflicts
+func PreconditionFailure(violationType, subject, description string) *errdetails.PreconditionFailure {
+	return &errdetails.PreconditionFailure{
+		Violations: []*errdetails.PreconditionFailure_Violation{{
+			Type:        violationType,
+			Subject:     subject,
+			Description: description,
+		}},
+	}
+}
+
+// ReasonForCode returns the stable ErrorInfo reason used when none is given, e.g. "NOT_FOUND"
+func ReasonForCode(code codes.Code) string {
+	// codes.Code.String() renders "NotFound"; convert to UPPER_SNAKE_CASE
+	name := code.String()
+	var b strings.Builder
+	for i, r := range name {
+		if i > 0 && r >= 'A' && r <= 'Z' {
+			b.WriteByte('_')
+		}
+		b.WriteRune(r)
+	}
+	return strings.ToUpper(b.String())
+}
+
+// buildDetails completes the given details for an error response:
+// an ErrorInfo is always present, and transient codes carry a RetryInfo
+func buildDetails(code codes.Code, details []proto.Message) []proto.Message {
+	if code == codes.OK {
+		return details
+	}
+
+	hasErrorInfo, hasRetryInfo := false, false
+	for _, d := range details {
+		switch d.(type) {
+		case *errdetails.ErrorInfo:
+			hasErrorInfo = true
+		case *errdetails.RetryInfo:
+			hasRetryInfo = true
+		}
+	}
+
+	result := make([]proto.Message, 0, len(details)+2)
+	if !hasErrorInfo {
+		result = append(result, ErrorInfo(ReasonForCode(code), nil))
+	}
+	result = append(result, details...)
+	if !hasRetryInfo && (code == codes.Unavailable || code == codes.ResourceExhausted) {
+		result = append(result, RetryInfo(defaultRetryDelay))
+	}
+	return result
+}
+
+// packDetails converts details to Any for the response envelope
+func packDetails(code codes.Code, details []proto.Message) []*anypb.Any {
+	messages := buildDetails(code, details)
+	packed := make([]*anypb.Any, 0, len(messages))
+	for _, m := range messages {
+		a, err := anypb.New(m)
+		if err != nil {
+			log.Printf("[Response] ERROR: Failed to pack error detail %T: %v", m, err)
+			continue
+		}
+		packed = append(packed, a)
+	}
+	return packed
+}
+
+// DetailsFromStatus extracts the details of a gRPC status so they can be
+// forwarded into a response envelope
+func DetailsFromStatus(st *status.Status) []proto.Message {
+	var details []proto.Message
+	for _, d := range st.Details() {
+		if m, ok := d.(proto.Message); ok {
+			details = append(details, m)
+		}
+	}
+	return details
+}
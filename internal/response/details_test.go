@@ -0,0 +1,60 @@
+package response
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestReasonForCode(t *testing.T) {
+	tests := map[codes.Code]string{
+		codes.NotFound:          "NOT_FOUND",
+		codes.InvalidArgument:   "INVALID_ARGUMENT",
+		codes.ResourceExhausted: "RESOURCE_EXHAUSTED",
+		codes.Internal:          "INTERNAL",
+	}
+	for code, want := range tests {
+		if got := ReasonForCode(code); got != want {
+			t.Errorf("ReasonForCode(%s) = %q, want %q", code, got, want)
+		}
+	}
+}
+
+func TestGRPCErrorDetails(t *testing.T) {
+	err := GRPCError(codes.Unavailable, "user service is down")
+
+	st := status.Convert(err)
+	var errorInfo *errdetails.ErrorInfo
+	var retryInfo *errdetails.RetryInfo
+	for _, d := range st.Details() {
+		switch v := d.(type) {
+		case *errdetails.ErrorInfo:
+			errorInfo = v
+		case *errdetails.RetryInfo:
+			retryInfo = v
+		}
+	}
+
+	if errorInfo == nil || errorInfo.Reason != "UNAVAILABLE" || errorInfo.Domain != ErrorDomain {
+		t.Errorf("ErrorInfo = %v, want reason UNAVAILABLE in domain %s", errorInfo, ErrorDomain)
+	}
+	if retryInfo == nil || retryInfo.RetryDelay.AsDuration() != defaultRetryDelay {
+		t.Errorf("RetryInfo = %v, want delay %s", retryInfo, defaultRetryDelay)
+	}
+}
+
+func TestEnvelopeDetailsKeepExplicitErrorInfo(t *testing.T) {
+	resp := CreateArticleError(codes.ResourceExhausted, "slow down",
+		ErrorInfo(ReasonRateLimited, nil), RetryInfo(5*time.Second))
+
+	if len(resp.Details) != 2 {
+		t.Fatalf("len(Details) = %d, want 2 (no defaults added)", len(resp.Details))
+	}
+	var errorInfo errdetails.ErrorInfo
+	if err := resp.Details[0].UnmarshalTo(&errorInfo); err != nil || errorInfo.Reason != ReasonRateLimited {
+		t.Errorf("Details[0] = %v (%v), want ErrorInfo %s", &errorInfo, err, ReasonRateLimited)
+	}
+}
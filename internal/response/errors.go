@@ -0,0 +1,39 @@
+package response
+
+import (
+	"context"
+	"errors"
+
+	"github.com/thatlq1812/service-2-article/internal/repository"
+
+	"google.golang.org/grpc/codes"
+)
+
+// GRPCCodeFromError is the central translator from repository/context errors to gRPC codes
+// Use MapGRPCCodeToString on the result for the envelope code
+func GRPCCodeFromError(err error) codes.Code {
+	switch {
+	case err == nil:
+		return codes.OK
+	case errors.Is(err, repository.ErrNotFound):
+		return codes.NotFound
+	case errors.Is(err, repository.ErrConflict):
+		return codes.AlreadyExists
+	case errors.Is(err, repository.ErrForbidden):
+		return codes.PermissionDenied
+	case errors.Is(err, repository.ErrLimitExceeded):
+		return codes.FailedPrecondition
+	case errors.Is(err, context.DeadlineExceeded):
+		return codes.DeadlineExceeded
+	case errors.Is(err, context.Canceled):
+		return codes.Canceled
+	default:
+		return codes.Internal
+	}
+}
+
+// TranslateError returns both the gRPC code and the envelope code for err
+func TranslateError(err error) (codes.Code, string) {
+	code := GRPCCodeFromError(err)
+	return code, MapGRPCCodeToString(code)
+}
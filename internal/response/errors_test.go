@@ -0,0 +1,37 @@
+package response
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/thatlq1812/service-2-article/internal/repository"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestTranslateError(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          error
+		wantCode     codes.Code
+		wantEnvelope string
+	}{
+		{"not found", fmt.Errorf("article with ID 1: %w", repository.ErrNotFound), codes.NotFound, CodeNotFound},
+		{"conflict", fmt.Errorf("create article failed: %w", repository.ErrConflict), codes.AlreadyExists, CodeAlreadyExists},
+		{"forbidden", repository.ErrForbidden, codes.PermissionDenied, CodePermissionDenied},
+		{"limit", fmt.Errorf("user 1 follows 3 authors: %w", repository.ErrLimitExceeded), codes.FailedPrecondition, CodeFailedPrecondition},
+		{"deadline", fmt.Errorf("query failed: %w", context.DeadlineExceeded), codes.DeadlineExceeded, CodeDeadlineExceeded},
+		{"unknown", errors.New("boom"), codes.Internal, CodeInternalError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, envelope := TranslateError(tt.err)
+			if code != tt.wantCode || envelope != tt.wantEnvelope {
+				t.Errorf("TranslateError(%v) = (%s, %s), want (%s, %s)", tt.err, code, envelope, tt.wantCode, tt.wantEnvelope)
+			}
+		})
+	}
+}
@@ -1,20 +1,28 @@
 package response
 
 import (
+	"log"
+
 	pb "github.com/thatlq1812/service-2-article/proto"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/protoadapt"
 )
 
 // Standard response codes mapping
 const (
 	CodeSuccess            = "000" // Success
+	CodeCanceled           = "001" // Request cancelled by the client
 	CodeUnknownError       = "002" // Unknown error
 	CodeInvalidRequest     = "003" // Invalid request
+	CodeDeadlineExceeded   = "004" // Request timeout
 	CodeNotFound           = "005" // Not found
 	CodeAlreadyExists      = "006" // Already exists
 	CodePermissionDenied   = "007" // Permission denied
+	CodeFailedPrecondition = "009" // Not allowed in the resource's current state
+	CodeUnimplemented      = "012" // Not enabled on this server
 	CodeInternalError      = "013" // Internal error
 	CodeUnauthenticated    = "014" // Authentication required
 	CodeServiceUnavailable = "015" // Service unavailable
@@ -89,49 +97,661 @@ func ListArticlesSuccess(articles []*pb.ArticleWithUser, total, page, totalPages
 }
 
 // Error response helpers - return wrapped responses with error codes
+// Details (see details.go) are packed into the envelope; an ErrorInfo is always included
 
 // CreateArticleError returns error response for CreateArticle
-func CreateArticleError(code codes.Code, message string) *pb.CreateArticleResponse {
+func CreateArticleError(code codes.Code, message string, details ...proto.Message) *pb.CreateArticleResponse {
 	return &pb.CreateArticleResponse{
 		Code:    MapGRPCCodeToString(code),
 		Message: message,
 		Data:    nil,
+		Details: packDetails(code, details),
 	}
 }
 
 // GetArticleError returns error response for GetArticle
-func GetArticleError(code codes.Code, message string) *pb.GetArticleResponse {
+func GetArticleError(code codes.Code, message string, details ...proto.Message) *pb.GetArticleResponse {
 	return &pb.GetArticleResponse{
 		Code:    MapGRPCCodeToString(code),
 		Message: message,
 		Data:    nil,
+		Details: packDetails(code, details),
 	}
 }
 
 // UpdateArticleError returns error response for UpdateArticle
-func UpdateArticleError(code codes.Code, message string) *pb.UpdateArticleResponse {
+func UpdateArticleError(code codes.Code, message string, details ...proto.Message) *pb.UpdateArticleResponse {
 	return &pb.UpdateArticleResponse{
 		Code:    MapGRPCCodeToString(code),
 		Message: message,
 		Data:    nil,
+		Details: packDetails(code, details),
 	}
 }
 
 // DeleteArticleError returns error response for DeleteArticle
-func DeleteArticleError(code codes.Code, message string) *pb.DeleteArticleResponse {
+func DeleteArticleError(code codes.Code, message string, details ...proto.Message) *pb.DeleteArticleResponse {
 	return &pb.DeleteArticleResponse{
 		Code:    MapGRPCCodeToString(code),
 		Message: message,
 		Data:    nil,
+		Details: packDetails(code, details),
 	}
 }
 
 // ListArticlesError returns error response for ListArticles
-func ListArticlesError(code codes.Code, message string) *pb.ListArticlesResponse {
+func ListArticlesError(code codes.Code, message string, details ...proto.Message) *pb.ListArticlesResponse {
 	return &pb.ListArticlesResponse{
 		Code:    MapGRPCCodeToString(code),
 		Message: message,
 		Data:    nil,
+		Details: packDetails(code, details),
+	}
+}
+
+// Webhook Response Helpers
+
+func CreateWebhookSuccess(webhook *pb.Webhook) *pb.CreateWebhookResponse {
+	return &pb.CreateWebhookResponse{
+		Code:    CodeSuccess,
+		Message: "success",
+		Data: &pb.CreateWebhookData{
+			Webhook: webhook,
+		},
+	}
+}
+
+func ListWebhooksSuccess(webhooks []*pb.Webhook) *pb.ListWebhooksResponse {
+	return &pb.ListWebhooksResponse{
+		Code:    CodeSuccess,
+		Message: "success",
+		Data: &pb.ListWebhooksData{
+			Webhooks: webhooks,
+		},
+	}
+}
+
+func DeleteWebhookSuccess() *pb.DeleteWebhookResponse {
+	return &pb.DeleteWebhookResponse{
+		Code:    CodeSuccess,
+		Message: "success",
+		Data: &pb.DeleteWebhookData{
+			Success: true,
+		},
+	}
+}
+
+func ListWebhookDeliveriesSuccess(deliveries []*pb.WebhookDelivery, total, page, totalPages int32) *pb.ListWebhookDeliveriesResponse {
+	return &pb.ListWebhookDeliveriesResponse{
+		Code:    CodeSuccess,
+		Message: "success",
+		Data: &pb.ListWebhookDeliveriesData{
+			Deliveries: deliveries,
+			Total:      total,
+			Page:       page,
+			TotalPages: totalPages,
+		},
+	}
+}
+
+func RetryWebhookDeliverySuccess(delivery *pb.WebhookDelivery) *pb.RetryWebhookDeliveryResponse {
+	return &pb.RetryWebhookDeliveryResponse{
+		Code:    CodeSuccess,
+		Message: "success",
+		Data: &pb.RetryWebhookDeliveryData{
+			Delivery: delivery,
+		},
+	}
+}
+
+// CreateWebhookError returns error response for CreateWebhook
+func CreateWebhookError(code codes.Code, message string, details ...proto.Message) *pb.CreateWebhookResponse {
+	return &pb.CreateWebhookResponse{
+		Code:    MapGRPCCodeToString(code),
+		Message: message,
+		Details: packDetails(code, details),
+	}
+}
+
+// ListWebhooksError returns error response for ListWebhooks
+func ListWebhooksError(code codes.Code, message string, details ...proto.Message) *pb.ListWebhooksResponse {
+	return &pb.ListWebhooksResponse{
+		Code:    MapGRPCCodeToString(code),
+		Message: message,
+		Details: packDetails(code, details),
+	}
+}
+
+// DeleteWebhookError returns error response for DeleteWebhook
+func DeleteWebhookError(code codes.Code, message string, details ...proto.Message) *pb.DeleteWebhookResponse {
+	return &pb.DeleteWebhookResponse{
+		Code:    MapGRPCCodeToString(code),
+		Message: message,
+		Details: packDetails(code, details),
+	}
+}
+
+// ListWebhookDeliveriesError returns error response for ListWebhookDeliveries
+func ListWebhookDeliveriesError(code codes.Code, message string, details ...proto.Message) *pb.ListWebhookDeliveriesResponse {
+	return &pb.ListWebhookDeliveriesResponse{
+		Code:    MapGRPCCodeToString(code),
+		Message: message,
+		Details: packDetails(code, details),
+	}
+}
+
+// RetryWebhookDeliveryError returns error response for RetryWebhookDelivery
+func RetryWebhookDeliveryError(code codes.Code, message string, details ...proto.Message) *pb.RetryWebhookDeliveryResponse {
+	return &pb.RetryWebhookDeliveryResponse{
+		Code:    MapGRPCCodeToString(code),
+		Message: message,
+		Details: packDetails(code, details),
+	}
+}
+
+// Comment Response Helpers
+
+func CreateCommentSuccess(comment *pb.Comment) *pb.CreateCommentResponse {
+	return &pb.CreateCommentResponse{
+		Code:    CodeSuccess,
+		Message: "success",
+		Data: &pb.CreateCommentData{
+			Comment: comment,
+		},
+	}
+}
+
+func ListCommentsSuccess(comments []*pb.Comment, total, page, totalPages int32) *pb.ListCommentsResponse {
+	return &pb.ListCommentsResponse{
+		Code:    CodeSuccess,
+		Message: "success",
+		Data: &pb.ListCommentsData{
+			Comments:   comments,
+			Total:      total,
+			Page:       page,
+			TotalPages: totalPages,
+		},
+	}
+}
+
+func ListRepliesSuccess(replies []*pb.Comment, nextPageToken string) *pb.ListRepliesResponse {
+	return &pb.ListRepliesResponse{
+		Code:    CodeSuccess,
+		Message: "success",
+		Data: &pb.ListRepliesData{
+			Replies:       replies,
+			NextPageToken: nextPageToken,
+		},
+	}
+}
+
+func UpdateCommentSuccess(comment *pb.Comment) *pb.UpdateCommentResponse {
+	return &pb.UpdateCommentResponse{
+		Code:    CodeSuccess,
+		Message: "success",
+		Data: &pb.UpdateCommentData{
+			Comment: comment,
+		},
+	}
+}
+
+func DeleteCommentSuccess() *pb.DeleteCommentResponse {
+	return &pb.DeleteCommentResponse{
+		Code:    CodeSuccess,
+		Message: "success",
+		Data: &pb.DeleteCommentData{
+			Success: true,
+		},
+	}
+}
+
+func ModerateCommentSuccess(comment *pb.Comment) *pb.ModerateCommentResponse {
+	return &pb.ModerateCommentResponse{
+		Code:    CodeSuccess,
+		Message: "success",
+		Data: &pb.ModerateCommentData{
+			Comment: comment,
+		},
+	}
+}
+
+// CreateCommentError returns error response for CreateComment
+func CreateCommentError(code codes.Code, message string, details ...proto.Message) *pb.CreateCommentResponse {
+	return &pb.CreateCommentResponse{
+		Code:    MapGRPCCodeToString(code),
+		Message: message,
+		Details: packDetails(code, details),
+	}
+}
+
+// ListCommentsError returns error response for ListComments
+func ListCommentsError(code codes.Code, message string, details ...proto.Message) *pb.ListCommentsResponse {
+	return &pb.ListCommentsResponse{
+		Code:    MapGRPCCodeToString(code),
+		Message: message,
+		Details: packDetails(code, details),
+	}
+}
+
+// ListRepliesError returns error response for ListReplies
+func ListRepliesError(code codes.Code, message string, details ...proto.Message) *pb.ListRepliesResponse {
+	return &pb.ListRepliesResponse{
+		Code:    MapGRPCCodeToString(code),
+		Message: message,
+		Details: packDetails(code, details),
+	}
+}
+
+// UpdateCommentError returns error response for UpdateComment
+func UpdateCommentError(code codes.Code, message string, details ...proto.Message) *pb.UpdateCommentResponse {
+	return &pb.UpdateCommentResponse{
+		Code:    MapGRPCCodeToString(code),
+		Message: message,
+		Details: packDetails(code, details),
+	}
+}
+
+// DeleteCommentError returns error response for DeleteComment
+func DeleteCommentError(code codes.Code, message string, details ...proto.Message) *pb.DeleteCommentResponse {
+	return &pb.DeleteCommentResponse{
+		Code:    MapGRPCCodeToString(code),
+		Message: message,
+		Details: packDetails(code, details),
+	}
+}
+
+// ModerateCommentError returns error response for ModerateComment
+func ModerateCommentError(code codes.Code, message string, details ...proto.Message) *pb.ModerateCommentResponse {
+	return &pb.ModerateCommentResponse{
+		Code:    MapGRPCCodeToString(code),
+		Message: message,
+		Details: packDetails(code, details),
+	}
+}
+
+// Reaction Response Helpers
+
+func AddReactionSuccess(summary *pb.ReactionSummary, changed bool) *pb.AddReactionResponse {
+	return &pb.AddReactionResponse{
+		Code:    CodeSuccess,
+		Message: "success",
+		Data: &pb.AddReactionData{
+			Summary: summary,
+			Changed: changed,
+		},
+	}
+}
+
+func RemoveReactionSuccess(summary *pb.ReactionSummary, changed bool) *pb.RemoveReactionResponse {
+	return &pb.RemoveReactionResponse{
+		Code:    CodeSuccess,
+		Message: "success",
+		Data: &pb.RemoveReactionData{
+			Summary: summary,
+			Changed: changed,
+		},
+	}
+}
+
+func ListReactionsSuccess(reactions []*pb.Reaction, total, page, totalPages int32, summary *pb.ReactionSummary) *pb.ListReactionsResponse {
+	return &pb.ListReactionsResponse{
+		Code:    CodeSuccess,
+		Message: "success",
+		Data: &pb.ListReactionsData{
+			Reactions:  reactions,
+			Total:      total,
+			Page:       page,
+			TotalPages: totalPages,
+			Summary:    summary,
+		},
+	}
+}
+
+// AddReactionError returns error response for AddReaction
+func AddReactionError(code codes.Code, message string, details ...proto.Message) *pb.AddReactionResponse {
+	return &pb.AddReactionResponse{
+		Code:    MapGRPCCodeToString(code),
+		Message: message,
+		Details: packDetails(code, details),
+	}
+}
+
+// RemoveReactionError returns error response for RemoveReaction
+func RemoveReactionError(code codes.Code, message string, details ...proto.Message) *pb.RemoveReactionResponse {
+	return &pb.RemoveReactionResponse{
+		Code:    MapGRPCCodeToString(code),
+		Message: message,
+		Details: packDetails(code, details),
+	}
+}
+
+// ListReactionsError returns error response for ListReactions
+func ListReactionsError(code codes.Code, message string, details ...proto.Message) *pb.ListReactionsResponse {
+	return &pb.ListReactionsResponse{
+		Code:    MapGRPCCodeToString(code),
+		Message: message,
+		Details: packDetails(code, details),
+	}
+}
+
+// Bookmark Response Helpers
+
+func BookmarkArticleSuccess(bookmark *pb.Bookmark, changed bool) *pb.BookmarkArticleResponse {
+	return &pb.BookmarkArticleResponse{
+		Code:    CodeSuccess,
+		Message: "success",
+		Data: &pb.BookmarkArticleData{
+			Bookmark: bookmark,
+			Changed:  changed,
+		},
+	}
+}
+
+func UnbookmarkArticleSuccess(changed bool) *pb.UnbookmarkArticleResponse {
+	return &pb.UnbookmarkArticleResponse{
+		Code:    CodeSuccess,
+		Message: "success",
+		Data: &pb.UnbookmarkArticleData{
+			Changed: changed,
+		},
+	}
+}
+
+func ListBookmarksSuccess(bookmarks []*pb.Bookmark, nextPageToken string) *pb.ListBookmarksResponse {
+	return &pb.ListBookmarksResponse{
+		Code:    CodeSuccess,
+		Message: "success",
+		Data: &pb.ListBookmarksData{
+			Bookmarks:     bookmarks,
+			NextPageToken: nextPageToken,
+		},
+	}
+}
+
+func CreateCollectionSuccess(collection *pb.Collection) *pb.CreateCollectionResponse {
+	return &pb.CreateCollectionResponse{
+		Code:    CodeSuccess,
+		Message: "success",
+		Data: &pb.CreateCollectionData{
+			Collection: collection,
+		},
+	}
+}
+
+func ListCollectionsSuccess(collections []*pb.Collection) *pb.ListCollectionsResponse {
+	return &pb.ListCollectionsResponse{
+		Code:    CodeSuccess,
+		Message: "success",
+		Data: &pb.ListCollectionsData{
+			Collections: collections,
+		},
+	}
+}
+
+func RenameCollectionSuccess(collection *pb.Collection) *pb.RenameCollectionResponse {
+	return &pb.RenameCollectionResponse{
+		Code:    CodeSuccess,
+		Message: "success",
+		Data: &pb.RenameCollectionData{
+			Collection: collection,
+		},
+	}
+}
+
+func ReorderCollectionsSuccess(collections []*pb.Collection) *pb.ReorderCollectionsResponse {
+	return &pb.ReorderCollectionsResponse{
+		Code:    CodeSuccess,
+		Message: "success",
+		Data: &pb.ReorderCollectionsData{
+			Collections: collections,
+		},
+	}
+}
+
+func ShareCollectionSuccess(collection *pb.Collection) *pb.ShareCollectionResponse {
+	return &pb.ShareCollectionResponse{
+		Code:    CodeSuccess,
+		Message: "success",
+		Data: &pb.ShareCollectionData{
+			Collection: collection,
+		},
+	}
+}
+
+func DeleteCollectionSuccess() *pb.DeleteCollectionResponse {
+	return &pb.DeleteCollectionResponse{
+		Code:    CodeSuccess,
+		Message: "success",
+		Data: &pb.DeleteCollectionData{
+			Success: true,
+		},
+	}
+}
+
+func GetSharedCollectionSuccess(collection *pb.Collection, bookmarks []*pb.Bookmark, nextPageToken string) *pb.GetSharedCollectionResponse {
+	return &pb.GetSharedCollectionResponse{
+		Code:    CodeSuccess,
+		Message: "success",
+		Data: &pb.GetSharedCollectionData{
+			Collection:    collection,
+			Bookmarks:     bookmarks,
+			NextPageToken: nextPageToken,
+		},
+	}
+}
+
+// BookmarkArticleError returns error response for BookmarkArticle
+func BookmarkArticleError(code codes.Code, message string, details ...proto.Message) *pb.BookmarkArticleResponse {
+	return &pb.BookmarkArticleResponse{
+		Code:    MapGRPCCodeToString(code),
+		Message: message,
+		Details: packDetails(code, details),
+	}
+}
+
+// UnbookmarkArticleError returns error response for UnbookmarkArticle
+func UnbookmarkArticleError(code codes.Code, message string, details ...proto.Message) *pb.UnbookmarkArticleResponse {
+	return &pb.UnbookmarkArticleResponse{
+		Code:    MapGRPCCodeToString(code),
+		Message: message,
+		Details: packDetails(code, details),
+	}
+}
+
+// ListBookmarksError returns error response for ListBookmarks
+func ListBookmarksError(code codes.Code, message string, details ...proto.Message) *pb.ListBookmarksResponse {
+	return &pb.ListBookmarksResponse{
+		Code:    MapGRPCCodeToString(code),
+		Message: message,
+		Details: packDetails(code, details),
+	}
+}
+
+// CreateCollectionError returns error response for CreateCollection
+func CreateCollectionError(code codes.Code, message string, details ...proto.Message) *pb.CreateCollectionResponse {
+	return &pb.CreateCollectionResponse{
+		Code:    MapGRPCCodeToString(code),
+		Message: message,
+		Details: packDetails(code, details),
+	}
+}
+
+// ListCollectionsError returns error response for ListCollections
+func ListCollectionsError(code codes.Code, message string, details ...proto.Message) *pb.ListCollectionsResponse {
+	return &pb.ListCollectionsResponse{
+		Code:    MapGRPCCodeToString(code),
+		Message: message,
+		Details: packDetails(code, details),
+	}
+}
+
+// RenameCollectionError returns error response for RenameCollection
+func RenameCollectionError(code codes.Code, message string, details ...proto.Message) *pb.RenameCollectionResponse {
+	return &pb.RenameCollectionResponse{
+		Code:    MapGRPCCodeToString(code),
+		Message: message,
+		Details: packDetails(code, details),
+	}
+}
+
+// ReorderCollectionsError returns error response for ReorderCollections
+func ReorderCollectionsError(code codes.Code, message string, details ...proto.Message) *pb.ReorderCollectionsResponse {
+	return &pb.ReorderCollectionsResponse{
+		Code:    MapGRPCCodeToString(code),
+		Message: message,
+		Details: packDetails(code, details),
+	}
+}
+
+// ShareCollectionError returns error response for ShareCollection
+func ShareCollectionError(code codes.Code, message string, details ...proto.Message) *pb.ShareCollectionResponse {
+	return &pb.ShareCollectionResponse{
+		Code:    MapGRPCCodeToString(code),
+		Message: message,
+		Details: packDetails(code, details),
+	}
+}
+
+// DeleteCollectionError returns error response for DeleteCollection
+func DeleteCollectionError(code codes.Code, message string, details ...proto.Message) *pb.DeleteCollectionResponse {
+	return &pb.DeleteCollectionResponse{
+		Code:    MapGRPCCodeToString(code),
+		Message: message,
+		Details: packDetails(code, details),
+	}
+}
+
+// GetSharedCollectionError returns error response for GetSharedCollection
+func GetSharedCollectionError(code codes.Code, message string, details ...proto.Message) *pb.GetSharedCollectionResponse {
+	return &pb.GetSharedCollectionResponse{
+		Code:    MapGRPCCodeToString(code),
+		Message: message,
+		Details: packDetails(code, details),
+	}
+}
+
+// Trending Response Helpers
+
+// ListTrendingArticlesSuccess returns success response for ListTrendingArticles
+func ListTrendingArticlesSuccess(articles []*pb.TrendingArticle) *pb.ListTrendingArticlesResponse {
+	return &pb.ListTrendingArticlesResponse{
+		Code:    CodeSuccess,
+		Message: "success",
+		Data:    &pb.ListTrendingArticlesData{Articles: articles},
+	}
+}
+
+// ListTrendingArticlesError returns error response for ListTrendingArticles
+func ListTrendingArticlesError(code codes.Code, message string, details ...proto.Message) *pb.ListTrendingArticlesResponse {
+	return &pb.ListTrendingArticlesResponse{
+		Code:    MapGRPCCodeToString(code),
+		Message: message,
+		Details: packDetails(code, details),
+	}
+}
+
+// Related Articles Response Helpers
+
+// GetRelatedArticlesSuccess returns success response for GetRelatedArticles
+func GetRelatedArticlesSuccess(articles []*pb.ArticleWithUser) *pb.GetRelatedArticlesResponse {
+	return &pb.GetRelatedArticlesResponse{
+		Code:    CodeSuccess,
+		Message: "success",
+		Data:    &pb.GetRelatedArticlesData{Articles: articles},
+	}
+}
+
+// GetRelatedArticlesError returns error response for GetRelatedArticles
+func GetRelatedArticlesError(code codes.Code, message string, details ...proto.Message) *pb.GetRelatedArticlesResponse {
+	return &pb.GetRelatedArticlesResponse{
+		Code:    MapGRPCCodeToString(code),
+		Message: message,
+		Details: packDetails(code, details),
+	}
+}
+
+// Follow and Feed Response Helpers
+
+// FollowAuthorSuccess returns success response for FollowAuthor
+func FollowAuthorSuccess(follow *pb.Follow, changed bool) *pb.FollowAuthorResponse {
+	return &pb.FollowAuthorResponse{
+		Code:    CodeSuccess,
+		Message: "success",
+		Data: &pb.FollowAuthorData{
+			Follow:  follow,
+			Changed: changed,
+		},
+	}
+}
+
+// UnfollowAuthorSuccess returns success response for UnfollowAuthor
+func UnfollowAuthorSuccess(changed bool) *pb.UnfollowAuthorResponse {
+	return &pb.UnfollowAuthorResponse{
+		Code:    CodeSuccess,
+		Message: "success",
+		Data: &pb.UnfollowAuthorData{
+			Changed: changed,
+		},
+	}
+}
+
+// ListFollowingSuccess returns success response for ListFollowing
+func ListFollowingSuccess(follows []*pb.Follow, nextPageToken string) *pb.ListFollowingResponse {
+	return &pb.ListFollowingResponse{
+		Code:    CodeSuccess,
+		Message: "success",
+		Data: &pb.ListFollowingData{
+			Follows:       follows,
+			NextPageToken: nextPageToken,
+		},
+	}
+}
+
+// GetFeedSuccess returns success response for GetFeed
+func GetFeedSuccess(articles []*pb.ArticleWithUser, nextPageToken string) *pb.GetFeedResponse {
+	return &pb.GetFeedResponse{
+		Code:    CodeSuccess,
+		Message: "success",
+		Data: &pb.GetFeedData{
+			Articles:      articles,
+			NextPageToken: nextPageToken,
+		},
+	}
+}
+
+// FollowAuthorError returns error response for FollowAuthor
+func FollowAuthorError(code codes.Code, message string, details ...proto.Message) *pb.FollowAuthorResponse {
+	return &pb.FollowAuthorResponse{
+		Code:    MapGRPCCodeToString(code),
+		Message: message,
+		Details: packDetails(code, details),
+	}
+}
+
+// UnfollowAuthorError returns error response for UnfollowAuthor
+func UnfollowAuthorError(code codes.Code, message string, details ...proto.Message) *pb.UnfollowAuthorResponse {
+	return &pb.UnfollowAuthorResponse{
+		Code:    MapGRPCCodeToString(code),
+		Message: message,
+		Details: packDetails(code, details),
+	}
+}
+
+// ListFollowingError returns error response for ListFollowing
+func ListFollowingError(code codes.Code, message string, details ...proto.Message) *pb.ListFollowingResponse {
+	return &pb.ListFollowingResponse{
+		Code:    MapGRPCCodeToString(code),
+		Message: message,
+		Details: packDetails(code, details),
+	}
+}
+
+// GetFeedError returns error response for GetFeed
+func GetFeedError(code codes.Code, message string, details ...proto.Message) *pb.GetFeedResponse {
+	return &pb.GetFeedResponse{
+		Code:    MapGRPCCodeToString(code),
+		Message: message,
+		Details: packDetails(code, details),
 	}
 }
 
@@ -140,6 +760,10 @@ func MapGRPCCodeToString(code codes.Code) string {
 	switch code {
 	case codes.OK:
 		return CodeSuccess
+	case codes.Canceled:
+		return CodeCanceled
+	case codes.DeadlineExceeded:
+		return CodeDeadlineExceeded
 	case codes.InvalidArgument:
 		return CodeInvalidRequest
 	case codes.NotFound:
@@ -148,6 +772,10 @@ func MapGRPCCodeToString(code codes.Code) string {
 		return CodeAlreadyExists
 	case codes.PermissionDenied:
 		return CodePermissionDenied
+	case codes.FailedPrecondition:
+		return CodeFailedPrecondition
+	case codes.Unimplemented:
+		return CodeUnimplemented
 	case codes.Unauthenticated:
 		return CodeUnauthenticated
 	case codes.Unavailable:
@@ -160,7 +788,8 @@ func MapGRPCCodeToString(code codes.Code) string {
 }
 
 // GRPCError creates a standardized gRPC error with hints
-func GRPCError(code codes.Code, message string) error {
+// Details are attached to the status; an ErrorInfo is always included
+func GRPCError(code codes.Code, message string, details ...proto.Message) error {
 	// Add hints based on code
 	hint := ""
 	switch code {
@@ -177,11 +806,28 @@ func GRPCError(code codes.Code, message string) error {
 	default:
 		hint = ""
 	}
-	fullMessage := message + hint
-	return status.Error(code, fullMessage)
+	return StatusError(code, message+hint, details...)
+}
+
+// StatusError creates a gRPC status error with message as-is
+// Details are attached to the status; an ErrorInfo is always included
+func StatusError(code codes.Code, message string, details ...proto.Message) error {
+	st := status.New(code, message)
+
+	messages := buildDetails(code, details)
+	v1 := make([]protoadapt.MessageV1, 0, len(messages))
+	for _, m := range messages {
+		v1 = append(v1, protoadapt.MessageV1Of(m))
+	}
+	withDetails, err := st.WithDetails(v1...)
+	if err != nil {
+		log.Printf("[Response] ERROR: Failed to attach error details: %v", err)
+		return st.Err()
+	}
+	return withDetails.Err()
 }
 
 // GRPCErrorWithCode is an alias for GRPCError for backward compatibility
-func GRPCErrorWithCode(code codes.Code, message string) error {
-	return GRPCError(code, message)
+func GRPCErrorWithCode(code codes.Code, message string, details ...proto.Message) error {
+	return GRPCError(code, message, details...)
 }
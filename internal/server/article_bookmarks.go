@@ -0,0 +1,494 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/thatlq1812/service-2-article/internal/repository"
+	"github.com/thatlq1812/service-2-article/internal/response"
+	"github.com/thatlq1812/service-2-article/internal/validator"
+	pb "github.com/thatlq1812/service-2-article/proto"
+
+	"google.golang.org/grpc/codes"
+)
+
+// EnableBookmarks serves the bookmark and collection RPCs from repo; without it they return Unimplemented
+func (s *ArticleServer) EnableBookmarks(repo repository.BookmarkRepository) {
+	s.bookmarks = repo
+}
+
+// errBookmarksDisabled is returned by the bookmark RPCs when bookmarks are not enabled
+var errBookmarksDisabled = response.StatusError(codes.Unimplemented, "bookmarks are not enabled on this server")
+
+func collectionNotFound(id int32) error {
+	return response.StatusError(codes.NotFound, fmt.Sprintf("collection with ID %d not found", id),
+		response.ErrorInfo(response.ReasonCollectionNotFound, map[string]string{"collection_id": fmt.Sprint(id)}))
+}
+
+func collectionExists(name string) error {
+	return response.StatusError(codes.AlreadyExists, fmt.Sprintf("you already have a collection named %q", name),
+		response.ErrorInfo(response.ReasonCollectionExists, map[string]string{"name": name}))
+}
+
+// collectionError translates a collection repository error; method and id are for the log
+func collectionError(method string, id int32, name string, err error) error {
+	switch {
+	case errors.Is(err, repository.ErrNotFound):
+		return collectionNotFound(id)
+	case errors.Is(err, repository.ErrConflict):
+		return collectionExists(name)
+	}
+	log.Printf("[%s] Database error: collection_id=%d, error=%v", method, id, err)
+	return response.StatusError(response.GRPCCodeFromError(err), "failed to "+method)
+}
+
+// newShareToken returns a random URL-safe token of 128 bits
+func newShareToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// attachArticles sets the article (BASIC view) and its author on every bookmark, loading the
+// articles in one query and their authors in one batch. Bookmarks whose article is
+// gone keep article unset.
+func (s *ArticleServer) attachArticles(ctx context.Context, method string, bookmarks []*pb.Bookmark) error {
+	if len(bookmarks) == 0 {
+		return nil
+	}
+	ids := make([]int32, len(bookmarks))
+	for i, b := range bookmarks {
+		ids[i] = b.ArticleId
+	}
+	articles, err := s.repo.ListByIDs(ctx, ids, basicViewFields...)
+	if err != nil {
+		log.Printf("[%s] Database error: article_ids=%v, error=%v", method, ids, err)
+		return response.StatusError(response.GRPCCodeFromError(err), "failed to load bookmarked articles")
+	}
+
+	byID := make(map[int32]*pb.Article, len(articles))
+	for _, article := range articles {
+		byID[article.Id] = article
+	}
+	authors := s.fetchAuthors(ctx, method, articles)
+	for _, b := range bookmarks {
+		if article, ok := byID[b.ArticleId]; ok {
+			b.Article = &pb.ArticleWithUser{Article: article, User: authors[article.UserId]}
+		}
+	}
+	return nil
+}
+
+// bookmarkPage returns one page of a list of userID with the token of the next page.
+// Page tokens hold the ID of the last bookmark of the previous page.
+func (s *ArticleServer) bookmarkPage(ctx context.Context, method string, userID, collectionID, pageSize int32, pageToken string) ([]*pb.Bookmark, string, error) {
+	after, ok := decodePageToken(pageToken)
+	if !ok {
+		return nil, "", invalidArgument(validator.Violations{{Field: "page_token", Description: "is not a valid page token"}})
+	}
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+
+	// One more than asked for tells whether there is a next page
+	bookmarks, err := s.bookmarks.ListBookmarks(ctx, userID, collectionID, after, pageSize+1)
+	if err != nil {
+		log.Printf("[%s] Database error: user_id=%d, collection_id=%d, error=%v", method, userID, collectionID, err)
+		return nil, "", response.StatusError(response.GRPCCodeFromError(err), "failed to list bookmarks")
+	}
+	var next string
+	if int32(len(bookmarks)) > pageSize {
+		bookmarks = bookmarks[:pageSize]
+		next = encodePageToken(bookmarks[pageSize-1].Id)
+	}
+	if err := s.attachArticles(ctx, method, bookmarks); err != nil {
+		return nil, "", err
+	}
+	return bookmarks, next, nil
+}
+
+// checkCollection reports a collection that is not one of userID's; 0 is the default list
+func (s *ArticleServer) checkCollection(ctx context.Context, method string, userID, collectionID int32) error {
+	if collectionID == 0 {
+		return nil
+	}
+	if _, err := s.bookmarks.GetCollection(ctx, userID, collectionID); err != nil {
+		return collectionError(method, collectionID, "", err)
+	}
+	return nil
+}
+
+// bookmarkArticle saves an article for userID
+func (s *ArticleServer) bookmarkArticle(ctx context.Context, userID int32, req *pb.BookmarkArticleRequest) (*pb.Bookmark, bool, error) {
+	if err := s.checkCollection(ctx, "BookmarkArticle", userID, req.CollectionId); err != nil {
+		return nil, false, err
+	}
+	bookmark, added, err := s.bookmarks.AddBookmark(ctx, userID, req.ArticleId, req.CollectionId)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, false, articleNotFound(req.ArticleId)
+		}
+		log.Printf("[BookmarkArticle] Database error: article_id=%d, user_id=%d, error=%v", req.ArticleId, userID, err)
+		return nil, false, response.StatusError(response.GRPCCodeFromError(err), "failed to bookmark article")
+	}
+	if err := s.attachArticles(ctx, "BookmarkArticle", []*pb.Bookmark{bookmark}); err != nil {
+		return nil, false, err
+	}
+	log.Printf("[BookmarkArticle] Success: article_id=%d, collection_id=%d, user_id=%d, added=%t", req.ArticleId, req.CollectionId, userID, added)
+	return bookmark, added, nil
+}
+
+// unbookmarkArticle removes an article from a list of userID
+func (s *ArticleServer) unbookmarkArticle(ctx context.Context, userID int32, req *pb.UnbookmarkArticleRequest) (bool, error) {
+	if err := s.checkCollection(ctx, "UnbookmarkArticle", userID, req.CollectionId); err != nil {
+		return false, err
+	}
+	removed, err := s.bookmarks.RemoveBookmark(ctx, userID, req.ArticleId, req.CollectionId)
+	if err != nil {
+		log.Printf("[UnbookmarkArticle] Database error: article_id=%d, user_id=%d, error=%v", req.ArticleId, userID, err)
+		return false, response.StatusError(response.GRPCCodeFromError(err), "failed to remove bookmark")
+	}
+	return removed, nil
+}
+
+// createCollection adds a collection for userID, who may have at most validator.MaxCollections
+func (s *ArticleServer) createCollection(ctx context.Context, userID int32, name string) (*pb.Collection, error) {
+	current, err := s.bookmarks.ListCollections(ctx, userID)
+	if err != nil {
+		return nil, collectionError("CreateCollection", 0, name, err)
+	}
+	if len(current) >= validator.MaxCollections {
+		return nil, response.StatusError(codes.FailedPrecondition, fmt.Sprintf("you already have %d collections", len(current)),
+			response.PreconditionFailure("LIMIT", fmt.Sprintf("users/%d/collections", userID), fmt.Sprintf("at most %d collections per user", validator.MaxCollections)))
+	}
+	collection, err := s.bookmarks.CreateCollection(ctx, userID, name)
+	if err != nil {
+		return nil, collectionError("CreateCollection", 0, name, err)
+	}
+	return collection, nil
+}
+
+// reorderCollections requires ids to list every collection of userID exactly once
+func (s *ArticleServer) reorderCollections(ctx context.Context, userID int32, ids []int32) ([]*pb.Collection, error) {
+	current, err := s.bookmarks.ListCollections(ctx, userID)
+	if err != nil {
+		return nil, collectionError("ReorderCollections", 0, "", err)
+	}
+	requested := make(map[int32]bool, len(ids))
+	for _, id := range ids {
+		requested[id] = true
+	}
+	complete := len(ids) == len(current)
+	for _, c := range current {
+		complete = complete && requested[c.Id]
+	}
+	if !complete {
+		return nil, invalidArgument(validator.Violations{{Field: "ids", Description: "must list each of your collections exactly once"}})
+	}
+
+	collections, err := s.bookmarks.ReorderCollections(ctx, userID, ids)
+	if err != nil {
+		return nil, collectionError("ReorderCollections", 0, "", err)
+	}
+	return collections, nil
+}
+
+// shareCollection creates a share token for a collection of userID, keeping an existing one,
+// or revokes it
+func (s *ArticleServer) shareCollection(ctx context.Context, userID int32, req *pb.ShareCollectionRequest) (*pb.Collection, error) {
+	collection, err := s.bookmarks.GetCollection(ctx, userID, req.Id)
+	if err != nil {
+		return nil, collectionError("ShareCollection", req.Id, "", err)
+	}
+	if req.Shared == (collection.ShareToken != "") {
+		return collection, nil
+	}
+
+	var token string
+	if req.Shared {
+		if token, err = newShareToken(); err != nil {
+			log.Printf("[ShareCollection] Failed to generate token: %v", err)
+			return nil, response.StatusError(codes.Internal, "failed to share collection")
+		}
+	}
+	collection, err = s.bookmarks.SetShareToken(ctx, userID, req.Id, token)
+	if err != nil {
+		return nil, collectionError("ShareCollection", req.Id, "", err)
+	}
+	log.Printf("[ShareCollection] Success: collection_id=%d, user_id=%d, shared=%t", req.Id, userID, req.Shared)
+	return collection, nil
+}
+
+// sharedCollection reads a shared collection and one page of its bookmarks
+func (s *ArticleServer) sharedCollection(ctx context.Context, req *pb.GetSharedCollectionRequest) (*pb.Collection, []*pb.Bookmark, string, error) {
+	collection, err := s.bookmarks.GetSharedCollection(ctx, req.ShareToken)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, nil, "", response.StatusError(codes.NotFound, "shared collection not found",
+				response.ErrorInfo(response.ReasonCollectionNotFound, nil))
+		}
+		log.Printf("[GetSharedCollection] Database error: %v", err)
+		return nil, nil, "", response.StatusError(response.GRPCCodeFromError(err), "failed to get collection")
+	}
+	bookmarks, next, err := s.bookmarkPage(ctx, "GetSharedCollection", collection.UserId, collection.Id, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	// Only the owner sees the token
+	collection.ShareToken = ""
+	return collection, bookmarks, next, nil
+}
+
+// BookmarkArticle saves an article to the caller's default reading list or to one of their
+// collections. Saving an article that is already there succeeds with changed=false.
+func (s *ArticleServer) BookmarkArticle(ctx context.Context, req *pb.BookmarkArticleRequest) (*pb.BookmarkArticleResponse, error) {
+	if s.bookmarks == nil {
+		code, message, details := fromStatus(errBookmarksDisabled)
+		return response.BookmarkArticleError(code, message, details...), nil
+	}
+	userID, err := s.authenticate(ctx, "BookmarkArticle")
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.BookmarkArticleError(code, message, details...), nil
+	}
+	if err := validator.ValidateBookmarkArticle(req); err != nil {
+		log.Printf("[BookmarkArticle] Invalid argument: %v", err)
+		message, details := validationFailure(err)
+		return response.BookmarkArticleError(codes.InvalidArgument, message, details...), nil
+	}
+
+	bookmark, added, err := s.bookmarkArticle(ctx, userID, req)
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.BookmarkArticleError(code, message, details...), nil
+	}
+	return response.BookmarkArticleSuccess(bookmark, added), nil
+}
+
+// UnbookmarkArticle removes an article from one of the caller's lists
+func (s *ArticleServer) UnbookmarkArticle(ctx context.Context, req *pb.UnbookmarkArticleRequest) (*pb.UnbookmarkArticleResponse, error) {
+	if s.bookmarks == nil {
+		code, message, details := fromStatus(errBookmarksDisabled)
+		return response.UnbookmarkArticleError(code, message, details...), nil
+	}
+	userID, err := s.authenticate(ctx, "UnbookmarkArticle")
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.UnbookmarkArticleError(code, message, details...), nil
+	}
+	if err := validator.ValidateUnbookmarkArticle(req); err != nil {
+		log.Printf("[UnbookmarkArticle] Invalid argument: %v", err)
+		message, details := validationFailure(err)
+		return response.UnbookmarkArticleError(codes.InvalidArgument, message, details...), nil
+	}
+
+	removed, err := s.unbookmarkArticle(ctx, userID, req)
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.UnbookmarkArticleError(code, message, details...), nil
+	}
+	return response.UnbookmarkArticleSuccess(removed), nil
+}
+
+// ListBookmarks pages through one of the caller's lists, most recently saved first, with the
+// articles and their authors
+func (s *ArticleServer) ListBookmarks(ctx context.Context, req *pb.ListBookmarksRequest) (*pb.ListBookmarksResponse, error) {
+	if s.bookmarks == nil {
+		code, message, details := fromStatus(errBookmarksDisabled)
+		return response.ListBookmarksError(code, message, details...), nil
+	}
+	userID, err := s.authenticate(ctx, "ListBookmarks")
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.ListBookmarksError(code, message, details...), nil
+	}
+	if err := validator.ValidateListBookmarks(req); err != nil {
+		log.Printf("[ListBookmarks] Invalid argument: %v", err)
+		message, details := validationFailure(err)
+		return response.ListBookmarksError(codes.InvalidArgument, message, details...), nil
+	}
+
+	if err := s.checkCollection(ctx, "ListBookmarks", userID, req.CollectionId); err != nil {
+		code, message, details := fromStatus(err)
+		return response.ListBookmarksError(code, message, details...), nil
+	}
+	bookmarks, next, err := s.bookmarkPage(ctx, "ListBookmarks", userID, req.CollectionId, req.PageSize, req.PageToken)
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.ListBookmarksError(code, message, details...), nil
+	}
+	return response.ListBookmarksSuccess(bookmarks, next), nil
+}
+
+// CreateCollection adds a collection after the caller's existing ones
+func (s *ArticleServer) CreateCollection(ctx context.Context, req *pb.CreateCollectionRequest) (*pb.CreateCollectionResponse, error) {
+	if s.bookmarks == nil {
+		code, message, details := fromStatus(errBookmarksDisabled)
+		return response.CreateCollectionError(code, message, details...), nil
+	}
+	userID, err := s.authenticate(ctx, "CreateCollection")
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.CreateCollectionError(code, message, details...), nil
+	}
+	if err := validator.ValidateCreateCollection(req); err != nil {
+		log.Printf("[CreateCollection] Invalid argument: %v", err)
+		message, details := validationFailure(err)
+		return response.CreateCollectionError(codes.InvalidArgument, message, details...), nil
+	}
+
+	collection, err := s.createCollection(ctx, userID, req.Name)
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.CreateCollectionError(code, message, details...), nil
+	}
+	log.Printf("[CreateCollection] Success: collection_id=%d, user_id=%d", collection.Id, userID)
+	return response.CreateCollectionSuccess(collection), nil
+}
+
+// ListCollections returns the caller's collections by position
+func (s *ArticleServer) ListCollections(ctx context.Context, req *pb.ListCollectionsRequest) (*pb.ListCollectionsResponse, error) {
+	if s.bookmarks == nil {
+		code, message, details := fromStatus(errBookmarksDisabled)
+		return response.ListCollectionsError(code, message, details...), nil
+	}
+	userID, err := s.authenticate(ctx, "ListCollections")
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.ListCollectionsError(code, message, details...), nil
+	}
+
+	collections, err := s.bookmarks.ListCollections(ctx, userID)
+	if err != nil {
+		code, message, details := fromStatus(collectionError("ListCollections", 0, "", err))
+		return response.ListCollectionsError(code, message, details...), nil
+	}
+	return response.ListCollectionsSuccess(collections), nil
+}
+
+// RenameCollection renames one of the caller's collections
+func (s *ArticleServer) RenameCollection(ctx context.Context, req *pb.RenameCollectionRequest) (*pb.RenameCollectionResponse, error) {
+	if s.bookmarks == nil {
+		code, message, details := fromStatus(errBookmarksDisabled)
+		return response.RenameCollectionError(code, message, details...), nil
+	}
+	userID, err := s.authenticate(ctx, "RenameCollection")
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.RenameCollectionError(code, message, details...), nil
+	}
+	if err := validator.ValidateRenameCollection(req); err != nil {
+		log.Printf("[RenameCollection] Invalid argument: %v", err)
+		message, details := validationFailure(err)
+		return response.RenameCollectionError(codes.InvalidArgument, message, details...), nil
+	}
+
+	collection, err := s.bookmarks.RenameCollection(ctx, userID, req.Id, req.Name)
+	if err != nil {
+		code, message, details := fromStatus(collectionError("RenameCollection", req.Id, req.Name, err))
+		return response.RenameCollectionError(code, message, details...), nil
+	}
+	return response.RenameCollectionSuccess(collection), nil
+}
+
+// ReorderCollections puts the caller's collections in the order of ids, which must list each of them once
+func (s *ArticleServer) ReorderCollections(ctx context.Context, req *pb.ReorderCollectionsRequest) (*pb.ReorderCollectionsResponse, error) {
+	if s.bookmarks == nil {
+		code, message, details := fromStatus(errBookmarksDisabled)
+		return response.ReorderCollectionsError(code, message, details...), nil
+	}
+	userID, err := s.authenticate(ctx, "ReorderCollections")
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.ReorderCollectionsError(code, message, details...), nil
+	}
+	if err := validator.ValidateReorderCollections(req); err != nil {
+		log.Printf("[ReorderCollections] Invalid argument: %v", err)
+		message, details := validationFailure(err)
+		return response.ReorderCollectionsError(codes.InvalidArgument, message, details...), nil
+	}
+
+	collections, err := s.reorderCollections(ctx, userID, req.Ids)
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.ReorderCollectionsError(code, message, details...), nil
+	}
+	return response.ReorderCollectionsSuccess(collections), nil
+}
+
+// ShareCollection creates a share token for one of the caller's collections, or revokes it.
+// Anyone with the token can read the collection through GetSharedCollection.
+func (s *ArticleServer) ShareCollection(ctx context.Context, req *pb.ShareCollectionRequest) (*pb.ShareCollectionResponse, error) {
+	if s.bookmarks == nil {
+		code, message, details := fromStatus(errBookmarksDisabled)
+		return response.ShareCollectionError(code, message, details...), nil
+	}
+	userID, err := s.authenticate(ctx, "ShareCollection")
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.ShareCollectionError(code, message, details...), nil
+	}
+	if err := validator.ValidateShareCollection(req); err != nil {
+		log.Printf("[ShareCollection] Invalid argument: %v", err)
+		message, details := validationFailure(err)
+		return response.ShareCollectionError(codes.InvalidArgument, message, details...), nil
+	}
+
+	collection, err := s.shareCollection(ctx, userID, req)
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.ShareCollectionError(code, message, details...), nil
+	}
+	return response.ShareCollectionSuccess(collection), nil
+}
+
+// DeleteCollection deletes one of the caller's collections with its bookmarks
+func (s *ArticleServer) DeleteCollection(ctx context.Context, req *pb.DeleteCollectionRequest) (*pb.DeleteCollectionResponse, error) {
+	if s.bookmarks == nil {
+		code, message, details := fromStatus(errBookmarksDisabled)
+		return response.DeleteCollectionError(code, message, details...), nil
+	}
+	userID, err := s.authenticate(ctx, "DeleteCollection")
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.DeleteCollectionError(code, message, details...), nil
+	}
+	if err := validator.ValidateDeleteCollection(req); err != nil {
+		log.Printf("[DeleteCollection] Invalid argument: %v", err)
+		message, details := validationFailure(err)
+		return response.DeleteCollectionError(codes.InvalidArgument, message, details...), nil
+	}
+
+	if err := s.bookmarks.DeleteCollection(ctx, userID, req.Id); err != nil {
+		code, message, details := fromStatus(collectionError("DeleteCollection", req.Id, "", err))
+		return response.DeleteCollectionError(code, message, details...), nil
+	}
+	log.Printf("[DeleteCollection] Success: collection_id=%d, user_id=%d", req.Id, userID)
+	return response.DeleteCollectionSuccess(), nil
+}
+
+// GetSharedCollection returns a shared collection and a page of its bookmarks; no authentication is needed
+func (s *ArticleServer) GetSharedCollection(ctx context.Context, req *pb.GetSharedCollectionRequest) (*pb.GetSharedCollectionResponse, error) {
+	if s.bookmarks == nil {
+		code, message, details := fromStatus(errBookmarksDisabled)
+		return response.GetSharedCollectionError(code, message, details...), nil
+	}
+	if err := validator.ValidateGetSharedCollection(req); err != nil {
+		log.Printf("[GetSharedCollection] Invalid argument: %v", err)
+		message, details := validationFailure(err)
+		return response.GetSharedCollectionError(codes.InvalidArgument, message, details...), nil
+	}
+
+	collection, bookmarks, next, err := s.sharedCollection(ctx, req)
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.GetSharedCollectionError(code, message, details...), nil
+	}
+	return response.GetSharedCollectionSuccess(collection, bookmarks, next), nil
+}
@@ -0,0 +1,497 @@
+package server
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"sync"
+
+	"github.com/thatlq1812/service-2-article/internal/auth"
+	"github.com/thatlq1812/service-2-article/internal/repository"
+	"github.com/thatlq1812/service-2-article/internal/response"
+	"github.com/thatlq1812/service-2-article/internal/validator"
+	pb "github.com/thatlq1812/service-2-article/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxCommentDepth is how deep replies nest below a top-level comment
+	maxCommentDepth = 5
+	// defaultReplyPageSize is how many replies ListComments returns per top-level comment by default
+	defaultReplyPageSize = 3
+	// maxUserFetches bounds the concurrent User Service calls for one page of comments
+	maxUserFetches = 10
+)
+
+// EnableComments serves the comment RPCs from repo; without it they return Unimplemented
+func (s *ArticleServer) EnableComments(repo repository.CommentRepository) {
+	s.comments = repo
+}
+
+// errCommentsDisabled is returned by the comment RPCs when comments are not enabled
+var errCommentsDisabled = response.StatusError(codes.Unimplemented, "comments are not enabled on this server")
+
+func commentNotFound(id int32) error {
+	return response.StatusError(codes.NotFound, fmt.Sprintf("comment with ID %d not found", id),
+		response.ErrorInfo(response.ReasonCommentNotFound, map[string]string{"comment_id": fmt.Sprint(id)}))
+}
+
+// viewer returns the caller's user ID, or 0 for anonymous calls; a token that is sent must be valid
+func (s *ArticleServer) viewer(ctx context.Context, method string) (int32, error) {
+	if _, err := auth.ExtractTokenFromContext(ctx); errors.Is(err, auth.ErrMissingToken) {
+		return 0, nil
+	}
+	return s.authenticate(ctx, method)
+}
+
+// canModerate reports whether userID may hide or delete any comment on article: its author and admins
+func (s *ArticleServer) canModerate(userID int32, article *pb.Article) bool {
+	return userID == article.UserId || s.admins[userID]
+}
+
+// commentArticle loads the id and author of the article being commented on
+func (s *ArticleServer) commentArticle(ctx context.Context, method string, id int32) (*pb.Article, error) {
+	article, err := s.repo.GetByID(ctx, id, "id")
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, articleNotFound(id)
+		}
+		log.Printf("[%s] Database error: article_id=%d, error=%v", method, id, err)
+		return nil, response.StatusError(response.GRPCCodeFromError(err), "failed to get article")
+	}
+	return article, nil
+}
+
+// getComment loads a comment without its user
+func (s *ArticleServer) getComment(ctx context.Context, method string, id int32) (*pb.Comment, error) {
+	comment, err := s.comments.GetComment(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, commentNotFound(id)
+		}
+		log.Printf("[%s] Database error: comment_id=%d, error=%v", method, id, err)
+		return nil, response.StatusError(response.GRPCCodeFromError(err), "failed to get comment")
+	}
+	return comment, nil
+}
+
+// fetchUser returns a user from User Service, or nil when it cannot provide it
+func (s *ArticleServer) fetchUser(ctx context.Context, method string, userID int32) *pb.User {
+	user, err := s.userClient.GetUser(ctx, userID)
+	if err != nil {
+		log.Printf("[%s] WARN: User unavailable (graceful degradation): user_id=%d, code=%s", method, userID, status.Code(err))
+		return nil
+	}
+	return convertUser(user)
+}
+
+// fetchUsers returns the users of comments from User Service, asking once per user and at most
+// maxUserFetches at a time. Users that cannot be fetched are missing from the map.
+func (s *ArticleServer) fetchUsers(ctx context.Context, method string, comments []*pb.Comment) map[int32]*pb.User {
+	var (
+		mu    sync.Mutex
+		wg    sync.WaitGroup
+		users = make(map[int32]*pb.User)
+		seen  = make(map[int32]bool)
+		slots = make(chan struct{}, maxUserFetches)
+	)
+	for _, c := range comments {
+		if seen[c.UserId] {
+			continue
+		}
+		seen[c.UserId] = true
+		wg.Go(func() {
+			slots <- struct{}{}
+			defer func() { <-slots }()
+			if user := s.fetchUser(ctx, method, c.UserId); user != nil {
+				mu.Lock()
+				users[c.UserId] = user
+				mu.Unlock()
+			}
+		})
+	}
+	wg.Wait()
+	return users
+}
+
+// flattenComments returns comments and all of their nested replies
+func flattenComments(comments []*pb.Comment) []*pb.Comment {
+	var all []*pb.Comment
+	for _, c := range comments {
+		all = append(all, c)
+		all = append(all, flattenComments(c.Replies)...)
+	}
+	return all
+}
+
+// prepareComments sets the user of every comment and nested reply and clears the content of
+// hidden comments the viewer may not read: only their authors and moderators can
+func (s *ArticleServer) prepareComments(ctx context.Context, method string, comments []*pb.Comment, viewerID int32, moderator bool) {
+	all := flattenComments(comments)
+	users := s.fetchUsers(ctx, method, all)
+	for _, c := range all {
+		if c.Hidden && !moderator && c.UserId != viewerID {
+			c.Content = ""
+		}
+		c.User = users[c.UserId]
+	}
+}
+
+// createComment stores a comment by userID, checking the parent is in the same article and not too deep
+func (s *ArticleServer) createComment(ctx context.Context, userID int32, req *pb.CreateCommentRequest) (*pb.Comment, error) {
+	if _, err := s.commentArticle(ctx, "CreateComment", req.ArticleId); err != nil {
+		return nil, err
+	}
+	if req.ParentId > 0 {
+		parent, err := s.getComment(ctx, "CreateComment", req.ParentId)
+		if err != nil {
+			return nil, err
+		}
+		if parent.ArticleId != req.ArticleId {
+			return nil, commentNotFound(req.ParentId)
+		}
+		if parent.Depth >= maxCommentDepth {
+			return nil, invalidArgument(validator.Violations{{Field: "parent_id", Description: fmt.Sprintf("replies nest at most %d levels deep", maxCommentDepth)}})
+		}
+	}
+
+	created, err := s.comments.CreateComment(ctx, &pb.Comment{
+		ArticleId: req.ArticleId,
+		ParentId:  req.ParentId,
+		UserId:    userID,
+		Content:   req.Content,
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			// The article or the parent was deleted meanwhile
+			if req.ParentId > 0 {
+				return nil, commentNotFound(req.ParentId)
+			}
+			return nil, articleNotFound(req.ArticleId)
+		}
+		log.Printf("[CreateComment] Database error: article_id=%d, user_id=%d, error=%v", req.ArticleId, userID, err)
+		return nil, response.StatusError(response.GRPCCodeFromError(err), "failed to create comment")
+	}
+	created.User = s.fetchUser(ctx, "CreateComment", userID)
+	log.Printf("[CreateComment] Success: comment_id=%d, article_id=%d, user_id=%d", created.Id, created.ArticleId, userID)
+	return created, nil
+}
+
+// listComments returns one page of top-level comments of an article with up to replyLimit replies
+// each, as viewerID (0 for anonymous callers) may see them. Threads with more replies get a
+// replies_page_token for ListReplies.
+func (s *ArticleServer) listComments(ctx context.Context, viewerID, articleID, limit, offset, replyLimit int32) ([]*pb.Comment, int32, error) {
+	article, err := s.commentArticle(ctx, "ListComments", articleID)
+	if err != nil {
+		return nil, 0, err
+	}
+	comments, total, err := s.comments.ListComments(ctx, articleID, limit, offset, replyLimit)
+	if err != nil {
+		log.Printf("[ListComments] Database error: article_id=%d, error=%v", articleID, err)
+		return nil, 0, response.StatusError(response.GRPCCodeFromError(err), "failed to list comments")
+	}
+	for _, c := range comments {
+		replies := flattenComments(c.Replies)
+		if len(replies) > 0 && c.ReplyCount > int32(len(replies)) {
+			// Replies are returned in ID order, so the rest of the thread follows the highest ID
+			last := slices.MaxFunc(replies, func(a, b *pb.Comment) int { return cmp.Compare(a.Id, b.Id) })
+			c.RepliesPageToken = encodePageToken(last.Id)
+		}
+	}
+	s.prepareComments(ctx, "ListComments", comments, viewerID, viewerID != 0 && s.canModerate(viewerID, article))
+	return comments, total, nil
+}
+
+// listReplies returns one page of the replies in the thread of a top-level comment, as viewerID
+// may see them, with the token of the next page. Page tokens hold the ID of the last reply returned.
+func (s *ArticleServer) listReplies(ctx context.Context, viewerID int32, req *pb.ListRepliesRequest) ([]*pb.Comment, string, error) {
+	after, ok := decodePageToken(req.PageToken)
+	if !ok {
+		return nil, "", invalidArgument(validator.Violations{{Field: "page_token", Description: "is not a valid page token"}})
+	}
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+
+	comment, err := s.getComment(ctx, "ListReplies", req.CommentId)
+	if err != nil {
+		return nil, "", err
+	}
+	if comment.ParentId != 0 {
+		return nil, "", invalidArgument(validator.Violations{{Field: "comment_id", Description: "must be a top-level comment"}})
+	}
+	article, err := s.commentArticle(ctx, "ListReplies", comment.ArticleId)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// One more than asked for tells whether there is a next page
+	replies, err := s.comments.ListReplies(ctx, req.CommentId, after, pageSize+1)
+	if err != nil {
+		log.Printf("[ListReplies] Database error: comment_id=%d, error=%v", req.CommentId, err)
+		return nil, "", response.StatusError(response.GRPCCodeFromError(err), "failed to list replies")
+	}
+	var next string
+	if int32(len(replies)) > pageSize {
+		replies = replies[:pageSize]
+		next = encodePageToken(replies[pageSize-1].Id)
+	}
+	s.prepareComments(ctx, "ListReplies", replies, viewerID, viewerID != 0 && s.canModerate(viewerID, article))
+	return replies, next, nil
+}
+
+// updateComment replaces the content of a comment; only its author may
+func (s *ArticleServer) updateComment(ctx context.Context, userID int32, req *pb.UpdateCommentRequest) (*pb.Comment, error) {
+	comment, err := s.getComment(ctx, "UpdateComment", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if comment.UserId != userID {
+		denied := fmt.Errorf("user %d is not the author of comment %d: %w", userID, req.Id, repository.ErrForbidden)
+		log.Printf("[UpdateComment] Permission denied: %v", denied)
+		return nil, response.StatusError(response.GRPCCodeFromError(denied), "only the author can edit a comment")
+	}
+
+	updated, err := s.comments.UpdateComment(ctx, req.Id, req.Content)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, commentNotFound(req.Id)
+		}
+		log.Printf("[UpdateComment] Database error: comment_id=%d, error=%v", req.Id, err)
+		return nil, response.StatusError(response.GRPCCodeFromError(err), "failed to update comment")
+	}
+	updated.User = s.fetchUser(ctx, "UpdateComment", userID)
+	return updated, nil
+}
+
+// deleteComment deletes a comment with its replies; its author and moderators may
+func (s *ArticleServer) deleteComment(ctx context.Context, userID, id int32) error {
+	comment, err := s.getComment(ctx, "DeleteComment", id)
+	if err != nil {
+		return err
+	}
+	if comment.UserId != userID {
+		article, err := s.commentArticle(ctx, "DeleteComment", comment.ArticleId)
+		if err != nil {
+			return err
+		}
+		if !s.canModerate(userID, article) {
+			denied := fmt.Errorf("user %d may not delete comment %d: %w", userID, id, repository.ErrForbidden)
+			log.Printf("[DeleteComment] Permission denied: %v", denied)
+			return response.StatusError(response.GRPCCodeFromError(denied), "only the comment author or the article author can delete a comment")
+		}
+	}
+
+	if _, err := s.comments.DeleteComment(ctx, id); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return commentNotFound(id)
+		}
+		log.Printf("[DeleteComment] Database error: comment_id=%d, error=%v", id, err)
+		return response.StatusError(response.GRPCCodeFromError(err), "failed to delete comment")
+	}
+	log.Printf("[DeleteComment] Success: comment_id=%d, user_id=%d", id, userID)
+	return nil
+}
+
+// moderateComment hides or shows a comment; only moderators of its article may
+func (s *ArticleServer) moderateComment(ctx context.Context, userID int32, req *pb.ModerateCommentRequest) (*pb.Comment, error) {
+	comment, err := s.getComment(ctx, "ModerateComment", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	article, err := s.commentArticle(ctx, "ModerateComment", comment.ArticleId)
+	if err != nil {
+		return nil, err
+	}
+	if !s.canModerate(userID, article) {
+		denied := fmt.Errorf("user %d may not moderate comment %d: %w", userID, req.Id, repository.ErrForbidden)
+		log.Printf("[ModerateComment] Permission denied: %v", denied)
+		return nil, response.StatusError(response.GRPCCodeFromError(denied), "only the article author can moderate its comments")
+	}
+
+	updated, err := s.comments.SetCommentHidden(ctx, req.Id, req.Hidden)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, commentNotFound(req.Id)
+		}
+		log.Printf("[ModerateComment] Database error: comment_id=%d, error=%v", req.Id, err)
+		return nil, response.StatusError(response.GRPCCodeFromError(err), "failed to moderate comment")
+	}
+	updated.User = s.fetchUser(ctx, "ModerateComment", updated.UserId)
+	log.Printf("[ModerateComment] Success: comment_id=%d, hidden=%t, user_id=%d", req.Id, req.Hidden, userID)
+	return updated, nil
+}
+
+// CreateComment adds a comment, or a reply when parent_id is set, by the authenticated user
+func (s *ArticleServer) CreateComment(ctx context.Context, req *pb.CreateCommentRequest) (*pb.CreateCommentResponse, error) {
+	if s.comments == nil {
+		code, message, details := fromStatus(errCommentsDisabled)
+		return response.CreateCommentError(code, message, details...), nil
+	}
+	userID, err := s.authenticate(ctx, "CreateComment")
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.CreateCommentError(code, message, details...), nil
+	}
+	if err := validator.ValidateCreateComment(req); err != nil {
+		log.Printf("[CreateComment] Invalid argument: %v", err)
+		message, details := validationFailure(err)
+		return response.CreateCommentError(codes.InvalidArgument, message, details...), nil
+	}
+
+	comment, err := s.createComment(ctx, userID, req)
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.CreateCommentError(code, message, details...), nil
+	}
+	return response.CreateCommentSuccess(comment), nil
+}
+
+// ListComments returns a page of an article's top-level comments with their replies nested.
+// Authentication is optional; it lets authors and moderators read hidden comments.
+func (s *ArticleServer) ListComments(ctx context.Context, req *pb.ListCommentsRequest) (*pb.ListCommentsResponse, error) {
+	if s.comments == nil {
+		code, message, details := fromStatus(errCommentsDisabled)
+		return response.ListCommentsError(code, message, details...), nil
+	}
+	if err := validator.ValidateListComments(req); err != nil {
+		log.Printf("[ListComments] Invalid argument: %v", err)
+		message, details := validationFailure(err)
+		return response.ListCommentsError(codes.InvalidArgument, message, details...), nil
+	}
+	viewerID, err := s.viewer(ctx, "ListComments")
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.ListCommentsError(code, message, details...), nil
+	}
+
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	pageNumber := req.PageNumber
+	if pageNumber < 1 {
+		pageNumber = 1
+	}
+
+	replyPageSize := req.ReplyPageSize
+	if replyPageSize <= 0 {
+		replyPageSize = defaultReplyPageSize
+	}
+
+	comments, total, err := s.listComments(ctx, viewerID, req.ArticleId, pageSize, (pageNumber-1)*pageSize, replyPageSize)
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.ListCommentsError(code, message, details...), nil
+	}
+	totalPages := (total + pageSize - 1) / pageSize
+	return response.ListCommentsSuccess(comments, total, pageNumber, totalPages), nil
+}
+
+// ListReplies continues a thread cut short by ListComments, oldest first.
+// Authentication is optional, as for ListComments.
+func (s *ArticleServer) ListReplies(ctx context.Context, req *pb.ListRepliesRequest) (*pb.ListRepliesResponse, error) {
+	if s.comments == nil {
+		code, message, details := fromStatus(errCommentsDisabled)
+		return response.ListRepliesError(code, message, details...), nil
+	}
+	if err := validator.ValidateListReplies(req); err != nil {
+		log.Printf("[ListReplies] Invalid argument: %v", err)
+		message, details := validationFailure(err)
+		return response.ListRepliesError(codes.InvalidArgument, message, details...), nil
+	}
+	viewerID, err := s.viewer(ctx, "ListReplies")
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.ListRepliesError(code, message, details...), nil
+	}
+
+	replies, next, err := s.listReplies(ctx, viewerID, req)
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.ListRepliesError(code, message, details...), nil
+	}
+	return response.ListRepliesSuccess(replies, next), nil
+}
+
+// UpdateComment replaces the content of one of the caller's comments
+func (s *ArticleServer) UpdateComment(ctx context.Context, req *pb.UpdateCommentRequest) (*pb.UpdateCommentResponse, error) {
+	if s.comments == nil {
+		code, message, details := fromStatus(errCommentsDisabled)
+		return response.UpdateCommentError(code, message, details...), nil
+	}
+	userID, err := s.authenticate(ctx, "UpdateComment")
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.UpdateCommentError(code, message, details...), nil
+	}
+	if err := validator.ValidateUpdateComment(req); err != nil {
+		log.Printf("[UpdateComment] Invalid argument: %v", err)
+		message, details := validationFailure(err)
+		return response.UpdateCommentError(codes.InvalidArgument, message, details...), nil
+	}
+
+	comment, err := s.updateComment(ctx, userID, req)
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.UpdateCommentError(code, message, details...), nil
+	}
+	return response.UpdateCommentSuccess(comment), nil
+}
+
+// DeleteComment deletes a comment and its replies; allowed for the comment's author and
+// for the article's author (and admins)
+func (s *ArticleServer) DeleteComment(ctx context.Context, req *pb.DeleteCommentRequest) (*pb.DeleteCommentResponse, error) {
+	if s.comments == nil {
+		code, message, details := fromStatus(errCommentsDisabled)
+		return response.DeleteCommentError(code, message, details...), nil
+	}
+	userID, err := s.authenticate(ctx, "DeleteComment")
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.DeleteCommentError(code, message, details...), nil
+	}
+	if err := validator.ValidateDeleteComment(req); err != nil {
+		log.Printf("[DeleteComment] Invalid argument: %v", err)
+		message, details := validationFailure(err)
+		return response.DeleteCommentError(codes.InvalidArgument, message, details...), nil
+	}
+
+	if err := s.deleteComment(ctx, userID, req.Id); err != nil {
+		code, message, details := fromStatus(err)
+		return response.DeleteCommentError(code, message, details...), nil
+	}
+	return response.DeleteCommentSuccess(), nil
+}
+
+// ModerateComment hides or shows a comment on one of the caller's articles (admins: any article).
+// Hidden comments keep their replies and no longer count towards comment_count.
+func (s *ArticleServer) ModerateComment(ctx context.Context, req *pb.ModerateCommentRequest) (*pb.ModerateCommentResponse, error) {
+	if s.comments == nil {
+		code, message, details := fromStatus(errCommentsDisabled)
+		return response.ModerateCommentError(code, message, details...), nil
+	}
+	userID, err := s.authenticate(ctx, "ModerateComment")
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.ModerateCommentError(code, message, details...), nil
+	}
+	if err := validator.ValidateModerateComment(req); err != nil {
+		log.Printf("[ModerateComment] Invalid argument: %v", err)
+		message, details := validationFailure(err)
+		return response.ModerateCommentError(codes.InvalidArgument, message, details...), nil
+	}
+
+	comment, err := s.moderateComment(ctx, userID, req)
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.ModerateCommentError(code, message, details...), nil
+	}
+	return response.ModerateCommentSuccess(comment), nil
+}
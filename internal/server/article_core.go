@@ -0,0 +1,396 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/thatlq1812/service-2-article/internal/auth"
+	"github.com/thatlq1812/service-2-article/internal/repository"
+	"github.com/thatlq1812/service-2-article/internal/response"
+	"github.com/thatlq1812/service-2-article/internal/validator"
+	pb "github.com/thatlq1812/service-2-article/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// The methods in this file hold the business logic shared by the v1 (envelope) and
+// v2 (status code) APIs. They take validated input and return gRPC status errors
+// carrying google.rpc details; v1 handlers copy those into the response envelope.
+
+// fromStatus splits a status error into the parts of a v1 response envelope
+func fromStatus(err error) (codes.Code, string, []proto.Message) {
+	st := status.Convert(err)
+	return st.Code(), st.Message(), response.DetailsFromStatus(st)
+}
+
+// invalidArgument converts validator output into an InvalidArgument status with BadRequest details
+func invalidArgument(err error) error {
+	message, details := validationFailure(err)
+	return response.StatusError(codes.InvalidArgument, message, details...)
+}
+
+// articleNotFound is the status returned for a missing article
+func articleNotFound(id int32) error {
+	return response.StatusError(codes.NotFound, fmt.Sprintf("article with ID %d not found", id),
+		response.ErrorInfo(response.ReasonArticleNotFound, map[string]string{"article_id": fmt.Sprint(id)}))
+}
+
+// authenticate returns the caller's user ID from the JWT (with Redis blacklist check)
+func (s *ArticleServer) authenticate(ctx context.Context, method string) (int32, error) {
+	userID, err := auth.GetUserIDFromContextWithBlacklist(ctx, s.jwtSecret, s.redis)
+	if err != nil {
+		if err == auth.ErrTokenBlacklisted {
+			log.Printf("[%s] Token has been revoked (logged out)", method)
+			return 0, response.StatusError(codes.Unauthenticated, "token has been revoked",
+				response.ErrorInfo(response.ReasonTokenRevoked, nil))
+		}
+		log.Printf("[%s] Authentication failed: %v", method, err)
+		return 0, response.StatusError(codes.Unauthenticated, "authentication required")
+	}
+	return int32(userID), nil
+}
+
+// verifyAuthor checks that userID exists in User Service.
+// A missing user is InvalidArgument (AUTHOR_NOT_FOUND); other failures keep their code.
+func (s *ArticleServer) verifyAuthor(ctx context.Context, method string, userID int32) error {
+	log.Printf("[%s] Verifying user exists: user_id=%d", method, userID)
+	_, err := s.userClient.GetUser(ctx, userID)
+	if err == nil {
+		return nil
+	}
+
+	st := status.Convert(err)
+	switch st.Code() {
+	case codes.NotFound:
+		log.Printf("[%s] User not found: user_id=%d", method, userID)
+		return response.StatusError(codes.InvalidArgument, fmt.Sprintf("user with ID %d not found", userID),
+			response.ErrorInfo(response.ReasonAuthorNotFound, map[string]string{"user_id": fmt.Sprint(userID)}))
+	case codes.Unavailable:
+		log.Printf("[%s] User service unavailable: user_id=%d", method, userID)
+		return response.StatusError(codes.Unavailable, "user service is currently unavailable, please try again later",
+			response.ErrorInfo(response.ReasonUserServiceDown, nil))
+	case codes.DeadlineExceeded:
+		log.Printf("[%s] User service timeout: user_id=%d", method, userID)
+		return response.StatusError(codes.DeadlineExceeded, "request timeout while verifying user",
+			response.ErrorInfo(response.ReasonUserServiceDown, nil))
+	default:
+		log.Printf("[%s] Failed to verify user: user_id=%d, error=%v", method, userID, err)
+		return response.StatusError(codes.Internal, "failed to verify user",
+			response.ErrorInfo(response.ReasonUserServiceFailed, nil))
+	}
+}
+
+// createArticle verifies the author exists, renders the content and stores input
+func (s *ArticleServer) createArticle(ctx context.Context, input *pb.Article) (*pb.Article, error) {
+	userID := input.UserId
+
+	// Verify user exists by calling User Service
+	if err := s.verifyAuthor(ctx, "CreateArticle", userID); err != nil {
+		return nil, err
+	}
+
+	// Sanitise and render content before it is stored
+	if err := prepareContent(input); err != nil {
+		log.Printf("[CreateArticle] Invalid content: user_id=%d, error=%v", userID, err)
+		return nil, contentError(err)
+	}
+
+	// Create article in database with authenticated user ID
+	article, err := s.repo.Create(ctx, input)
+	if err != nil {
+		log.Printf("[CreateArticle] Database error: user_id=%d, error=%v", userID, err)
+		if errors.Is(err, repository.ErrConflict) {
+			return nil, response.StatusError(codes.AlreadyExists, "article already exists",
+				response.ErrorInfo(response.ReasonArticleConflict, nil),
+				response.PreconditionFailure("CONFLICT", "article", "an article with the same unique fields already exists"))
+		}
+		return nil, response.StatusError(response.GRPCCodeFromError(err), "failed to create article")
+	}
+
+	log.Printf("[CreateArticle] Success: article_id=%d, user_id=%d", article.Id, userID)
+	return article, nil
+}
+
+// loadArticle loads the requested fields of an article (nil loads every field)
+func (s *ArticleServer) loadArticle(ctx context.Context, id int32, fields []string) (*pb.Article, error) {
+	article, err := s.repo.GetByID(ctx, id, fields...)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			log.Printf("[GetArticle] Article not found: article_id=%d", id)
+			return nil, articleNotFound(id)
+		}
+		log.Printf("[GetArticle] Database error: article_id=%d, error=%v", id, err)
+		return nil, response.StatusError(response.GRPCCodeFromError(err), "failed to get article")
+	}
+	return article, nil
+}
+
+// getArticle loads the requested fields of an article and its author.
+// Implements graceful degradation: the author is nil when User Service cannot provide it.
+func (s *ArticleServer) getArticle(ctx context.Context, id int32, fields []string) (*pb.ArticleWithUser, error) {
+	// 1. Retrieve article from database, loading only the requested fields
+	article, err := s.loadArticle(ctx, id, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Fetch user information from User Service (inter-service communication)
+	log.Printf("[GetArticleWithUser] Fetching user info: article_id=%d, user_id=%d", article.Id, article.UserId)
+	user := s.fetchAuthor(ctx, "GetArticleWithUser", article)
+
+	// 3. Return combined article and user data
+	if user != nil {
+		log.Printf("[GetArticleWithUser] Success: article_id=%d, user_id=%d, user_email=%s", article.Id, user.Id, user.Email)
+	}
+	return &pb.ArticleWithUser{
+		Article: article,
+		User:    user,
+	}, nil
+}
+
+// fetchAuthor returns the author of article, or nil when User Service cannot provide it
+func (s *ArticleServer) fetchAuthor(ctx context.Context, method string, article *pb.Article) *pb.User {
+	userServiceUser, err := s.userClient.GetUser(ctx, article.UserId)
+	if err == nil {
+		return convertUser(userServiceUser)
+	}
+
+	st := status.Convert(err)
+	switch st.Code() {
+	case codes.NotFound:
+		// User deleted or doesn't exist - this is expected, return article without user
+		log.Printf("[%s] WARN: User not found (graceful degradation): article_id=%d, user_id=%d", method, article.Id, article.UserId)
+	case codes.Unavailable, codes.DeadlineExceeded:
+		// User Service down or timeout - return article without user to maintain availability
+		log.Printf("[%s] WARN: User Service unavailable (graceful degradation): article_id=%d, user_id=%d, code=%s, error=%v",
+			method, article.Id, article.UserId, st.Code(), st.Message())
+	default:
+		// Unexpected error - log as ERROR and still apply graceful degradation
+		log.Printf("[%s] ERROR: User Service unexpected error (graceful degradation): article_id=%d, user_id=%d, code=%s, error=%v",
+			method, article.Id, article.UserId, st.Code(), err)
+	}
+	return nil
+}
+
+// fetchAuthors returns the authors of articles by user ID, asking User Service once per author
+// and for all authors at once. Authors it cannot provide are missing from the map.
+func (s *ArticleServer) fetchAuthors(ctx context.Context, method string, articles []*pb.Article) map[int32]*pb.User {
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		authors = make(map[int32]*pb.User)
+		seen    = make(map[int32]bool)
+	)
+	for _, article := range articles {
+		if seen[article.UserId] {
+			continue
+		}
+		seen[article.UserId] = true
+		wg.Go(func() {
+			if author := s.fetchAuthor(ctx, method, article); author != nil {
+				mu.Lock()
+				authors[article.UserId] = author
+				mu.Unlock()
+			}
+		})
+	}
+	wg.Wait()
+	return authors
+}
+
+// updateArticle applies a validated update request.
+// With an update mask exactly the listed fields are set; otherwise empty fields keep their value.
+func (s *ArticleServer) updateArticle(ctx context.Context, req *pb.UpdateArticleRequest) (*pb.Article, error) {
+	// Check if article exists and get current values
+	existing, err := s.repo.GetByID(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, articleNotFound(req.Id)
+		}
+		log.Printf("[UpdateArticle] Database error: article_id=%d, error=%v", req.Id, err)
+		return nil, response.StatusError(response.GRPCCodeFromError(err), "failed to check article")
+	}
+
+	title, content, format := existing.Title, existing.Content, existing.ContentFormat
+	if paths := req.GetUpdateMask().GetPaths(); len(paths) > 0 {
+		// Apply exactly the listed fields, empty values included
+		for _, path := range paths {
+			switch path {
+			case "title":
+				title = req.Title
+			case "content":
+				content = req.Content
+			case "content_format":
+				format = req.GetContentFormat()
+			}
+		}
+	} else {
+		// Use existing values for omitted fields
+		if req.Title != "" {
+			title = req.Title
+		}
+		if req.Content != "" {
+			content = req.Content
+		}
+		if req.ContentFormat != nil {
+			format = *req.ContentFormat
+		}
+	}
+
+	// Re-render so content_html and the summary always match the stored revision
+	input := &pb.Article{
+		Id:            req.Id,
+		Title:         title,
+		Content:       content,
+		ContentFormat: format,
+	}
+	if err := prepareContent(input); err != nil {
+		log.Printf("[UpdateArticle] Invalid content: article_id=%d, error=%v", req.Id, err)
+		return nil, contentError(err)
+	}
+
+	// Update article
+	article, err := s.repo.Update(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrNotFound):
+			// Deleted between the existence check and the update
+			return nil, articleNotFound(req.Id)
+		case errors.Is(err, repository.ErrConflict):
+			return nil, response.StatusError(codes.AlreadyExists, "article conflicts with an existing one",
+				response.ErrorInfo(response.ReasonArticleConflict, map[string]string{"article_id": fmt.Sprint(req.Id)}),
+				response.PreconditionFailure("CONFLICT", fmt.Sprintf("articles/%d", req.Id), "update conflicts with an existing article"))
+		}
+		log.Printf("[UpdateArticle] Database error: article_id=%d, error=%v", req.Id, err)
+		return nil, response.StatusError(response.GRPCCodeFromError(err), "failed to update article")
+	}
+
+	return article, nil
+}
+
+// deleteArticle removes an article
+func (s *ArticleServer) deleteArticle(ctx context.Context, id int32) error {
+	// Delete article from database (reports ErrNotFound when nothing was deleted)
+	if err := s.repo.Delete(ctx, id); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return articleNotFound(id)
+		}
+		log.Printf("[DeleteArticle] Database error: article_id=%d, error=%v", id, err)
+		return response.StatusError(response.GRPCCodeFromError(err), "failed to delete article")
+	}
+	return nil
+}
+
+// listPage returns one page of articles (all, or of userID when > 0) in order and the total count
+func (s *ArticleServer) listPage(ctx context.Context, userID int32, order pb.ArticleOrder, limit, offset int32, fields []string) ([]*pb.Article, int32, error) {
+	var articles []*pb.Article
+	var total int32
+	var err error
+
+	switch {
+	case order == pb.ArticleOrder_ARTICLE_ORDER_POPULAR:
+		// Most reactions first, all articles or of one user
+		articles, total, err = s.repo.ListPopular(ctx, userID, limit, offset, fields...)
+	case userID > 0:
+		// Filter by specific user
+		articles, total, err = s.repo.ListByUser(ctx, userID, limit, offset, fields...)
+	default:
+		// List all articles
+		articles, total, err = s.repo.ListAll(ctx, limit, offset, fields...)
+	}
+
+	if err != nil {
+		log.Printf("[ListArticles] Database error: user_id=%d, error=%v", userID, err)
+		return nil, 0, response.StatusError(response.GRPCCodeFromError(err), "failed to list articles")
+	}
+	return articles, total, nil
+}
+
+// listArticles returns one page of articles with their authors
+func (s *ArticleServer) listArticles(ctx context.Context, userID int32, order pb.ArticleOrder, limit, offset int32, fields []string) ([]*pb.ArticleWithUser, int32, error) {
+	articles, total, err := s.listPage(ctx, userID, order, limit, offset, fields)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Enrich articles with user information from User Service
+	// Implements graceful degradation: includes articles even if user info fetch fails
+	log.Printf("[ListArticles] Fetching user info for %d articles", len(articles))
+	articlesWithUser := make([]*pb.ArticleWithUser, 0, len(articles))
+	failedUserFetches := 0
+
+	for _, article := range articles {
+		user := s.fetchAuthor(ctx, "ListArticles", article)
+		if user == nil {
+			failedUserFetches++
+		}
+		articlesWithUser = append(articlesWithUser, &pb.ArticleWithUser{
+			Article: article,
+			User:    user,
+		})
+	}
+
+	if failedUserFetches > 0 {
+		log.Printf("[ListArticles] WARN: %d/%d articles returned without author info due to User Service issues",
+			failedUserFetches, len(articles))
+	}
+
+	return articlesWithUser, total, nil
+}
+
+// exportArticles streams the articles matching filter to send, resolving authors when includeAuthor is set.
+// Authors are fetched once per export and reused across their articles.
+func (s *ArticleServer) exportArticles(ctx context.Context, filter repository.ExportFilter, fields []string, includeAuthor bool, send func(*pb.ArticleWithUser) error) error {
+	authors := make(map[int32]*pb.User)
+	exported := 0
+	var sendErr error
+
+	err := s.repo.Export(ctx, filter, fields, func(article *pb.Article) error {
+		item := &pb.ArticleWithUser{Article: article}
+		if includeAuthor {
+			user, ok := authors[article.UserId]
+			if !ok {
+				// Missing authors are cached as nil so User Service is asked only once
+				user = s.fetchAuthor(ctx, "ExportArticles", article)
+				authors[article.UserId] = user
+			}
+			item.User = user
+		}
+		if err := send(item); err != nil {
+			sendErr = err
+			return err
+		}
+		exported++
+		return nil
+	})
+
+	switch {
+	case err == nil:
+		log.Printf("[ExportArticles] Success: exported=%d, user_id=%d", exported, filter.UserID)
+		return nil
+	case ctx.Err() != nil:
+		// Client cancelled or deadline exceeded
+		log.Printf("[ExportArticles] Cancelled after %d articles: %v", exported, ctx.Err())
+		return status.FromContextError(ctx.Err()).Err()
+	case sendErr != nil:
+		log.Printf("[ExportArticles] Send failed after %d articles: %v", exported, sendErr)
+		return sendErr
+	default:
+		log.Printf("[ExportArticles] Database error after %d articles: %v", exported, err)
+		return response.StatusError(response.GRPCCodeFromError(err), "failed to export articles")
+	}
+}
+
+// contentError maps a prepareContent failure to a status
+func contentError(err error) error {
+	var violations validator.Violations
+	if errors.As(err, &violations) {
+		return invalidArgument(err)
+	}
+	return response.StatusError(codes.Internal, "failed to render content")
+}
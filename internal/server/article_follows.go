@@ -0,0 +1,250 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thatlq1812/service-2-article/internal/repository"
+	"github.com/thatlq1812/service-2-article/internal/response"
+	"github.com/thatlq1812/service-2-article/internal/validator"
+	pb "github.com/thatlq1812/service-2-article/proto"
+
+	"google.golang.org/grpc/codes"
+)
+
+// EnableFollows serves the follow and feed RPCs from repo; without it they return Unimplemented
+func (s *ArticleServer) EnableFollows(repo repository.FollowRepository) {
+	s.follows = repo
+}
+
+// errFollowsDisabled is returned by the follow and feed RPCs when follows are not enabled
+var errFollowsDisabled = response.StatusError(codes.Unimplemented, "follows are not enabled on this server")
+
+// Feed page tokens hold the position of the last article of the previous page. Articles are
+// ordered by creation time, which is not unique, so the token carries the ID as well.
+func encodeFeedToken(p repository.FeedPosition) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d.%d", p.CreatedAt.UnixNano(), p.ID)))
+}
+
+func decodeFeedToken(token string) (repository.FeedPosition, bool) {
+	if token == "" {
+		return repository.FeedPosition{}, true
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return repository.FeedPosition{}, false
+	}
+	nanos, id, ok := strings.Cut(string(raw), ".")
+	if !ok {
+		return repository.FeedPosition{}, false
+	}
+	createdAt, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return repository.FeedPosition{}, false
+	}
+	articleID, err := strconv.ParseInt(id, 10, 32)
+	if err != nil || articleID <= 0 {
+		return repository.FeedPosition{}, false
+	}
+	return repository.FeedPosition{CreatedAt: time.Unix(0, createdAt), ID: int32(articleID)}, true
+}
+
+// followAuthor makes userID follow an author who exists in User Service. userID may follow at
+// most validator.MaxFollowing authors.
+func (s *ArticleServer) followAuthor(ctx context.Context, userID, authorID int32) (*pb.Follow, bool, error) {
+	if authorID == userID {
+		return nil, false, invalidArgument(validator.Violations{{Field: "author_id", Description: "must not be yourself"}})
+	}
+	if err := s.verifyAuthor(ctx, "FollowAuthor", authorID); err != nil {
+		return nil, false, err
+	}
+
+	follow, added, err := s.follows.Follow(ctx, userID, authorID, validator.MaxFollowing)
+	if errors.Is(err, repository.ErrLimitExceeded) {
+		return nil, false, response.StatusError(codes.FailedPrecondition, fmt.Sprintf("you already follow %d authors", validator.MaxFollowing),
+			response.PreconditionFailure("LIMIT", fmt.Sprintf("users/%d/following", userID), fmt.Sprintf("at most %d followed authors per user", validator.MaxFollowing)))
+	}
+	if err != nil {
+		log.Printf("[FollowAuthor] Database error: author_id=%d, user_id=%d, error=%v", authorID, userID, err)
+		return nil, false, response.StatusError(response.GRPCCodeFromError(err), "failed to follow author")
+	}
+	log.Printf("[FollowAuthor] Success: author_id=%d, user_id=%d, added=%t", authorID, userID, added)
+	return follow, added, nil
+}
+
+// unfollowAuthor removes an author from the feed of userID
+func (s *ArticleServer) unfollowAuthor(ctx context.Context, userID, authorID int32) (bool, error) {
+	removed, err := s.follows.Unfollow(ctx, userID, authorID)
+	if err != nil {
+		log.Printf("[UnfollowAuthor] Database error: author_id=%d, user_id=%d, error=%v", authorID, userID, err)
+		return false, response.StatusError(response.GRPCCodeFromError(err), "failed to unfollow author")
+	}
+	return removed, nil
+}
+
+// followingPage returns one page of the follows of userID with the token of the next page.
+// Page tokens hold the ID of the last follow of the previous page.
+func (s *ArticleServer) followingPage(ctx context.Context, userID, pageSize int32, pageToken string) ([]*pb.Follow, string, error) {
+	after, ok := decodePageToken(pageToken)
+	if !ok {
+		return nil, "", invalidArgument(validator.Violations{{Field: "page_token", Description: "is not a valid page token"}})
+	}
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+
+	// One more than asked for tells whether there is a next page
+	follows, err := s.follows.ListFollowing(ctx, userID, after, pageSize+1)
+	if err != nil {
+		log.Printf("[ListFollowing] Database error: user_id=%d, error=%v", userID, err)
+		return nil, "", response.StatusError(response.GRPCCodeFromError(err), "failed to list followed authors")
+	}
+	var next string
+	if int32(len(follows)) > pageSize {
+		follows = follows[:pageSize]
+		next = encodePageToken(follows[pageSize-1].Id)
+	}
+	return follows, next, nil
+}
+
+// feedPage returns one page of the feed of userID, with the authors, and the token of the next page
+func (s *ArticleServer) feedPage(ctx context.Context, userID int32, req *pb.GetFeedRequest) ([]*pb.ArticleWithUser, string, error) {
+	after, ok := decodeFeedToken(req.PageToken)
+	if !ok {
+		return nil, "", invalidArgument(validator.Violations{{Field: "page_token", Description: "is not a valid page token"}})
+	}
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	fields := readFields(req.ReadMask, req.View, pb.ArticleView_ARTICLE_VIEW_BASIC)
+
+	entries, err := s.follows.Feed(ctx, userID, after, pageSize+1, fields...)
+	if err != nil {
+		log.Printf("[GetFeed] Database error: user_id=%d, error=%v", userID, err)
+		return nil, "", response.StatusError(response.GRPCCodeFromError(err), "failed to get feed")
+	}
+	var next string
+	if int32(len(entries)) > pageSize {
+		entries = entries[:pageSize]
+		next = encodeFeedToken(entries[pageSize-1].Position)
+	}
+
+	articles := make([]*pb.Article, len(entries))
+	for i, entry := range entries {
+		articles[i] = entry.Article
+	}
+	authors := s.fetchAuthors(ctx, "GetFeed", articles)
+	feed := make([]*pb.ArticleWithUser, len(articles))
+	for i, article := range articles {
+		feed[i] = &pb.ArticleWithUser{Article: article, User: authors[article.UserId]}
+	}
+	return feed, next, nil
+}
+
+// FollowAuthor adds an author to the caller's feed
+func (s *ArticleServer) FollowAuthor(ctx context.Context, req *pb.FollowAuthorRequest) (*pb.FollowAuthorResponse, error) {
+	if s.follows == nil {
+		code, message, details := fromStatus(errFollowsDisabled)
+		return response.FollowAuthorError(code, message, details...), nil
+	}
+	userID, err := s.authenticate(ctx, "FollowAuthor")
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.FollowAuthorError(code, message, details...), nil
+	}
+	if err := validator.ValidateFollowAuthor(req); err != nil {
+		log.Printf("[FollowAuthor] Invalid argument: %v", err)
+		message, details := validationFailure(err)
+		return response.FollowAuthorError(codes.InvalidArgument, message, details...), nil
+	}
+
+	follow, added, err := s.followAuthor(ctx, userID, req.AuthorId)
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.FollowAuthorError(code, message, details...), nil
+	}
+	return response.FollowAuthorSuccess(follow, added), nil
+}
+
+// UnfollowAuthor removes an author from the caller's feed
+func (s *ArticleServer) UnfollowAuthor(ctx context.Context, req *pb.UnfollowAuthorRequest) (*pb.UnfollowAuthorResponse, error) {
+	if s.follows == nil {
+		code, message, details := fromStatus(errFollowsDisabled)
+		return response.UnfollowAuthorError(code, message, details...), nil
+	}
+	userID, err := s.authenticate(ctx, "UnfollowAuthor")
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.UnfollowAuthorError(code, message, details...), nil
+	}
+	if err := validator.ValidateUnfollowAuthor(req); err != nil {
+		log.Printf("[UnfollowAuthor] Invalid argument: %v", err)
+		message, details := validationFailure(err)
+		return response.UnfollowAuthorError(codes.InvalidArgument, message, details...), nil
+	}
+
+	removed, err := s.unfollowAuthor(ctx, userID, req.AuthorId)
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.UnfollowAuthorError(code, message, details...), nil
+	}
+	return response.UnfollowAuthorSuccess(removed), nil
+}
+
+// ListFollowing pages through the authors the caller follows, most recently followed first
+func (s *ArticleServer) ListFollowing(ctx context.Context, req *pb.ListFollowingRequest) (*pb.ListFollowingResponse, error) {
+	if s.follows == nil {
+		code, message, details := fromStatus(errFollowsDisabled)
+		return response.ListFollowingError(code, message, details...), nil
+	}
+	userID, err := s.authenticate(ctx, "ListFollowing")
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.ListFollowingError(code, message, details...), nil
+	}
+	if err := validator.ValidateListFollowing(req); err != nil {
+		log.Printf("[ListFollowing] Invalid argument: %v", err)
+		message, details := validationFailure(err)
+		return response.ListFollowingError(codes.InvalidArgument, message, details...), nil
+	}
+
+	follows, next, err := s.followingPage(ctx, userID, req.PageSize, req.PageToken)
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.ListFollowingError(code, message, details...), nil
+	}
+	return response.ListFollowingSuccess(follows, next), nil
+}
+
+// GetFeed pages through the articles of the authors the caller follows, newest first, with
+// their authors
+func (s *ArticleServer) GetFeed(ctx context.Context, req *pb.GetFeedRequest) (*pb.GetFeedResponse, error) {
+	if s.follows == nil {
+		code, message, details := fromStatus(errFollowsDisabled)
+		return response.GetFeedError(code, message, details...), nil
+	}
+	userID, err := s.authenticate(ctx, "GetFeed")
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.GetFeedError(code, message, details...), nil
+	}
+	if err := validator.ValidateGetFeed(req); err != nil {
+		log.Printf("[GetFeed] Invalid argument: %v", err)
+		message, details := validationFailure(err)
+		return response.GetFeedError(codes.InvalidArgument, message, details...), nil
+	}
+
+	feed, next, err := s.feedPage(ctx, userID, req)
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.GetFeedError(code, message, details...), nil
+	}
+	return response.GetFeedSuccess(feed, next), nil
+}
@@ -0,0 +1,241 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+
+	"github.com/thatlq1812/service-2-article/internal/response"
+	"github.com/thatlq1812/service-2-article/internal/validator"
+	pb "github.com/thatlq1812/service-2-article/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// DefaultImportBatchSize is the number of rows inserted per transaction
+	DefaultImportBatchSize = 500
+	// maxImportErrors caps the rejected rows listed in an import report
+	maxImportErrors = 1000
+)
+
+// ImportOptions control one bulk import
+type ImportOptions struct {
+	Author    int32 // Author of rows without user_id
+	Admin     bool  // May set user_id and timestamps of rows
+	DryRun    bool  // Validate only
+	BatchSize int   // Rows per transaction; DefaultImportBatchSize when 0
+}
+
+// importRow is a validated row waiting in the current batch
+type importRow struct {
+	row    int32
+	source string
+}
+
+// articleImport holds the state of one running import
+type articleImport struct {
+	s       *ArticleServer
+	opts    ImportOptions
+	report  *pb.ImportArticlesResponse
+	authors map[int32]error // verifyAuthor results, so each author is checked once
+	batch   []*pb.Article
+	rows    []importRow
+}
+
+// Import reads rows from next until io.EOF, validating, rendering and inserting them in batches.
+// Invalid rows are listed in the report and skipped; a batch that fails to insert is retried in
+// halves down to single rows, so only the failing rows are reported. Errors that make the rest of the import pointless (cancellation, User Service down,
+// a failing next) stop it and are returned as status errors.
+func (s *ArticleServer) Import(ctx context.Context, opts ImportOptions, next func() (*pb.ImportedArticle, error)) (*pb.ImportArticlesResponse, error) {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = DefaultImportBatchSize
+	}
+	imp := &articleImport{
+		s:       s,
+		opts:    opts,
+		report:  &pb.ImportArticlesResponse{DryRun: opts.DryRun},
+		authors: make(map[int32]error),
+	}
+
+	for {
+		row, err := next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if err := imp.add(ctx, row); err != nil {
+			return nil, err
+		}
+	}
+	if err := imp.flush(ctx); err != nil {
+		return nil, err
+	}
+
+	log.Printf("[ImportArticles] Done: received=%d, imported=%d, failed=%d, dry_run=%t",
+		imp.report.Received, imp.report.Imported, imp.report.Failed, opts.DryRun)
+	return imp.report, nil
+}
+
+// add validates one row and queues it, flushing full batches
+func (imp *articleImport) add(ctx context.Context, row *pb.ImportedArticle) error {
+	imp.report.Received++
+	index := imp.report.Received
+
+	if !imp.opts.Admin && ((row.UserId != 0 && row.UserId != imp.opts.Author) || row.CreateTime != nil || row.UpdateTime != nil) {
+		imp.reject(index, row.Source, "only admins may set user_id, create_time or update_time")
+		return nil
+	}
+	if err := validator.ValidateImportedArticle(row); err != nil {
+		imp.reject(index, row.Source, err.Error())
+		return nil
+	}
+	userID := row.UserId
+	if userID == 0 {
+		userID = imp.opts.Author
+	}
+	if userID == 0 {
+		imp.reject(index, row.Source, "user_id is required")
+		return nil
+	}
+
+	authorErr, checked := imp.authors[userID]
+	if !checked {
+		authorErr = imp.s.verifyAuthor(ctx, "ImportArticles", userID)
+		if authorErr != nil && status.Code(authorErr) != codes.InvalidArgument {
+			// User Service is unavailable: every remaining row would fail the same way
+			return authorErr
+		}
+		imp.authors[userID] = authorErr
+	}
+	if authorErr != nil {
+		imp.reject(index, row.Source, status.Convert(authorErr).Message())
+		return nil
+	}
+
+	article := &pb.Article{
+		Title:         row.Title,
+		Content:       row.Content,
+		UserId:        userID,
+		ContentFormat: row.ContentFormat,
+		CreateTime:    row.CreateTime,
+		UpdateTime:    row.UpdateTime,
+	}
+	if err := prepareContent(article); err != nil {
+		imp.reject(index, row.Source, err.Error())
+		return nil
+	}
+
+	imp.batch = append(imp.batch, article)
+	imp.rows = append(imp.rows, importRow{row: index, source: row.Source})
+	if len(imp.batch) >= imp.opts.BatchSize {
+		return imp.flush(ctx)
+	}
+	return nil
+}
+
+// flush inserts the queued batch in one transaction (skipped for a dry run)
+func (imp *articleImport) flush(ctx context.Context) error {
+	if len(imp.batch) == 0 {
+		return nil
+	}
+	defer func() {
+		imp.batch = imp.batch[:0]
+		imp.rows = imp.rows[:0]
+	}()
+
+	if imp.opts.DryRun {
+		imp.report.Imported += int32(len(imp.batch))
+		return nil
+	}
+	return imp.insert(ctx, imp.batch, imp.rows)
+}
+
+// insert inserts articles in one transaction. A failing batch is split in halves and retried,
+// so only the rows that fail on their own are rejected.
+func (imp *articleImport) insert(ctx context.Context, articles []*pb.Article, rows []importRow) error {
+	err := imp.s.repo.Import(ctx, articles)
+	if err == nil {
+		imp.report.Imported += int32(len(articles))
+		return nil
+	}
+	if ctx.Err() != nil {
+		return status.FromContextError(ctx.Err()).Err()
+	}
+	if len(articles) == 1 {
+		log.Printf("[ImportArticles] Insert failed: row=%d, error=%v", rows[0].row, err)
+		imp.reject(rows[0].row, rows[0].source, fmt.Sprintf("insert failed: %v", err))
+		return nil
+	}
+
+	log.Printf("[ImportArticles] Batch insert failed, retrying in halves: rows=%d-%d, error=%v", rows[0].row, rows[len(rows)-1].row, err)
+	half := len(articles) / 2
+	if err := imp.insert(ctx, articles[:half], rows[:half]); err != nil {
+		return err
+	}
+	return imp.insert(ctx, articles[half:], rows[half:])
+}
+
+// reject records a failed row; the report lists at most maxImportErrors of them
+func (imp *articleImport) reject(row int32, source, message string) {
+	imp.report.Failed++
+	if len(imp.report.Errors) >= maxImportErrors {
+		imp.report.ErrorsTruncated = true
+		return
+	}
+	imp.report.Errors = append(imp.report.Errors, &pb.ImportError{Row: row, Source: source, Message: message})
+}
+
+// ImportArticles imports a client stream of articles authored by the caller.
+// Admins (ADMIN_USER_IDS) may also set each row's author and original timestamps.
+// Calls are rate limited per user like the write RPCs (RATE_LIMIT_RULES).
+func (s *ArticleServer) ImportArticles(stream pb.ArticleService_ImportArticlesServer) error {
+	ctx := stream.Context()
+	userID, err := s.authenticate(ctx, "ImportArticles")
+	if err != nil {
+		return err
+	}
+
+	// Options come with the first message
+	first, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return stream.SendAndClose(&pb.ImportArticlesResponse{})
+	}
+	if err != nil {
+		return err
+	}
+	opts := ImportOptions{Author: userID, Admin: s.admins[userID], DryRun: first.DryRun}
+	log.Printf("[ImportArticles] Started: user_id=%d, admin=%t, dry_run=%t", userID, opts.Admin, opts.DryRun)
+
+	pending := first
+	next := func() (*pb.ImportedArticle, error) {
+		for {
+			req := pending
+			pending = nil
+			if req == nil {
+				var err error
+				if req, err = stream.Recv(); err != nil {
+					return nil, err
+				}
+			}
+			// Messages without an article (e.g. options only) are skipped
+			if req.Article != nil {
+				return req.Article, nil
+			}
+		}
+	}
+
+	report, err := s.Import(ctx, opts, next)
+	if err != nil {
+		if _, ok := status.FromError(err); !ok {
+			err = response.StatusError(codes.Internal, "failed to import articles")
+		}
+		return err
+	}
+	return stream.SendAndClose(report)
+}
@@ -0,0 +1,194 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/thatlq1812/service-2-article/internal/repository"
+	"github.com/thatlq1812/service-2-article/internal/response"
+	"github.com/thatlq1812/service-2-article/internal/validator"
+	pb "github.com/thatlq1812/service-2-article/proto"
+
+	"google.golang.org/grpc/codes"
+)
+
+// EnableReactions serves the reaction RPCs from repo; without it they return Unimplemented
+func (s *ArticleServer) EnableReactions(repo repository.ReactionRepository) {
+	s.reactions = repo
+}
+
+// errReactionsDisabled is returned by the reaction RPCs when reactions are not enabled
+var errReactionsDisabled = response.StatusError(codes.Unimplemented, "reactions are not enabled on this server")
+
+// reactionSummary reads the reaction counters of an article and the reactions of viewerID
+// (none for anonymous callers)
+func (s *ArticleServer) reactionSummary(ctx context.Context, method string, articleID, viewerID int32) (*pb.ReactionSummary, error) {
+	article, err := s.repo.GetByID(ctx, articleID, "id", "reaction_count", "reaction_counts")
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, articleNotFound(articleID)
+		}
+		log.Printf("[%s] Database error: article_id=%d, error=%v", method, articleID, err)
+		return nil, response.StatusError(response.GRPCCodeFromError(err), "failed to get article")
+	}
+	summary := &pb.ReactionSummary{
+		ArticleId: articleID,
+		Total:     article.ReactionCount,
+		Counts:    article.ReactionCounts,
+	}
+	if viewerID != 0 {
+		if summary.Mine, err = s.reactions.UserReactions(ctx, articleID, viewerID); err != nil {
+			log.Printf("[%s] Database error: article_id=%d, user_id=%d, error=%v", method, articleID, viewerID, err)
+			return nil, response.StatusError(response.GRPCCodeFromError(err), "failed to get reactions")
+		}
+	}
+	return summary, nil
+}
+
+// addReaction adds a reaction by userID and returns the new summary and whether it was added
+func (s *ArticleServer) addReaction(ctx context.Context, userID int32, req *pb.AddReactionRequest) (*pb.ReactionSummary, bool, error) {
+	added, err := s.reactions.AddReaction(ctx, req.ArticleId, userID, req.Type)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, false, articleNotFound(req.ArticleId)
+		}
+		log.Printf("[AddReaction] Database error: article_id=%d, user_id=%d, error=%v", req.ArticleId, userID, err)
+		return nil, false, response.StatusError(response.GRPCCodeFromError(err), "failed to add reaction")
+	}
+	summary, err := s.reactionSummary(ctx, "AddReaction", req.ArticleId, userID)
+	if err != nil {
+		return nil, false, err
+	}
+	log.Printf("[AddReaction] Success: article_id=%d, user_id=%d, type=%s, added=%t", req.ArticleId, userID, req.Type, added)
+	return summary, added, nil
+}
+
+// removeReaction removes a reaction of userID and returns the new summary and whether it was removed
+func (s *ArticleServer) removeReaction(ctx context.Context, userID int32, req *pb.RemoveReactionRequest) (*pb.ReactionSummary, bool, error) {
+	removed, err := s.reactions.RemoveReaction(ctx, req.ArticleId, userID, req.Type)
+	if err != nil {
+		log.Printf("[RemoveReaction] Database error: article_id=%d, user_id=%d, error=%v", req.ArticleId, userID, err)
+		return nil, false, response.StatusError(response.GRPCCodeFromError(err), "failed to remove reaction")
+	}
+	// Also reports a missing article
+	summary, err := s.reactionSummary(ctx, "RemoveReaction", req.ArticleId, userID)
+	if err != nil {
+		return nil, false, err
+	}
+	log.Printf("[RemoveReaction] Success: article_id=%d, user_id=%d, type=%s, removed=%t", req.ArticleId, userID, req.Type, removed)
+	return summary, removed, nil
+}
+
+// listReactions returns one page of an article's reactions with their users, and the summary for viewerID
+func (s *ArticleServer) listReactions(ctx context.Context, viewerID int32, req *pb.ListReactionsRequest, limit, offset int32) ([]*pb.Reaction, int32, *pb.ReactionSummary, error) {
+	summary, err := s.reactionSummary(ctx, "ListReactions", req.ArticleId, viewerID)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	reactions, total, err := s.reactions.ListReactions(ctx, req.ArticleId, req.Type, limit, offset)
+	if err != nil {
+		log.Printf("[ListReactions] Database error: article_id=%d, error=%v", req.ArticleId, err)
+		return nil, 0, nil, response.StatusError(response.GRPCCodeFromError(err), "failed to list reactions")
+	}
+
+	// A user can react with several types, so ask User Service once per user
+	users := make(map[int32]*pb.User)
+	for _, reaction := range reactions {
+		user, ok := users[reaction.UserId]
+		if !ok {
+			user = s.fetchUser(ctx, "ListReactions", reaction.UserId)
+			users[reaction.UserId] = user
+		}
+		reaction.User = user
+	}
+	return reactions, total, summary, nil
+}
+
+// AddReaction reacts to an article as the authenticated user. Adding a reaction the caller
+// already has succeeds with changed=false.
+func (s *ArticleServer) AddReaction(ctx context.Context, req *pb.AddReactionRequest) (*pb.AddReactionResponse, error) {
+	if s.reactions == nil {
+		code, message, details := fromStatus(errReactionsDisabled)
+		return response.AddReactionError(code, message, details...), nil
+	}
+	userID, err := s.authenticate(ctx, "AddReaction")
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.AddReactionError(code, message, details...), nil
+	}
+	if err := validator.ValidateAddReaction(req); err != nil {
+		log.Printf("[AddReaction] Invalid argument: %v", err)
+		message, details := validationFailure(err)
+		return response.AddReactionError(codes.InvalidArgument, message, details...), nil
+	}
+
+	summary, added, err := s.addReaction(ctx, userID, req)
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.AddReactionError(code, message, details...), nil
+	}
+	return response.AddReactionSuccess(summary, added), nil
+}
+
+// RemoveReaction removes one of the caller's reactions. Removing a reaction the caller does
+// not have succeeds with changed=false.
+func (s *ArticleServer) RemoveReaction(ctx context.Context, req *pb.RemoveReactionRequest) (*pb.RemoveReactionResponse, error) {
+	if s.reactions == nil {
+		code, message, details := fromStatus(errReactionsDisabled)
+		return response.RemoveReactionError(code, message, details...), nil
+	}
+	userID, err := s.authenticate(ctx, "RemoveReaction")
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.RemoveReactionError(code, message, details...), nil
+	}
+	if err := validator.ValidateRemoveReaction(req); err != nil {
+		log.Printf("[RemoveReaction] Invalid argument: %v", err)
+		message, details := validationFailure(err)
+		return response.RemoveReactionError(codes.InvalidArgument, message, details...), nil
+	}
+
+	summary, removed, err := s.removeReaction(ctx, userID, req)
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.RemoveReactionError(code, message, details...), nil
+	}
+	return response.RemoveReactionSuccess(summary, removed), nil
+}
+
+// ListReactions returns a page of an article's reactions, newest first, with the counts per type.
+// Authentication is optional; it fills in the caller's own reactions.
+func (s *ArticleServer) ListReactions(ctx context.Context, req *pb.ListReactionsRequest) (*pb.ListReactionsResponse, error) {
+	if s.reactions == nil {
+		code, message, details := fromStatus(errReactionsDisabled)
+		return response.ListReactionsError(code, message, details...), nil
+	}
+	if err := validator.ValidateListReactions(req); err != nil {
+		log.Printf("[ListReactions] Invalid argument: %v", err)
+		message, details := validationFailure(err)
+		return response.ListReactionsError(codes.InvalidArgument, message, details...), nil
+	}
+	viewerID, err := s.viewer(ctx, "ListReactions")
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.ListReactionsError(code, message, details...), nil
+	}
+
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	pageNumber := req.PageNumber
+	if pageNumber < 1 {
+		pageNumber = 1
+	}
+
+	reactions, total, summary, err := s.listReactions(ctx, viewerID, req, pageSize, (pageNumber-1)*pageSize)
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.ListReactionsError(code, message, details...), nil
+	}
+	totalPages := (total + pageSize - 1) / pageSize
+	return response.ListReactionsSuccess(reactions, total, pageNumber, totalPages, summary), nil
+}
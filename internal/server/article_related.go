@@ -0,0 +1,73 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/thatlq1812/service-2-article/internal/repository"
+	"github.com/thatlq1812/service-2-article/internal/response"
+	"github.com/thatlq1812/service-2-article/internal/validator"
+	pb "github.com/thatlq1812/service-2-article/proto"
+
+	"google.golang.org/grpc/codes"
+)
+
+// defaultRelatedLimit is the number of related articles returned when limit is 0
+const defaultRelatedLimit = 5
+
+// getRelated loads the articles related to req.ArticleId, best first, with their authors.
+// The ranking comes from the repository (cached with the article); articles deleted since are skipped.
+func (s *ArticleServer) getRelated(ctx context.Context, req *pb.GetRelatedArticlesRequest) ([]*pb.ArticleWithUser, error) {
+	if _, err := s.repo.GetByID(ctx, req.ArticleId, "id"); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, articleNotFound(req.ArticleId)
+		}
+		log.Printf("[GetRelatedArticles] Database error: article_id=%d, error=%v", req.ArticleId, err)
+		return nil, response.StatusError(response.GRPCCodeFromError(err), "failed to get article")
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultRelatedLimit
+	}
+	ids, err := s.repo.RelatedIDs(ctx, req.ArticleId, limit)
+	if err != nil {
+		log.Printf("[GetRelatedArticles] Database error: article_id=%d, error=%v", req.ArticleId, err)
+		return nil, response.StatusError(response.GRPCCodeFromError(err), "failed to find related articles")
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	fields := readFields(req.ReadMask, req.View, pb.ArticleView_ARTICLE_VIEW_BASIC)
+	articles, err := s.repo.ListByIDs(ctx, ids, fields...)
+	if err != nil {
+		log.Printf("[GetRelatedArticles] Database error: article_ids=%v, error=%v", ids, err)
+		return nil, response.StatusError(response.GRPCCodeFromError(err), "failed to load related articles")
+	}
+
+	authors := s.fetchAuthors(ctx, "GetRelatedArticles", articles)
+	related := make([]*pb.ArticleWithUser, len(articles))
+	for i, article := range articles {
+		related[i] = &pb.ArticleWithUser{Article: article, User: authors[article.UserId]}
+	}
+	return related, nil
+}
+
+// GetRelatedArticles lists articles sharing the top terms of an article or written by its
+// author. No authentication is needed.
+func (s *ArticleServer) GetRelatedArticles(ctx context.Context, req *pb.GetRelatedArticlesRequest) (*pb.GetRelatedArticlesResponse, error) {
+	if err := validator.ValidateGetRelatedArticles(req); err != nil {
+		log.Printf("[GetRelatedArticles] Invalid argument: %v", err)
+		message, details := validationFailure(err)
+		return response.GetRelatedArticlesError(codes.InvalidArgument, message, details...), nil
+	}
+
+	related, err := s.getRelated(ctx, req)
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.GetRelatedArticlesError(code, message, details...), nil
+	}
+	return response.GetRelatedArticlesSuccess(related), nil
+}
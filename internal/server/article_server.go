@@ -2,26 +2,28 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	userpb "github.com/thatlq1812/service-1-user/proto"
 	"github.com/thatlq1812/service-2-article/internal/auth"
-	"github.com/thatlq1812/service-2-article/internal/client"
+	"github.com/thatlq1812/service-2-article/internal/render"
 	"github.com/thatlq1812/service-2-article/internal/repository"
 	"github.com/thatlq1812/service-2-article/internal/response"
+	"github.com/thatlq1812/service-2-article/internal/validator"
 	pb "github.com/thatlq1812/service-2-article/proto"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-const (
-	defaultPageSize = 10
-	maxPageSize     = 100
-	errNoRows       = "no rows"
-)
+const defaultPageSize = 10
 
 // convertUser converts User Service User to Article Service User proto type
 func convertUser(userServiceUser *userpb.User) *pb.User {
@@ -29,82 +31,184 @@ func convertUser(userServiceUser *userpb.User) *pb.User {
 		return nil
 	}
 	return &pb.User{
-		Id:        userServiceUser.Id,
-		Name:      userServiceUser.Name,
-		Email:     userServiceUser.Email,
-		CreatedAt: userServiceUser.CreatedAt,
-		UpdatedAt: userServiceUser.UpdatedAt,
+		Id:         userServiceUser.Id,
+		Name:       userServiceUser.Name,
+		Email:      userServiceUser.Email,
+		CreatedAt:  userServiceUser.CreatedAt,
+		UpdatedAt:  userServiceUser.UpdatedAt,
+		CreateTime: parseTimestamp(userServiceUser.CreatedAt),
+		UpdateTime: parseTimestamp(userServiceUser.UpdatedAt),
+	}
+}
+
+// parseTimestamp converts an RFC3339 string from User Service, or returns nil if it is not one
+func parseTimestamp(value string) *timestamppb.Timestamp {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// UserGetter fetches authors from User Service (implemented by client.UserClient)
+type UserGetter interface {
+	GetUser(ctx context.Context, userID int32) (*userpb.User, error)
+	GetUserWithRetry(ctx context.Context, userID int32) (*userpb.User, error)
+}
+
+// validationFailure converts validator output into an envelope message and error details
+func validationFailure(err error) (string, []proto.Message) {
+	var violations validator.Violations
+	if !errors.As(err, &violations) {
+		return err.Error(), nil
+	}
+	return violations.Error(), []proto.Message{
+		response.ErrorInfo(response.ReasonValidationFailed, nil),
+		response.BadRequest(violations...),
 	}
 }
 
+// prepareContent sanitises article.Content for storage and fills the fields derived from it
+// (content_html, excerpt, word count, reading time).
+// Returns Violations when sanitisation removed everything from non-empty content.
+func prepareContent(article *pb.Article) error {
+	rendered, err := render.Prepare(article.ContentFormat, article.Content)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(rendered.Content) == "" && strings.TrimSpace(article.Content) != "" {
+		return validator.Violations{{Field: "content", Description: "must not be empty after HTML sanitisation"}}
+	}
+
+	summary := render.Summarize(rendered.Text)
+	article.Content = rendered.Content
+	article.ContentHtml = rendered.HTML
+	article.Excerpt = summary.Excerpt
+	article.WordCount = int32(summary.WordCount)
+	article.ReadingTimeMinutes = int32(summary.ReadingMinutes)
+	return nil
+}
+
+// basicViewFields are the Article fields of ARTICLE_VIEW_BASIC: everything but the full content
+var basicViewFields = func() []string {
+	var fields []string
+	descriptors := (&pb.Article{}).ProtoReflect().Descriptor().Fields()
+	for i := 0; i < descriptors.Len(); i++ {
+		if name := string(descriptors.Get(i).Name()); name != "content" && name != "content_html" {
+			fields = append(fields, name)
+		}
+	}
+	return fields
+}()
+
+// readFields returns the Article fields a read loads: the read mask when set, otherwise
+// the fields of view (UNSPECIFIED falls back to defaultView). nil loads every field.
+func readFields(mask *fieldmaskpb.FieldMask, view, defaultView pb.ArticleView) []string {
+	if paths := mask.GetPaths(); len(paths) > 0 {
+		return paths
+	}
+	if view == pb.ArticleView_ARTICLE_VIEW_UNSPECIFIED {
+		view = defaultView
+	}
+	if view == pb.ArticleView_ARTICLE_VIEW_BASIC {
+		return basicViewFields
+	}
+	return nil
+}
+
 type ArticleServer struct {
 	pb.UnimplementedArticleServiceServer
 	repo       repository.ArticleRepository
-	userClient *client.UserClient
+	userClient UserGetter
 	redis      auth.TokenBlacklistChecker
 	jwtSecret  string
+	admins     map[int32]bool // may import on behalf of other authors and moderate any comment
+
+	// ExportArticles callers besides admins, and the longest an export may run
+	analysts      map[int32]bool
+	exportTimeout time.Duration
+
+	// Change feed for WatchArticles; nil when disabled
+	events          ArticleEvents
+	eventsRetention time.Duration
+
+	// Webhook subscriptions and deliveries; nil when disabled
+	webhooks repository.WebhookRepository
+
+	// Comments on articles; nil when disabled
+	comments repository.CommentRepository
+
+	// Reactions to articles; nil when disabled
+	reactions repository.ReactionRepository
+
+	// Bookmarks and collections; nil when disabled
+	bookmarks repository.BookmarkRepository
+
+	// View counting and trending articles; nil when disabled
+	viewRecorder ViewRecorder
+	views        repository.ViewRepository
+
+	// Followed authors and their feed; nil when disabled
+	follows repository.FollowRepository
 }
 
-func NewArticleServer(repo repository.ArticleRepository, userClient *client.UserClient, redis auth.TokenBlacklistChecker, jwtSecret string) *ArticleServer {
+func NewArticleServer(repo repository.ArticleRepository, userClient UserGetter, redis auth.TokenBlacklistChecker, jwtSecret string, adminIDs []int32) *ArticleServer {
+	admins := make(map[int32]bool, len(adminIDs))
+	for _, id := range adminIDs {
+		admins[id] = true
+	}
 	return &ArticleServer{
 		repo:       repo,
 		userClient: userClient,
 		redis:      redis,
 		jwtSecret:  jwtSecret,
+		admins:     admins,
+
+		analysts:      make(map[int32]bool),
+		exportTimeout: defaultExportTimeout,
 	}
 }
 
-func (s *ArticleServer) CreateArticle(ctx context.Context, req *pb.CreateArticleRequest) (*pb.CreateArticleResponse, error) {
-	// Validate authentication (JWT + Redis blacklist check)
-	userID, err := auth.GetUserIDFromContextWithBlacklist(ctx, s.jwtSecret, s.redis)
-	if err != nil {
-		if err == auth.ErrTokenBlacklisted {
-			log.Printf("[CreateArticle] Token has been revoked (logged out)")
-			return response.CreateArticleError(codes.Unauthenticated, "token has been revoked"), nil
-		}
-		log.Printf("[CreateArticle] Authentication failed: %v", err)
-		return response.CreateArticleError(codes.Unauthenticated, "authentication required"), nil
-	}
+// defaultExportTimeout caps an export until ConfigureExport sets another limit
+const defaultExportTimeout = 30 * time.Minute
 
-	// Validate input
-	if req.Title == "" {
-		log.Printf("[CreateArticle] Invalid argument: title is empty")
-		return response.CreateArticleError(codes.InvalidArgument, "title is required"), nil
-	}
-	if req.Content == "" {
-		log.Printf("[CreateArticle] Invalid argument: content is empty")
-		return response.CreateArticleError(codes.InvalidArgument, "content is required"), nil
+// ConfigureExport lets analystIDs export articles besides admins, and stops exports that run
+// longer than timeout (0 for no limit). An export holds a transaction and a database
+// connection until the client has read the last article.
+func (s *ArticleServer) ConfigureExport(analystIDs []int32, timeout time.Duration) {
+	s.analysts = make(map[int32]bool, len(analystIDs))
+	for _, id := range analystIDs {
+		s.analysts[id] = true
 	}
+	s.exportTimeout = timeout
+}
 
-	// Verify user exists by calling User Service
-	log.Printf("[CreateArticle] Verifying user exists: user_id=%d", userID)
-	_, err = s.userClient.GetUser(ctx, int32(userID))
+// CreateArticle creates an article authored by the authenticated user
+func (s *ArticleServer) CreateArticle(ctx context.Context, req *pb.CreateArticleRequest) (*pb.CreateArticleResponse, error) {
+	// Validate authentication (JWT + Redis blacklist check)
+	userID, err := s.authenticate(ctx, "CreateArticle")
 	if err != nil {
-		st := status.Convert(err)
-		switch st.Code() {
-		case codes.NotFound:
-			log.Printf("[CreateArticle] User not found: user_id=%d", userID)
-			return response.CreateArticleError(codes.InvalidArgument, fmt.Sprintf("user with ID %d not found", userID)), nil
-		case codes.Unavailable:
-			log.Printf("[CreateArticle] User service unavailable: user_id=%d", userID)
-			return response.CreateArticleError(codes.Unavailable, "user service is currently unavailable, please try again later"), nil
-		case codes.DeadlineExceeded:
-			log.Printf("[CreateArticle] User service timeout: user_id=%d", userID)
-			return response.CreateArticleError(codes.DeadlineExceeded, "request timeout while verifying user"), nil
-		default:
-			log.Printf("[CreateArticle] Failed to verify user: user_id=%d, error=%v", userID, err)
-			return response.CreateArticleError(codes.Internal, "failed to verify user"), nil
-		}
+		code, message, details := fromStatus(err)
+		return response.CreateArticleError(code, message, details...), nil
 	}
 
-	// Create article in database with authenticated user ID
-	article, err := s.repo.Create(ctx, req.Title, req.Content, int32(userID))
+	// Validate input
+	if err := validator.ValidateCreateArticle(req); err != nil {
+		log.Printf("[CreateArticle] Invalid argument: %v", err)
+		message, details := validationFailure(err)
+		return response.CreateArticleError(codes.InvalidArgument, message, details...), nil
+	}
+
+	article, err := s.createArticle(ctx, &pb.Article{
+		Title:         req.Title,
+		Content:       req.Content,
+		UserId:        userID,
+		ContentFormat: req.ContentFormat,
+	})
 	if err != nil {
-		log.Printf("[CreateArticle] Database error: user_id=%d, error=%v", userID, err)
-		return response.CreateArticleError(codes.Internal, "failed to create article"), nil
+		code, message, details := fromStatus(err)
+		return response.CreateArticleError(code, message, details...), nil
 	}
-
-	log.Printf("[CreateArticle] Success: article_id=%d, user_id=%d", article.Id, userID)
 	return response.CreateArticleSuccess(article), nil
 }
 
@@ -113,9 +217,10 @@ func (s *ArticleServer) CreateArticle(ctx context.Context, req *pb.CreateArticle
 // Implements graceful degradation: returns article even if user info is unavailable
 func (s *ArticleServer) GetArticle(ctx context.Context, req *pb.GetArticleRequest) (*pb.GetArticleResponse, error) {
 	// Validate input
-	if req.Id <= 0 {
-		log.Printf("[GetArticle] Invalid argument: article_id=%d", req.Id)
-		return response.GetArticleError(codes.InvalidArgument, "article ID must be positive"), nil
+	if err := validator.ValidateGetArticle(req); err != nil {
+		log.Printf("[GetArticle] Invalid argument: %v", err)
+		message, details := validationFailure(err)
+		return response.GetArticleError(codes.InvalidArgument, message, details...), nil
 	}
 
 	// Get article with user
@@ -123,8 +228,8 @@ func (s *ArticleServer) GetArticle(ctx context.Context, req *pb.GetArticleReques
 	if err != nil {
 		// GetArticleWithUser returns error only for article retrieval failures
 		// User Service failures are handled gracefully with nil user
-		st := status.Convert(err)
-		return response.GetArticleError(st.Code(), st.Message()), nil
+		code, message, details := fromStatus(err)
+		return response.GetArticleError(code, message, details...), nil
 	}
 
 	// Check if user info is missing (graceful degradation scenario)
@@ -142,61 +247,17 @@ func (s *ArticleServer) GetArticle(ctx context.Context, req *pb.GetArticleReques
 // If the user is not found or deleted, returns the article with a nil user
 func (s *ArticleServer) GetArticleWithUser(ctx context.Context, req *pb.GetArticleRequest) (*pb.ArticleWithUser, error) {
 	// Validate input
-	if req.Id <= 0 {
-		log.Printf("[GetArticleWithUser] Invalid argument: article_id=%d", req.Id)
-		return nil, response.GRPCError(codes.InvalidArgument, "Article ID must be positive. Provide a valid ID greater than 0.")
+	if err := validator.ValidateGetArticle(req); err != nil {
+		log.Printf("[GetArticleWithUser] Invalid argument: %v", err)
+		return nil, invalidArgument(err)
 	}
 
-	// 1. Retrieve article from database
-	article, err := s.repo.GetByID(ctx, req.Id)
+	articleWithUser, err := s.getArticle(ctx, req.Id, readFields(req.ReadMask, req.View, pb.ArticleView_ARTICLE_VIEW_FULL))
 	if err != nil {
-		if strings.Contains(err.Error(), errNoRows) {
-			log.Printf("[GetArticleWithUser] Article not found: article_id=%d", req.Id)
-			return nil, response.GRPCError(codes.NotFound, "Article not found. Verify the article ID exists.")
-		}
-		log.Printf("[GetArticleWithUser] Database error: article_id=%d, error=%v", req.Id, err)
-		return nil, response.GRPCError(codes.Internal, "Failed to get article. Contact support if the issue persists.")
+		return nil, err
 	}
-
-	// 2. Fetch user information from User Service (inter-service communication)
-	// Implements graceful degradation: returns article even if user fetch fails
-	log.Printf("[GetArticleWithUser] Fetching user info: article_id=%d, user_id=%d", article.Id, article.UserId)
-	userServiceUser, err := s.userClient.GetUser(ctx, article.UserId)
-	if err != nil {
-		st := status.Convert(err)
-		switch st.Code() {
-		case codes.NotFound:
-			// User deleted or doesn't exist - this is expected, return article without user
-			log.Printf("[GetArticleWithUser] WARN: User not found (graceful degradation): article_id=%d, user_id=%d", article.Id, article.UserId)
-			return &pb.ArticleWithUser{
-				Article: article,
-				User:    nil,
-			}, nil
-		case codes.Unavailable, codes.DeadlineExceeded:
-			// User Service down or timeout - return article without user to maintain availability
-			log.Printf("[GetArticleWithUser] WARN: User Service unavailable (graceful degradation): article_id=%d, user_id=%d, code=%s, error=%v",
-				article.Id, article.UserId, st.Code(), st.Message())
-			return &pb.ArticleWithUser{
-				Article: article,
-				User:    nil,
-			}, nil
-		default:
-			// Unexpected error - log as ERROR and still apply graceful degradation
-			log.Printf("[GetArticleWithUser] ERROR: User Service unexpected error (graceful degradation): article_id=%d, user_id=%d, code=%s, error=%v",
-				article.Id, article.UserId, st.Code(), err)
-			return &pb.ArticleWithUser{
-				Article: article,
-				User:    nil,
-			}, nil
-		}
-	}
-
-	// 3. Convert and return combined article and user data
-	log.Printf("[GetArticleWithUser] Success: article_id=%d, user_id=%d, user_email=%s", article.Id, userServiceUser.Id, userServiceUser.Email)
-	return &pb.ArticleWithUser{
-		Article: article,
-		User:    convertUser(userServiceUser),
-	}, nil
+	s.recordView(ctx, req.Id)
+	return articleWithUser, nil
 }
 
 // CreateArticleOld creates a new article after verifying the user exists (DEPRECATED - use CreateArticle with auth)
@@ -236,8 +297,20 @@ func (s *ArticleServer) CreateArticleOld(ctx context.Context, req *pb.CreateArti
 		}
 	}
 
+	// Sanitise and render content before it is stored
+	input := &pb.Article{
+		Title:         req.Title,
+		Content:       req.Content,
+		UserId:        req.UserId,
+		ContentFormat: req.ContentFormat,
+	}
+	if err := prepareContent(input); err != nil {
+		log.Printf("[CreateArticle] Invalid content: user_id=%d, error=%v", req.UserId, err)
+		return nil, response.GRPCError(codes.InvalidArgument, fmt.Sprintf("Invalid content: %v.", err))
+	}
+
 	// Create article in database
-	article, err := s.repo.Create(ctx, req.Title, req.Content, req.UserId)
+	article, err := s.repo.Create(ctx, input)
 	if err != nil {
 		log.Printf("[CreateArticle] Database error: user_id=%d, error=%v", req.UserId, err)
 		return nil, response.GRPCError(codes.Internal, fmt.Sprintf("Failed to create article: %v. Contact support if the issue persists.", err))
@@ -247,80 +320,56 @@ func (s *ArticleServer) CreateArticleOld(ctx context.Context, req *pb.CreateArti
 	return article, nil
 }
 
-// UpdateArticle updates an article's title and/or content
+// UpdateArticle updates an article's title, content and/or content format
 // Partial updates are supported - omitted fields retain their existing values
 func (s *ArticleServer) UpdateArticle(ctx context.Context, req *pb.UpdateArticleRequest) (*pb.UpdateArticleResponse, error) {
 	// Validate input
-	if req.Id <= 0 {
-		return response.UpdateArticleError(codes.InvalidArgument, "article ID must be positive"), nil
-	}
-	if req.Title == "" && req.Content == "" {
-		return response.UpdateArticleError(codes.InvalidArgument, "at least title or content must be provided"), nil
-	}
-
-	// Check if article exists and get current values
-	existing, err := s.repo.GetByID(ctx, req.Id)
-	if err != nil {
-		if strings.Contains(err.Error(), errNoRows) {
-			return response.UpdateArticleError(codes.NotFound, fmt.Sprintf("article with ID %d not found", req.Id)), nil
-		}
-		return response.UpdateArticleError(codes.Internal, "failed to check article"), nil
-	}
-
-	// Use existing values for omitted fields
-	title := req.Title
-	if title == "" {
-		title = existing.Title
-	}
-	content := req.Content
-	if content == "" {
-		content = existing.Content
+	if err := validator.ValidateUpdateArticle(req); err != nil {
+		log.Printf("[UpdateArticle] Invalid argument: %v", err)
+		message, details := validationFailure(err)
+		return response.UpdateArticleError(codes.InvalidArgument, message, details...), nil
 	}
 
-	// Update article
-	article, err := s.repo.Update(ctx, req.Id, title, content)
+	article, err := s.updateArticle(ctx, req)
 	if err != nil {
-		return response.UpdateArticleError(codes.Internal, "failed to update article"), nil
+		code, message, details := fromStatus(err)
+		return response.UpdateArticleError(code, message, details...), nil
 	}
-
 	return response.UpdateArticleSuccess(article), nil
 }
 
-// DeleteArticle deletes an article and returns the deleted article data
+// DeleteArticle deletes an article
 func (s *ArticleServer) DeleteArticle(ctx context.Context, req *pb.DeleteArticleRequest) (*pb.DeleteArticleResponse, error) {
 	// Validate input
-	if req.Id <= 0 {
-		return response.DeleteArticleError(codes.InvalidArgument, "article ID must be positive"), nil
+	if err := validator.ValidateDeleteArticle(req); err != nil {
+		log.Printf("[DeleteArticle] Invalid argument: %v", err)
+		message, details := validationFailure(err)
+		return response.DeleteArticleError(codes.InvalidArgument, message, details...), nil
 	}
 
-	// Check if article exists before deletion
-	_, err := s.repo.GetByID(ctx, req.Id)
-	if err != nil {
-		if strings.Contains(err.Error(), errNoRows) {
-			return response.DeleteArticleError(codes.NotFound, "article not found"), nil
-		}
-		return response.DeleteArticleError(codes.Internal, "failed to check article"), nil
+	if err := s.deleteArticle(ctx, req.Id); err != nil {
+		code, message, details := fromStatus(err)
+		return response.DeleteArticleError(code, message, details...), nil
 	}
-
-	// Delete article from database
-	err = s.repo.Delete(ctx, req.Id)
-	if err != nil {
-		return response.DeleteArticleError(codes.Internal, "failed to delete article"), nil
-	}
-
 	return response.DeleteArticleSuccess(), nil
 }
 
 // ListArticles retrieves a paginated list of articles with user information
-// Supports filtering by user ID. Fetches user data for each article via inter-service communication.
+// Supports filtering by user ID and ordering by popularity. Fetches user data for each article via inter-service communication.
 func (s *ArticleServer) ListArticles(ctx context.Context, req *pb.ListArticlesRequest) (*pb.ListArticlesResponse, error) {
 	// Validate and normalize pagination parameters
+	if err := validator.ValidateListArticles(req); err != nil {
+		log.Printf("[ListArticles] Invalid argument: %v", err)
+		message, details := validationFailure(err)
+		return response.ListArticlesError(codes.InvalidArgument, message, details...), nil
+	}
+
 	pageSize := req.PageSize
 	if pageSize <= 0 {
 		pageSize = defaultPageSize
 	}
-	if pageSize > maxPageSize {
-		pageSize = maxPageSize
+	if pageSize > validator.MaxPageSize {
+		pageSize = validator.MaxPageSize
 	}
 
 	pageNumber := req.PageNumber
@@ -331,56 +380,14 @@ func (s *ArticleServer) ListArticles(ctx context.Context, req *pb.ListArticlesRe
 	// Calculate offset for pagination (page starts from 1)
 	offset := (pageNumber - 1) * pageSize
 
-	// Retrieve articles based on filter
-	var articles []*pb.Article
-	var total int32
-	var err error
-
-	if req.UserId > 0 {
-		// Filter by specific user
-		articles, total, err = s.repo.ListByUser(ctx, req.UserId, pageSize, offset)
-	} else {
-		// List all articles
-		articles, total, err = s.repo.ListAll(ctx, pageSize, offset)
-	}
+	// List screens only need the summary fields unless FULL or a read mask is requested
+	// v1 lists returned whole articles before views existed, so FULL stays the default here
+	fields := readFields(req.ReadMask, req.View, pb.ArticleView_ARTICLE_VIEW_FULL)
 
+	articlesWithUser, total, err := s.listArticles(ctx, req.UserId, req.OrderBy, pageSize, offset, fields)
 	if err != nil {
-		return response.ListArticlesError(codes.Internal, "failed to list articles"), nil
-	}
-
-	// Enrich articles with user information from User Service
-	// Implements graceful degradation: includes articles even if user info fetch fails
-	log.Printf("[ListArticles] Fetching user info for %d articles", len(articles))
-	articlesWithUser := make([]*pb.ArticleWithUser, 0, len(articles))
-	failedUserFetches := 0
-
-	for _, article := range articles {
-		userServiceUser, err := s.userClient.GetUser(ctx, article.UserId)
-		if err != nil {
-			// If user not found or service unavailable, include article with nil user (graceful degradation)
-			st := status.Convert(err)
-			switch st.Code() {
-			case codes.NotFound:
-				log.Printf("[ListArticles] WARN: User not found (graceful degradation): article_id=%d, user_id=%d", article.Id, article.UserId)
-			case codes.Unavailable, codes.DeadlineExceeded:
-				log.Printf("[ListArticles] WARN: User Service unavailable (graceful degradation): article_id=%d, user_id=%d, code=%s",
-					article.Id, article.UserId, st.Code())
-			default:
-				log.Printf("[ListArticles] ERROR: User Service error (graceful degradation): article_id=%d, user_id=%d, error=%v",
-					article.Id, article.UserId, err)
-			}
-			userServiceUser = nil
-			failedUserFetches++
-		}
-		articlesWithUser = append(articlesWithUser, &pb.ArticleWithUser{
-			Article: article,
-			User:    convertUser(userServiceUser),
-		})
-	}
-
-	if failedUserFetches > 0 {
-		log.Printf("[ListArticles] WARN: %d/%d articles returned without author info due to User Service issues",
-			failedUserFetches, len(articles))
+		code, message, details := fromStatus(err)
+		return response.ListArticlesError(code, message, details...), nil
 	}
 
 	log.Printf("[ListArticles] Success: returned=%d, total=%d, page=%d", len(articlesWithUser), total, pageNumber)
@@ -390,3 +397,41 @@ func (s *ArticleServer) ListArticles(ctx context.Context, req *pb.ListArticlesRe
 
 	return response.ListArticlesSuccess(articlesWithUser, total, pageNumber, totalPages), nil
 }
+
+// ExportArticles streams every article matching the filters, in ID order, from one consistent snapshot.
+// Articles are read from the database only as fast as the client receives them (gRPC flow control).
+// Only admins and analysts may export, for at most the export timeout.
+func (s *ArticleServer) ExportArticles(req *pb.ExportArticlesRequest, stream pb.ArticleService_ExportArticlesServer) error {
+	ctx := stream.Context()
+	userID, err := s.authenticate(ctx, "ExportArticles")
+	if err != nil {
+		return err
+	}
+	if !s.admins[userID] && !s.analysts[userID] {
+		denied := fmt.Errorf("user %d is neither an admin nor an analyst: %w", userID, repository.ErrForbidden)
+		log.Printf("[ExportArticles] Permission denied: %v", denied)
+		return response.StatusError(response.GRPCCodeFromError(denied), "only admins and analysts may export articles")
+	}
+
+	// Validate input
+	if err := validator.ValidateExportArticles(req); err != nil {
+		log.Printf("[ExportArticles] Invalid argument: %v", err)
+		return invalidArgument(err)
+	}
+
+	filter := repository.ExportFilter{UserID: req.UserId}
+	if req.CreatedAfter != nil {
+		filter.CreatedAfter = req.CreatedAfter.AsTime()
+	}
+	if req.CreatedBefore != nil {
+		filter.CreatedBefore = req.CreatedBefore.AsTime()
+	}
+	fields := readFields(req.ReadMask, req.View, pb.ArticleView_ARTICLE_VIEW_FULL)
+
+	if s.exportTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.exportTimeout)
+		defer cancel()
+	}
+	return s.exportArticles(ctx, filter, fields, req.IncludeAuthor, stream.Send)
+}
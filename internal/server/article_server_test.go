@@ -0,0 +1,1304 @@
+package server_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	userpb "github.com/thatlq1812/service-1-user/proto"
+	"github.com/thatlq1812/service-2-article/internal/auth"
+	"github.com/thatlq1812/service-2-article/internal/repository"
+	"github.com/thatlq1812/service-2-article/internal/response"
+	"github.com/thatlq1812/service-2-article/internal/server"
+	"github.com/thatlq1812/service-2-article/internal/validator"
+	"github.com/thatlq1812/service-2-article/internal/views"
+	pb "github.com/thatlq1812/service-2-article/proto"
+	pbv2 "github.com/thatlq1812/service-2-article/proto/v2"
+)
+
+const testJWTSecret = "test-secret"
+
+// fakeUsers stands in for User Service
+type fakeUsers struct {
+	users map[int32]*userpb.User
+}
+
+func (f *fakeUsers) GetUser(_ context.Context, userID int32) (*userpb.User, error) {
+	user, ok := f.users[userID]
+	if !ok {
+		return nil, response.GRPCError(codes.NotFound, "User not found.")
+	}
+	return user, nil
+}
+
+func (f *fakeUsers) GetUserWithRetry(ctx context.Context, userID int32) (*userpb.User, error) {
+	return f.GetUser(ctx, userID)
+}
+
+// noBlacklist reports every token as valid
+type noBlacklist struct{}
+
+func (noBlacklist) IsTokenBlacklisted(context.Context, string) (bool, error) {
+	return false, nil
+}
+
+func newTestServer() *server.ArticleServer {
+	return newTestServerWithRepo(repository.NewArticleMemoryRepository())
+}
+
+// newTestServerWithRepo serves repo, with users 1 (Alice) and 2 (Bob, an admin)
+func newTestServerWithRepo(repo repository.ArticleRepository) *server.ArticleServer {
+	users := &fakeUsers{users: map[int32]*userpb.User{
+		1: {Id: 1, Name: "Alice", Email: "alice@example.com"},
+		2: {Id: 2, Name: "Bob", Email: "bob@example.com"},
+	}}
+	return server.NewArticleServer(repo, users, noBlacklist{}, testJWTSecret, []int32{2})
+}
+
+// authContext returns an incoming context carrying a valid JWT for userID
+func authContext(t *testing.T, userID uint64) context.Context {
+	t.Helper()
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, auth.Claims{
+		UserID: userID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}).SignedString([]byte(testJWTSecret))
+	if err != nil {
+		t.Fatalf("Failed to sign token: %v", err)
+	}
+
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func TestArticleServerLifecycle(t *testing.T) {
+	s := newTestServer()
+	ctx := authContext(t, 1)
+
+	created, err := s.CreateArticle(ctx, &pb.CreateArticleRequest{Title: "Hello", Content: "World"})
+	if err != nil || created.Code != response.CodeSuccess {
+		t.Fatalf("CreateArticle = %v, %v; want success", created, err)
+	}
+	article := created.Data.Article
+	if article.UserId != 1 {
+		t.Errorf("CreateArticle user_id = %d, want 1 (from JWT)", article.UserId)
+	}
+
+	got, err := s.GetArticle(ctx, &pb.GetArticleRequest{Id: article.Id})
+	if err != nil || got.Code != response.CodeSuccess {
+		t.Fatalf("GetArticle = %v, %v; want success", got, err)
+	}
+	if got.Data.Article.User.GetName() != "Alice" {
+		t.Errorf("GetArticle author = %v, want Alice", got.Data.Article.User)
+	}
+
+	updated, err := s.UpdateArticle(ctx, &pb.UpdateArticleRequest{Id: article.Id, Title: "Hello again"})
+	if err != nil || updated.Code != response.CodeSuccess {
+		t.Fatalf("UpdateArticle = %v, %v; want success", updated, err)
+	}
+	if updated.Data.Article.Title != "Hello again" || updated.Data.Article.Content != "World" {
+		t.Errorf("UpdateArticle = %+v, want new title and unchanged content", updated.Data.Article)
+	}
+
+	listed, err := s.ListArticles(ctx, &pb.ListArticlesRequest{UserId: 1})
+	if err != nil || listed.Code != response.CodeSuccess {
+		t.Fatalf("ListArticles = %v, %v; want success", listed, err)
+	}
+	if listed.Data.Total != 1 || len(listed.Data.Articles) != 1 {
+		t.Errorf("ListArticles total = %d, len = %d; want 1, 1", listed.Data.Total, len(listed.Data.Articles))
+	}
+
+	deleted, err := s.DeleteArticle(ctx, &pb.DeleteArticleRequest{Id: article.Id})
+	if err != nil || deleted.Code != response.CodeSuccess {
+		t.Fatalf("DeleteArticle = %v, %v; want success", deleted, err)
+	}
+
+	missing, err := s.GetArticle(ctx, &pb.GetArticleRequest{Id: article.Id})
+	if err != nil {
+		t.Fatalf("GetArticle after delete returned error: %v", err)
+	}
+	if missing.Code != response.CodeNotFound {
+		t.Errorf("GetArticle after delete code = %s, want %s", missing.Code, response.CodeNotFound)
+	}
+}
+
+func TestCreateArticleRequiresAuthentication(t *testing.T) {
+	s := newTestServer()
+
+	resp, err := s.CreateArticle(context.Background(), &pb.CreateArticleRequest{Title: "Hello", Content: "World"})
+	if err != nil {
+		t.Fatalf("CreateArticle returned error: %v", err)
+	}
+	if resp.Code != response.CodeUnauthenticated {
+		t.Errorf("CreateArticle code = %s, want %s", resp.Code, response.CodeUnauthenticated)
+	}
+}
+
+func TestCreateArticleValidation(t *testing.T) {
+	s := newTestServer()
+	ctx := authContext(t, 1)
+
+	tests := []struct {
+		name      string
+		req       *pb.CreateArticleRequest
+		wantField string
+	}{
+		{name: "missing title", req: &pb.CreateArticleRequest{Content: "World"}, wantField: "title"},
+		{name: "missing content", req: &pb.CreateArticleRequest{Title: "Hello"}, wantField: "content"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := s.CreateArticle(ctx, tt.req)
+			if err != nil {
+				t.Fatalf("CreateArticle returned error: %v", err)
+			}
+			if resp.Code != response.CodeInvalidRequest {
+				t.Errorf("CreateArticle code = %s, want %s", resp.Code, response.CodeInvalidRequest)
+			}
+			if got := violatedFields(t, resp.Details); !slices.Contains(got, tt.wantField) {
+				t.Errorf("CreateArticle field violations = %v, want %q", got, tt.wantField)
+			}
+		})
+	}
+}
+
+// violatedFields unpacks google.rpc.BadRequest details and returns the violated fields
+func violatedFields(t *testing.T, details []*anypb.Any) []string {
+	t.Helper()
+
+	var fields []string
+	for _, d := range details {
+		var badRequest errdetails.BadRequest
+		if !d.MessageIs(&badRequest) {
+			continue
+		}
+		if err := d.UnmarshalTo(&badRequest); err != nil {
+			t.Fatalf("Failed to unpack BadRequest: %v", err)
+		}
+		for _, v := range badRequest.FieldViolations {
+			fields = append(fields, v.Field)
+		}
+	}
+	return fields
+}
+
+func TestGetArticleWithoutAuthor(t *testing.T) {
+	repo := repository.NewArticleMemoryRepository()
+	article, err := repo.Create(context.Background(), &pb.Article{Title: "Hello", Content: "World", UserId: 3})
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	// Author 3 does not exist in User Service; the article must still be returned
+	s := server.NewArticleServer(repo, &fakeUsers{}, noBlacklist{}, testJWTSecret, nil)
+	resp, err := s.GetArticle(context.Background(), &pb.GetArticleRequest{Id: article.Id})
+	if err != nil || resp.Code != response.CodeSuccess {
+		t.Fatalf("GetArticle = %v, %v; want success", resp, err)
+	}
+	if resp.Data.Article.User != nil {
+		t.Errorf("GetArticle author = %v, want nil", resp.Data.Article.User)
+	}
+	if resp.Data.Article.Article.Id != article.Id {
+		t.Errorf("GetArticle id = %d, want %d", resp.Data.Article.Article.Id, article.Id)
+	}
+}
+
+func TestCreateArticleContentFormats(t *testing.T) {
+	s := newTestServer()
+	ctx := authContext(t, 1)
+
+	markdown, err := s.CreateArticle(ctx, &pb.CreateArticleRequest{
+		Title:         "Markdown",
+		Content:       "# Heading\n\n<script>alert(1)</script>",
+		ContentFormat: pb.ContentFormat_CONTENT_FORMAT_MARKDOWN,
+	})
+	if err != nil || markdown.Code != response.CodeSuccess {
+		t.Fatalf("CreateArticle(markdown) = %v, %v; want success", markdown, err)
+	}
+	if html := markdown.Data.Article.ContentHtml; !strings.Contains(html, "Heading</h1>") || strings.Contains(html, "<script") {
+		t.Errorf("CreateArticle(markdown) content_html = %q, want rendered and sanitised HTML", html)
+	}
+
+	html, err := s.CreateArticle(ctx, &pb.CreateArticleRequest{
+		Title:         "HTML",
+		Content:       `<p onclick="x()">Hi</p>`,
+		ContentFormat: pb.ContentFormat_CONTENT_FORMAT_HTML,
+	})
+	if err != nil || html.Code != response.CodeSuccess {
+		t.Fatalf("CreateArticle(html) = %v, %v; want success", html, err)
+	}
+	if html.Data.Article.Content != "<p>Hi</p>" {
+		t.Errorf("CreateArticle(html) stored content = %q, want sanitised %q", html.Data.Article.Content, "<p>Hi</p>")
+	}
+
+	// Switching format re-renders the existing content
+	format := pb.ContentFormat_CONTENT_FORMAT_PLAIN
+	updated, err := s.UpdateArticle(ctx, &pb.UpdateArticleRequest{Id: markdown.Data.Article.Id, ContentFormat: &format})
+	if err != nil || updated.Code != response.CodeSuccess {
+		t.Fatalf("UpdateArticle(format only) = %v, %v; want success", updated, err)
+	}
+	if updated.Data.Article.ContentHtml != "" {
+		t.Errorf("UpdateArticle(plain) content_html = %q, want empty", updated.Data.Article.ContentHtml)
+	}
+
+	empty, err := s.CreateArticle(ctx, &pb.CreateArticleRequest{
+		Title:         "Script only",
+		Content:       "<script>alert(1)</script>",
+		ContentFormat: pb.ContentFormat_CONTENT_FORMAT_HTML,
+	})
+	if err != nil {
+		t.Fatalf("CreateArticle(script only) returned error: %v", err)
+	}
+	if empty.Code != response.CodeInvalidRequest {
+		t.Errorf("CreateArticle(script only) code = %s, want %s", empty.Code, response.CodeInvalidRequest)
+	}
+}
+
+func TestArticleViews(t *testing.T) {
+	s := newTestServer()
+	ctx := authContext(t, 1)
+
+	created, err := s.CreateArticle(ctx, &pb.CreateArticleRequest{
+		Title:         "Views",
+		Content:       "Some **bold** words here.",
+		ContentFormat: pb.ContentFormat_CONTENT_FORMAT_MARKDOWN,
+	})
+	if err != nil || created.Code != response.CodeSuccess {
+		t.Fatalf("CreateArticle = %v, %v; want success", created, err)
+	}
+	if a := created.Data.Article; a.Excerpt != "Some bold words here." || a.WordCount != 4 || a.ReadingTimeMinutes != 1 {
+		t.Errorf("CreateArticle summary = %q, %d words, %d min; want markup-free excerpt, 4 words, 1 min",
+			a.Excerpt, a.WordCount, a.ReadingTimeMinutes)
+	}
+
+	tests := []struct {
+		name        string
+		view        pb.ArticleView
+		wantContent bool
+	}{
+		{name: "list default is full", view: pb.ArticleView_ARTICLE_VIEW_UNSPECIFIED, wantContent: true},
+		{name: "list basic", view: pb.ArticleView_ARTICLE_VIEW_BASIC, wantContent: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			listed, err := s.ListArticles(ctx, &pb.ListArticlesRequest{View: tt.view})
+			if err != nil || listed.Code != response.CodeSuccess || len(listed.Data.Articles) != 1 {
+				t.Fatalf("ListArticles = %v, %v; want one article", listed, err)
+			}
+			article := listed.Data.Articles[0].Article
+			if hasContent := article.Content != "" && article.ContentHtml != ""; hasContent != tt.wantContent {
+				t.Errorf("ListArticles content present = %v, want %v", hasContent, tt.wantContent)
+			}
+			if article.Excerpt == "" {
+				t.Error("ListArticles excerpt is empty, want it in every view")
+			}
+		})
+	}
+
+	// v1 clamps oversized pages instead of rejecting them
+	if listed, err := s.ListArticles(ctx, &pb.ListArticlesRequest{PageSize: 500}); err != nil || listed.Code != response.CodeSuccess {
+		t.Errorf("ListArticles(page_size 500) = %v, %v; want success", listed, err)
+	}
+
+	basic, err := s.GetArticle(ctx, &pb.GetArticleRequest{Id: created.Data.Article.Id, View: pb.ArticleView_ARTICLE_VIEW_BASIC})
+	if err != nil || basic.Code != response.CodeSuccess {
+		t.Fatalf("GetArticle(basic) = %v, %v; want success", basic, err)
+	}
+	if basic.Data.Article.Article.Content != "" {
+		t.Errorf("GetArticle(basic) content = %q, want empty", basic.Data.Article.Article.Content)
+	}
+}
+
+func TestArticleFieldMasks(t *testing.T) {
+	s := newTestServer()
+	ctx := authContext(t, 1)
+
+	created, err := s.CreateArticle(ctx, &pb.CreateArticleRequest{Title: "Masks", Content: "Some content"})
+	if err != nil || created.Code != response.CodeSuccess {
+		t.Fatalf("CreateArticle = %v, %v; want success", created, err)
+	}
+	id := created.Data.Article.Id
+
+	// Only listed fields change; empty content clears it on purpose
+	updated, err := s.UpdateArticle(ctx, &pb.UpdateArticleRequest{
+		Id:         id,
+		Title:      "Ignored",
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	if err != nil || updated.Code != response.CodeSuccess {
+		t.Fatalf("UpdateArticle(mask content) = %v, %v; want success", updated, err)
+	}
+	if a := updated.Data.Article; a.Title != "Masks" || a.Content != "" || a.WordCount != 0 {
+		t.Errorf("UpdateArticle(mask content) = %+v, want title kept and content cleared", a)
+	}
+
+	unknown, err := s.UpdateArticle(ctx, &pb.UpdateArticleRequest{Id: id, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"user_id"}}})
+	if err != nil {
+		t.Fatalf("UpdateArticle(unknown path) returned error: %v", err)
+	}
+	if unknown.Code != response.CodeInvalidRequest {
+		t.Errorf("UpdateArticle(unknown path) code = %s, want %s", unknown.Code, response.CodeInvalidRequest)
+	}
+
+	got, err := s.GetArticle(ctx, &pb.GetArticleRequest{Id: id, ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}}})
+	if err != nil || got.Code != response.CodeSuccess {
+		t.Fatalf("GetArticle(read mask) = %v, %v; want success", got, err)
+	}
+	if a := got.Data.Article.Article; a.Title != "Masks" || a.CreatedAt != "" || a.UserId != 1 {
+		t.Errorf("GetArticle(read mask) = %+v, want title, id and user_id only", a)
+	}
+}
+
+// exportStream collects the messages of a server-streaming ExportArticles call
+type exportStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	received []*pb.ArticleWithUser
+	failAt   int // Send fails with Unavailable at this message when > 0
+}
+
+func (s *exportStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *exportStream) Send(item *pb.ArticleWithUser) error {
+	if s.failAt > 0 && len(s.received)+1 == s.failAt {
+		return status.Error(codes.Unavailable, "stream broken")
+	}
+	s.received = append(s.received, item)
+	return nil
+}
+
+func TestExportArticles(t *testing.T) {
+	s := newTestServer()
+	for _, userID := range []uint64{1, 2, 1} {
+		resp, err := s.CreateArticle(authContext(t, userID), &pb.CreateArticleRequest{Title: "Title", Content: "Content"})
+		if err != nil || resp.Code != response.CodeSuccess {
+			t.Fatalf("CreateArticle(user %d) = %v, %v; want success", userID, resp, err)
+		}
+	}
+
+	admin := authContext(t, 2)
+	stream := &exportStream{ctx: admin}
+	if err := s.ExportArticles(&pb.ExportArticlesRequest{IncludeAuthor: true}, stream); err != nil {
+		t.Fatalf("ExportArticles failed: %v", err)
+	}
+	if len(stream.received) != 3 || stream.received[0].User.GetName() != "Alice" || stream.received[0].Article.Content != "Content" {
+		t.Errorf("ExportArticles = %v, want 3 full articles with authors", stream.received)
+	}
+
+	stream = &exportStream{ctx: admin}
+	err := s.ExportArticles(&pb.ExportArticlesRequest{UserId: 1, View: pb.ArticleView_ARTICLE_VIEW_BASIC}, stream)
+	if err != nil {
+		t.Fatalf("ExportArticles(user 1, BASIC) failed: %v", err)
+	}
+	for _, item := range stream.received {
+		if item.Article.UserId != 1 || item.Article.Content != "" || item.User != nil {
+			t.Errorf("ExportArticles(user 1, BASIC) item = %v, want user 1 without content or author", item)
+		}
+	}
+	if len(stream.received) != 2 {
+		t.Errorf("ExportArticles(user 1) returned %d articles, want 2", len(stream.received))
+	}
+
+	now := timestamppb.Now()
+	err = s.ExportArticles(&pb.ExportArticlesRequest{CreatedAfter: now, CreatedBefore: now}, &exportStream{ctx: admin})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ExportArticles(empty time range) = %v, want InvalidArgument", err)
+	}
+
+	stream = &exportStream{ctx: admin, failAt: 2}
+	if err := s.ExportArticles(&pb.ExportArticlesRequest{}, stream); status.Code(err) != codes.Unavailable || len(stream.received) != 1 {
+		t.Errorf("ExportArticles(broken stream) = %v after %d articles, want Unavailable after 1", err, len(stream.received))
+	}
+
+	cancelled, cancel := context.WithCancel(admin)
+	cancel()
+	if err := s.ExportArticles(&pb.ExportArticlesRequest{}, &exportStream{ctx: cancelled}); status.Code(err) != codes.Canceled {
+		t.Errorf("ExportArticles(cancelled) = %v, want Canceled", err)
+	}
+
+	// Only admins and analysts may export, for at most the export timeout
+	if err := s.ExportArticles(&pb.ExportArticlesRequest{}, &exportStream{ctx: context.Background()}); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("ExportArticles without token = %v, want Unauthenticated", err)
+	}
+	if err := s.ExportArticles(&pb.ExportArticlesRequest{}, &exportStream{ctx: authContext(t, 1)}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("ExportArticles(not admin) = %v, want PermissionDenied", err)
+	}
+	s.ConfigureExport([]int32{1}, time.Nanosecond)
+	if err := s.ExportArticles(&pb.ExportArticlesRequest{}, &exportStream{ctx: authContext(t, 1)}); status.Code(err) != codes.DeadlineExceeded {
+		t.Errorf("ExportArticles(analyst, past timeout) = %v, want DeadlineExceeded", err)
+	}
+}
+
+// importStream feeds requests to a client-streaming ImportArticles call
+type importStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	requests []*pb.ImportArticlesRequest
+	response *pb.ImportArticlesResponse
+}
+
+func (s *importStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *importStream) Recv() (*pb.ImportArticlesRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+	return req, nil
+}
+
+func (s *importStream) SendAndClose(resp *pb.ImportArticlesResponse) error {
+	s.response = resp
+	return nil
+}
+
+func TestImportArticles(t *testing.T) {
+	created := timestamppb.New(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC))
+	rows := func(dryRun bool) []*pb.ImportArticlesRequest {
+		return []*pb.ImportArticlesRequest{
+			{DryRun: dryRun},
+			{Article: &pb.ImportedArticle{Title: "Mine", Content: "# Body", ContentFormat: pb.ContentFormat_CONTENT_FORMAT_MARKDOWN, Source: "a"}},
+			{Article: &pb.ImportedArticle{Title: "Old", Content: "Body", UserId: 1, CreateTime: created, Source: "b"}},
+			{Article: &pb.ImportedArticle{Content: "Body", Source: "c"}},
+			{Article: &pb.ImportedArticle{Title: "Ghost", Content: "Body", UserId: 99, Source: "d"}},
+		}
+	}
+
+	// Non-admins cannot set authors or timestamps; missing titles and authors are rejected either way
+	s := newTestServer()
+	stream := &importStream{ctx: authContext(t, 1), requests: rows(false)}
+	if err := s.ImportArticles(stream); err != nil {
+		t.Fatalf("ImportArticles(user) failed: %v", err)
+	}
+	report := stream.response
+	if report.Received != 4 || report.Imported != 1 || report.Failed != 3 || len(report.Errors) != 3 {
+		t.Fatalf("ImportArticles(user) = %v, want 1 of 4 imported", report)
+	}
+	if e := report.Errors[0]; e.Row != 2 || e.Source != "b" {
+		t.Errorf("first error = %v, want row 2 (b)", e)
+	}
+
+	// Admins keep the original author and timestamps
+	s = newTestServer()
+	stream = &importStream{ctx: authContext(t, 2), requests: rows(false)}
+	if err := s.ImportArticles(stream); err != nil {
+		t.Fatalf("ImportArticles(admin) failed: %v", err)
+	}
+	if stream.response.Imported != 2 || stream.response.Failed != 2 {
+		t.Errorf("ImportArticles(admin) = %v, want 2 imported", stream.response)
+	}
+	list, err := s.ListArticles(authContext(t, 2), &pb.ListArticlesRequest{UserId: 1, View: pb.ArticleView_ARTICLE_VIEW_FULL})
+	if err != nil || len(list.Data.Articles) != 1 {
+		t.Fatalf("ListArticles(user 1) = %v, %v; want the imported article", list, err)
+	}
+	old := list.Data.Articles[0].Article
+	if old.Title != "Old" || !old.CreateTime.AsTime().Equal(created.AsTime()) || !old.UpdateTime.AsTime().Equal(created.AsTime()) {
+		t.Errorf("imported article = %v, want original create_time as both timestamps", old)
+	}
+
+	// A dry run validates without inserting
+	s = newTestServer()
+	stream = &importStream{ctx: authContext(t, 2), requests: rows(true)}
+	if err := s.ImportArticles(stream); err != nil {
+		t.Fatalf("ImportArticles(dry run) failed: %v", err)
+	}
+	if !stream.response.DryRun || stream.response.Imported != 2 {
+		t.Errorf("ImportArticles(dry run) = %v, want 2 valid rows", stream.response)
+	}
+	list, err = s.ListArticles(authContext(t, 2), &pb.ListArticlesRequest{})
+	if err != nil || list.Data.Total != 0 {
+		t.Errorf("ListArticles after dry run = %v, %v; want no articles", list, err)
+	}
+
+	if err := s.ImportArticles(&importStream{ctx: context.Background()}); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("ImportArticles without token = %v, want Unauthenticated", err)
+	}
+}
+
+// rejectingRepo fails every Import batch that holds an article titled "Bad"
+type rejectingRepo struct {
+	repository.ArticleRepository
+}
+
+func (r rejectingRepo) Import(ctx context.Context, articles []*pb.Article) error {
+	for _, article := range articles {
+		if article.Title == "Bad" {
+			return fmt.Errorf("constraint violated")
+		}
+	}
+	return r.ArticleRepository.Import(ctx, articles)
+}
+
+func TestImportRetriesFailedBatch(t *testing.T) {
+	s := newTestServerWithRepo(rejectingRepo{repository.NewArticleMemoryRepository()})
+	titles := []string{"A", "B", "Bad", "C", "D", "E", "Bad", "F"}
+	next := func() (*pb.ImportedArticle, error) {
+		if len(titles) == 0 {
+			return nil, io.EOF
+		}
+		row := &pb.ImportedArticle{Title: titles[0], Content: "Body"}
+		titles = titles[1:]
+		return row, nil
+	}
+
+	report, err := s.Import(context.Background(), server.ImportOptions{Author: 1, BatchSize: 5}, next)
+	if err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+	if report.Imported != 6 || report.Failed != 2 || len(report.Errors) != 2 || report.Errors[0].Row != 3 || report.Errors[1].Row != 7 {
+		t.Errorf("Import = %v, want only rows 3 and 7 rejected", report)
+	}
+}
+
+// fakeEvents is an in-memory change feed: events are stored and sent to live subscribers
+type fakeEvents struct {
+	stored []*pb.ArticleEvent
+	live   chan *pb.ArticleEvent
+}
+
+func (f *fakeEvents) Subscribe() (<-chan *pb.ArticleEvent, func()) {
+	return f.live, func() {}
+}
+
+func (f *fakeEvents) Replay(_ context.Context, afterID int64, fn func(*pb.ArticleEvent) error) error {
+	for _, event := range f.stored {
+		if event.Id > afterID {
+			if err := fn(event); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (f *fakeEvents) LastID(context.Context) (int64, error) {
+	if len(f.stored) == 0 {
+		return 0, nil
+	}
+	return f.stored[len(f.stored)-1].Id, nil
+}
+
+// watchStream collects WatchArticles responses and cancels the call after max of them
+type watchStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	cancel   context.CancelFunc
+	max      int
+	received []*pb.WatchArticlesResponse
+}
+
+func newWatchStream(max int) *watchStream {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &watchStream{ctx: ctx, cancel: cancel, max: max}
+}
+
+func (s *watchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *watchStream) Send(resp *pb.WatchArticlesResponse) error {
+	s.received = append(s.received, resp)
+	if len(s.received) == s.max {
+		s.cancel()
+	}
+	return nil
+}
+
+func TestWatchArticles(t *testing.T) {
+	event := func(id int64, userID int32, deleted bool) *pb.ArticleEvent {
+		article := &pb.Article{Id: int32(id), UserId: userID}
+		if deleted {
+			return &pb.ArticleEvent{Id: id, ArticleId: article.Id, Event: &pb.ArticleEvent_Deleted{Deleted: &pb.ArticleDeleted{Article: article}}}
+		}
+		return &pb.ArticleEvent{Id: id, ArticleId: article.Id, Event: &pb.ArticleEvent_Created{Created: &pb.ArticleCreated{Article: article}}}
+	}
+	events := &fakeEvents{
+		stored: []*pb.ArticleEvent{event(1, 1, false), event(2, 2, false), event(3, 1, true)},
+		live:   make(chan *pb.ArticleEvent, 10),
+	}
+	s := newTestServer()
+
+	err := s.WatchArticles(&pb.WatchArticlesRequest{}, newWatchStream(1))
+	if status.Code(err) != codes.Unimplemented {
+		t.Errorf("WatchArticles without a change feed = %v, want Unimplemented", err)
+	}
+	s.EnableWatch(events, time.Hour)
+
+	// Live events are filtered by author; replayed duplicates are skipped
+	events.live <- event(2, 2, false)
+	events.live <- event(4, 1, false)
+	stream := newWatchStream(1)
+	if err := s.WatchArticles(&pb.WatchArticlesRequest{UserId: 1}, stream); status.Code(err) != codes.Canceled {
+		t.Fatalf("WatchArticles = %v, want Canceled by the test", err)
+	}
+	if got := stream.received[0].GetEvent(); got.GetId() != 4 {
+		t.Fatalf("WatchArticles(user 1) first event = %v, want id 4", got)
+	}
+	token := stream.received[0].ResumeToken
+
+	// Resuming replays stored events (with some overlap), then continues live
+	events.stored = append(events.stored, event(4, 1, false), event(5, 1, true))
+	events.live <- event(5, 1, true)
+	events.live <- event(6, 1, true)
+	stream = newWatchStream(3)
+	err = s.WatchArticles(&pb.WatchArticlesRequest{ResumeToken: token, EventTypes: []string{"ArticleDeleted"}}, stream)
+	if status.Code(err) != codes.Canceled {
+		t.Fatalf("WatchArticles(resume) = %v, want Canceled by the test", err)
+	}
+	var ids []int64
+	for _, resp := range stream.received {
+		ids = append(ids, resp.GetEvent().GetId())
+	}
+	if !slices.Equal(ids, []int64{3, 5, 6}) {
+		t.Errorf("WatchArticles(resume, deleted only) ids = %v, want [3 5 6]", ids)
+	}
+
+	// Heartbeats carry a resume token when nothing happens
+	stream = newWatchStream(1)
+	err = s.WatchArticles(&pb.WatchArticlesRequest{HeartbeatInterval: durationpb.New(time.Second)}, stream)
+	if status.Code(err) != codes.Canceled || stream.received[0].GetHeartbeat() == nil || stream.received[0].ResumeToken == "" {
+		t.Errorf("WatchArticles(idle) = %v, %v; want a heartbeat with a resume token", stream.received, err)
+	}
+
+	// The feed dropped this subscriber: it must reconnect
+	close(events.live)
+	if err := s.WatchArticles(&pb.WatchArticlesRequest{}, newWatchStream(1)); status.Code(err) != codes.Unavailable {
+		t.Errorf("WatchArticles(interrupted) = %v, want Unavailable", err)
+	}
+
+	if err := s.WatchArticles(&pb.WatchArticlesRequest{ResumeToken: "!"}, newWatchStream(1)); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("WatchArticles(bad token) = %v, want InvalidArgument", err)
+	}
+	s.EnableWatch(events, time.Nanosecond)
+	if err := s.WatchArticles(&pb.WatchArticlesRequest{ResumeToken: token}, newWatchStream(1)); status.Code(err) != codes.OutOfRange {
+		t.Errorf("WatchArticles(expired token) = %v, want OutOfRange", err)
+	}
+}
+
+func TestWebhooks(t *testing.T) {
+	s := newTestServer()
+	alice, bob := authContext(t, 1), authContext(t, 2)
+	req := &pb.CreateWebhookRequest{Url: "https://partner.example.com/hooks", Secret: "0123456789abcdef", EventTypes: []string{"ArticleCreated"}}
+
+	// Disabled until the server is given a repository
+	if resp, _ := s.CreateWebhook(alice, req); resp.Code != response.CodeUnimplemented {
+		t.Fatalf("CreateWebhook without webhooks = %v, want Unimplemented", resp)
+	}
+
+	repo := repository.NewWebhookMemoryRepository()
+	s.EnableWebhooks(repo)
+
+	invalid, _ := s.CreateWebhook(alice, &pb.CreateWebhookRequest{Url: "ftp://partner.example.com", Secret: "short", EventTypes: []string{"ArticleRead"}})
+	if invalid.Code != response.CodeInvalidRequest {
+		t.Fatalf("CreateWebhook(invalid) code = %s, want %s", invalid.Code, response.CodeInvalidRequest)
+	}
+	if got, want := violatedFields(t, invalid.Details), []string{"url", "secret", "event_types[0]"}; !slices.Equal(got, want) {
+		t.Errorf("CreateWebhook(invalid) violations = %v, want %v", got, want)
+	}
+
+	created, err := s.CreateWebhook(alice, req)
+	if err != nil || created.Code != response.CodeSuccess {
+		t.Fatalf("CreateWebhook = %v, %v; want success", created, err)
+	}
+	webhook := created.Data.Webhook
+	if webhook.UserId != 1 || webhook.Url != req.Url {
+		t.Errorf("CreateWebhook = %+v, want user_id 1 and the requested URL", webhook)
+	}
+
+	listed, _ := s.ListWebhooks(bob, &pb.ListWebhooksRequest{})
+	if listed.Code != response.CodeSuccess || len(listed.Data.Webhooks) != 0 {
+		t.Errorf("ListWebhooks(bob) = %v, want success with no webhooks", listed)
+	}
+
+	article := &pb.Article{Id: 7, UserId: 1, Title: "Hello"}
+	event := &pb.ArticleEvent{Id: 1, ArticleId: 7, Time: timestamppb.Now(), Event: &pb.ArticleEvent_Created{Created: &pb.ArticleCreated{Article: article}}}
+	if err := repo.Enqueue(context.Background(), event); err != nil {
+		t.Fatalf("Enqueue failed: %v", err)
+	}
+
+	deliveries, _ := s.ListWebhookDeliveries(alice, &pb.ListWebhookDeliveriesRequest{WebhookId: webhook.Id})
+	if deliveries.Code != response.CodeSuccess || deliveries.Data.Total != 1 {
+		t.Fatalf("ListWebhookDeliveries = %v, want 1 delivery", deliveries)
+	}
+	delivery := deliveries.Data.Deliveries[0]
+	if delivery.EventId != 1 || delivery.Status != pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING {
+		t.Errorf("delivery = %+v, want event 1, PENDING", delivery)
+	}
+
+	// Other users' webhooks and deliveries look missing
+	if resp, _ := s.ListWebhookDeliveries(bob, &pb.ListWebhookDeliveriesRequest{WebhookId: webhook.Id}); resp.Code != response.CodeNotFound {
+		t.Errorf("ListWebhookDeliveries(bob) code = %s, want %s", resp.Code, response.CodeNotFound)
+	}
+	if resp, _ := s.DeleteWebhook(bob, &pb.DeleteWebhookRequest{Id: webhook.Id}); resp.Code != response.CodeNotFound {
+		t.Errorf("DeleteWebhook(bob) code = %s, want %s", resp.Code, response.CodeNotFound)
+	}
+
+	retried, _ := s.RetryWebhookDelivery(alice, &pb.RetryWebhookDeliveryRequest{Id: delivery.Id})
+	if retried.Code != response.CodeFailedPrecondition {
+		t.Errorf("RetryWebhookDelivery(pending) code = %s, want %s", retried.Code, response.CodeFailedPrecondition)
+	}
+
+	if resp, _ := s.DeleteWebhook(alice, &pb.DeleteWebhookRequest{Id: webhook.Id}); resp.Code != response.CodeSuccess {
+		t.Fatalf("DeleteWebhook = %v, want success", resp)
+	}
+	if resp, _ := s.ListWebhookDeliveries(alice, &pb.ListWebhookDeliveriesRequest{WebhookId: webhook.Id}); resp.Code != response.CodeNotFound {
+		t.Errorf("ListWebhookDeliveries after delete code = %s, want %s", resp.Code, response.CodeNotFound)
+	}
+}
+
+func TestComments(t *testing.T) {
+	articles := repository.NewArticleMemoryRepository()
+	s := newTestServerWithRepo(articles)
+	// Carol (3) is not an admin and unknown to User Service
+	alice, carol := authContext(t, 1), authContext(t, 3)
+
+	if resp, _ := s.ListComments(context.Background(), &pb.ListCommentsRequest{ArticleId: 1}); resp.Code != response.CodeUnimplemented {
+		t.Fatalf("ListComments without comments = %v, want Unimplemented", resp)
+	}
+	s.EnableComments(repository.NewCommentMemoryRepository(articles))
+
+	created, _ := s.CreateArticle(alice, &pb.CreateArticleRequest{Title: "Hello", Content: "World"})
+	articleID := created.Data.Article.Id
+
+	if resp, _ := s.CreateComment(context.Background(), &pb.CreateCommentRequest{ArticleId: articleID, Content: "Hi"}); resp.Code != response.CodeUnauthenticated {
+		t.Errorf("CreateComment without token code = %s, want %s", resp.Code, response.CodeUnauthenticated)
+	}
+	invalid, _ := s.CreateComment(carol, &pb.CreateCommentRequest{ArticleId: articleID, Content: " "})
+	if got := violatedFields(t, invalid.Details); invalid.Code != response.CodeInvalidRequest || !slices.Equal(got, []string{"content"}) {
+		t.Errorf("CreateComment(blank) = %s %v, want InvalidRequest on content", invalid.Code, got)
+	}
+	if resp, _ := s.CreateComment(carol, &pb.CreateCommentRequest{ArticleId: articleID, ParentId: 99, Content: "Hi"}); resp.Code != response.CodeNotFound {
+		t.Errorf("CreateComment(missing parent) code = %s, want %s", resp.Code, response.CodeNotFound)
+	}
+
+	top, _ := s.CreateComment(carol, &pb.CreateCommentRequest{ArticleId: articleID, Content: "First!"})
+	if top.Code != response.CodeSuccess || top.Data.Comment.UserId != 3 || top.Data.Comment.User != nil {
+		t.Fatalf("CreateComment = %v, want success by user 3 without user info", top)
+	}
+	topID := top.Data.Comment.Id
+	reply, _ := s.CreateComment(alice, &pb.CreateCommentRequest{ArticleId: articleID, ParentId: topID, Content: "Thanks"})
+	if reply.Code != response.CodeSuccess || reply.Data.Comment.User.GetName() != "Alice" || reply.Data.Comment.Depth != 1 {
+		t.Fatalf("CreateComment(reply) = %v, want a reply by Alice at depth 1", reply)
+	}
+
+	// Only the article author (or an admin) moderates
+	if resp, _ := s.ModerateComment(carol, &pb.ModerateCommentRequest{Id: topID, Hidden: true}); resp.Code != response.CodePermissionDenied {
+		t.Errorf("ModerateComment(carol) code = %s, want %s", resp.Code, response.CodePermissionDenied)
+	}
+	if resp, _ := s.ModerateComment(alice, &pb.ModerateCommentRequest{Id: topID, Hidden: true}); resp.Code != response.CodeSuccess || !resp.Data.Comment.Hidden {
+		t.Fatalf("ModerateComment(alice) = %v, want a hidden comment", resp)
+	}
+	if got, _ := s.GetArticle(alice, &pb.GetArticleRequest{Id: articleID}); got.Data.Article.Article.CommentCount != 1 {
+		t.Errorf("comment_count = %d, want 1 (the hidden comment does not count)", got.Data.Article.Article.CommentCount)
+	}
+
+	// Hidden content is only shown to its author and moderators
+	for _, tc := range []struct {
+		name    string
+		ctx     context.Context
+		content string
+	}{
+		{"anonymous", context.Background(), ""},
+		{"comment author", carol, "First!"},
+		{"article author", alice, "First!"},
+	} {
+		listed, _ := s.ListComments(tc.ctx, &pb.ListCommentsRequest{ArticleId: articleID})
+		if listed.Code != response.CodeSuccess || listed.Data.Total != 1 {
+			t.Fatalf("%s: ListComments = %v, want one thread", tc.name, listed)
+		}
+		thread := listed.Data.Comments[0]
+		if thread.Content != tc.content || !thread.Hidden {
+			t.Errorf("%s: hidden comment content = %q, want %q", tc.name, thread.Content, tc.content)
+		}
+		if len(thread.Replies) != 1 || thread.Replies[0].Content != "Thanks" || thread.Replies[0].User.GetName() != "Alice" {
+			t.Errorf("%s: replies = %v, want Alice's visible reply", tc.name, thread.Replies)
+		}
+	}
+
+	if resp, _ := s.UpdateComment(alice, &pb.UpdateCommentRequest{Id: topID, Content: "Edited"}); resp.Code != response.CodePermissionDenied {
+		t.Errorf("UpdateComment(not author) code = %s, want %s", resp.Code, response.CodePermissionDenied)
+	}
+	if resp, _ := s.UpdateComment(carol, &pb.UpdateCommentRequest{Id: topID, Content: "Edited"}); resp.Code != response.CodeSuccess || resp.Data.Comment.Content != "Edited" {
+		t.Errorf("UpdateComment(author) = %v, want the new content", resp)
+	}
+
+	// Replies nest at most 5 levels deep
+	parentID := reply.Data.Comment.Id
+	for depth := 2; depth <= 5; depth++ {
+		resp, _ := s.CreateComment(carol, &pb.CreateCommentRequest{ArticleId: articleID, ParentId: parentID, Content: "Deeper"})
+		if resp.Code != response.CodeSuccess {
+			t.Fatalf("CreateComment(depth %d) = %v, want success", depth, resp)
+		}
+		parentID = resp.Data.Comment.Id
+	}
+	tooDeep, _ := s.CreateComment(carol, &pb.CreateCommentRequest{ArticleId: articleID, ParentId: parentID, Content: "Too deep"})
+	if got := violatedFields(t, tooDeep.Details); tooDeep.Code != response.CodeInvalidRequest || !slices.Equal(got, []string{"parent_id"}) {
+		t.Errorf("CreateComment(depth 6) = %s %v, want InvalidRequest on parent_id", tooDeep.Code, got)
+	}
+
+	// The article author deletes the whole thread
+	if resp, _ := s.DeleteComment(alice, &pb.DeleteCommentRequest{Id: topID}); resp.Code != response.CodeSuccess {
+		t.Fatalf("DeleteComment(article author) = %v, want success", resp)
+	}
+	if got, _ := s.GetArticle(alice, &pb.GetArticleRequest{Id: articleID}); got.Data.Article.Article.CommentCount != 0 {
+		t.Errorf("comment_count after delete = %d, want 0", got.Data.Article.Article.CommentCount)
+	}
+	if resp, _ := s.DeleteComment(carol, &pb.DeleteCommentRequest{Id: parentID}); resp.Code != response.CodeNotFound {
+		t.Errorf("DeleteComment(reply of deleted thread) code = %s, want %s", resp.Code, response.CodeNotFound)
+	}
+}
+
+func TestCommentReplyPages(t *testing.T) {
+	articles := repository.NewArticleMemoryRepository()
+	s := newTestServerWithRepo(articles)
+	s.EnableComments(repository.NewCommentMemoryRepository(articles))
+	alice, carol := authContext(t, 1), authContext(t, 3)
+
+	created, _ := s.CreateArticle(alice, &pb.CreateArticleRequest{Title: "Hello", Content: "World"})
+	articleID := created.Data.Article.Id
+	top, _ := s.CreateComment(carol, &pb.CreateCommentRequest{ArticleId: articleID, Content: "Popular"})
+	topID := top.Data.Comment.Id
+
+	// Seven replies: every other one answers the previous reply
+	parentID := topID
+	var want []string
+	for i := 1; i <= 7; i++ {
+		content := fmt.Sprintf("Reply %d", i)
+		resp, _ := s.CreateComment(alice, &pb.CreateCommentRequest{ArticleId: articleID, ParentId: parentID, Content: content})
+		if resp.Code != response.CodeSuccess {
+			t.Fatalf("CreateComment(%s) = %v, want success", content, resp)
+		}
+		want = append(want, content)
+		if i%2 == 1 {
+			parentID = resp.Data.Comment.Id
+		} else {
+			parentID = topID
+		}
+	}
+
+	invalid, _ := s.ListComments(context.Background(), &pb.ListCommentsRequest{ArticleId: articleID, ReplyPageSize: validator.MaxReplyPageSize + 1})
+	if got := violatedFields(t, invalid.Details); invalid.Code != response.CodeInvalidRequest || !slices.Equal(got, []string{"reply_page_size"}) {
+		t.Errorf("ListComments(reply_page_size too large) = %s %v, want InvalidRequest on reply_page_size", invalid.Code, got)
+	}
+
+	listed, _ := s.ListComments(context.Background(), &pb.ListCommentsRequest{ArticleId: articleID, ReplyPageSize: 2})
+	if listed.Code != response.CodeSuccess || len(listed.Data.Comments) != 1 {
+		t.Fatalf("ListComments = %v, want one thread", listed)
+	}
+	thread := listed.Data.Comments[0]
+	var got []string
+	var walk func([]*pb.Comment)
+	walk = func(replies []*pb.Comment) {
+		for _, r := range replies {
+			if r.User.GetName() != "Alice" {
+				t.Errorf("reply %q user = %v, want Alice", r.Content, r.User)
+			}
+			got = append(got, r.Content)
+			walk(r.Replies)
+		}
+	}
+	walk(thread.Replies)
+	if thread.ReplyCount != 7 || !slices.Equal(got, want[:2]) || thread.RepliesPageToken == "" {
+		t.Fatalf("thread = %d replies %v, token %q; want 7 replies, the first 2 returned and a token", thread.ReplyCount, got, thread.RepliesPageToken)
+	}
+
+	// ListReplies returns the rest of the thread, flat
+	token := thread.RepliesPageToken
+	for pages := 0; ; pages++ {
+		if pages == 3 {
+			t.Fatal("ListReplies did not reach the last page")
+		}
+		page, _ := s.ListReplies(context.Background(), &pb.ListRepliesRequest{CommentId: topID, PageSize: 3, PageToken: token})
+		if page.Code != response.CodeSuccess {
+			t.Fatalf("ListReplies = %v, want success", page)
+		}
+		walk(page.Data.Replies)
+		if token = page.Data.NextPageToken; token == "" {
+			break
+		}
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("replies = %v, want %v", got, want)
+	}
+
+	firstReply := listed.Data.Comments[0].Replies[0].Id
+	for _, tc := range []struct {
+		name  string
+		req   *pb.ListRepliesRequest
+		code  string
+		field string
+	}{
+		{"reply instead of thread", &pb.ListRepliesRequest{CommentId: firstReply}, response.CodeInvalidRequest, "comment_id"},
+		{"bad token", &pb.ListRepliesRequest{CommentId: topID, PageToken: "not a token"}, response.CodeInvalidRequest, "page_token"},
+		{"missing comment", &pb.ListRepliesRequest{CommentId: 999}, response.CodeNotFound, ""},
+	} {
+		resp, _ := s.ListReplies(context.Background(), tc.req)
+		if resp.Code != tc.code {
+			t.Errorf("%s: ListReplies code = %s, want %s", tc.name, resp.Code, tc.code)
+		} else if tc.field != "" && !slices.Equal(violatedFields(t, resp.Details), []string{tc.field}) {
+			t.Errorf("%s: violated fields = %v, want %s", tc.name, violatedFields(t, resp.Details), tc.field)
+		}
+	}
+}
+
+func TestReactions(t *testing.T) {
+	articles := repository.NewArticleMemoryRepository()
+	s := newTestServerWithRepo(articles)
+	alice, bob := authContext(t, 1), authContext(t, 2)
+	like, love := pb.ReactionType_REACTION_TYPE_LIKE, pb.ReactionType_REACTION_TYPE_LOVE
+
+	if resp, _ := s.AddReaction(alice, &pb.AddReactionRequest{ArticleId: 1, Type: like}); resp.Code != response.CodeUnimplemented {
+		t.Fatalf("AddReaction without reactions = %v, want Unimplemented", resp)
+	}
+	s.EnableReactions(repository.NewReactionMemoryRepository(articles))
+
+	quiet, _ := s.CreateArticle(alice, &pb.CreateArticleRequest{Title: "Quiet", Content: "World"})
+	popular, _ := s.CreateArticle(alice, &pb.CreateArticleRequest{Title: "Popular", Content: "World"})
+	articleID := popular.Data.Article.Id
+
+	if resp, _ := s.AddReaction(context.Background(), &pb.AddReactionRequest{ArticleId: articleID, Type: like}); resp.Code != response.CodeUnauthenticated {
+		t.Errorf("AddReaction without token code = %s, want %s", resp.Code, response.CodeUnauthenticated)
+	}
+	invalid, _ := s.AddReaction(bob, &pb.AddReactionRequest{ArticleId: articleID})
+	if got := violatedFields(t, invalid.Details); invalid.Code != response.CodeInvalidRequest || !slices.Equal(got, []string{"type"}) {
+		t.Errorf("AddReaction(no type) = %s %v, want InvalidRequest on type", invalid.Code, got)
+	}
+	if resp, _ := s.AddReaction(bob, &pb.AddReactionRequest{ArticleId: 99, Type: like}); resp.Code != response.CodeNotFound {
+		t.Errorf("AddReaction(missing article) code = %s, want %s", resp.Code, response.CodeNotFound)
+	}
+
+	added, _ := s.AddReaction(bob, &pb.AddReactionRequest{ArticleId: articleID, Type: like})
+	if added.Code != response.CodeSuccess || !added.Data.Changed || added.Data.Summary.Total != 1 || len(added.Data.Summary.Mine) != 1 {
+		t.Fatalf("AddReaction = %v, want a changed summary with Bob's like", added)
+	}
+	if again, _ := s.AddReaction(bob, &pb.AddReactionRequest{ArticleId: articleID, Type: like}); again.Data.Changed || again.Data.Summary.Total != 1 {
+		t.Errorf("AddReaction(again) = %v, want unchanged with total 1", again)
+	}
+	s.AddReaction(bob, &pb.AddReactionRequest{ArticleId: articleID, Type: love})
+	s.AddReaction(alice, &pb.AddReactionRequest{ArticleId: articleID, Type: love})
+
+	got, _ := s.GetArticle(alice, &pb.GetArticleRequest{Id: articleID})
+	if a := got.Data.Article.Article; a.ReactionCount != 3 || len(a.ReactionCounts) != 2 || a.ReactionCounts[1].Type != love || a.ReactionCounts[1].Count != 2 {
+		t.Errorf("article reactions = %d %v, want 3: 1 LIKE, 2 LOVE", a.ReactionCount, a.ReactionCounts)
+	}
+
+	// Anonymous callers see the counts but no reactions of their own
+	listed, _ := s.ListReactions(context.Background(), &pb.ListReactionsRequest{ArticleId: articleID, Type: love})
+	if listed.Code != response.CodeSuccess || listed.Data.Total != 2 || listed.Data.Summary.Total != 3 || len(listed.Data.Summary.Mine) != 0 {
+		t.Fatalf("ListReactions(LOVE) = %v, want 2 loves of 3 reactions", listed)
+	}
+	if r := listed.Data.Reactions[0]; r.UserId != 1 || r.User.GetName() != "Alice" {
+		t.Errorf("newest reaction = %v, want Alice's", r)
+	}
+
+	// Popular articles come first
+	ordered, _ := s.ListArticles(context.Background(), &pb.ListArticlesRequest{OrderBy: pb.ArticleOrder_ARTICLE_ORDER_POPULAR})
+	if ordered.Code != response.CodeSuccess || len(ordered.Data.Articles) != 2 || ordered.Data.Articles[0].Article.Id != articleID {
+		t.Fatalf("ListArticles(POPULAR) = %v, want the popular article first", ordered)
+	}
+	newest, _ := s.ListArticles(context.Background(), &pb.ListArticlesRequest{})
+	if newest.Data.Articles[0].Article.Id != articleID || newest.Data.Articles[1].Article.Id != quiet.Data.Article.Id {
+		t.Errorf("ListArticles = %v, want newest first", newest)
+	}
+
+	removed, _ := s.RemoveReaction(bob, &pb.RemoveReactionRequest{ArticleId: articleID, Type: like})
+	if removed.Code != response.CodeSuccess || !removed.Data.Changed || removed.Data.Summary.Total != 2 || len(removed.Data.Summary.Mine) != 1 {
+		t.Errorf("RemoveReaction = %v, want total 2 with Bob's love left", removed)
+	}
+	if again, _ := s.RemoveReaction(bob, &pb.RemoveReactionRequest{ArticleId: articleID, Type: like}); again.Code != response.CodeSuccess || again.Data.Changed {
+		t.Errorf("RemoveReaction(again) = %v, want unchanged", again)
+	}
+	if resp, _ := s.RemoveReaction(bob, &pb.RemoveReactionRequest{ArticleId: 99, Type: like}); resp.Code != response.CodeNotFound {
+		t.Errorf("RemoveReaction(missing article) code = %s, want %s", resp.Code, response.CodeNotFound)
+	}
+}
+
+func TestBookmarks(t *testing.T) {
+	articles := repository.NewArticleMemoryRepository()
+	s := newTestServerWithRepo(articles)
+	alice, bob := authContext(t, 1), authContext(t, 2)
+
+	if resp, _ := s.BookmarkArticle(alice, &pb.BookmarkArticleRequest{ArticleId: 1}); resp.Code != response.CodeUnimplemented {
+		t.Fatalf("BookmarkArticle without bookmarks = %v, want Unimplemented", resp)
+	}
+	s.EnableBookmarks(repository.NewBookmarkMemoryRepository(articles))
+
+	var ids []int32
+	for _, title := range []string{"First", "Second", "Third"} {
+		created, _ := s.CreateArticle(alice, &pb.CreateArticleRequest{Title: title, Content: "World"})
+		ids = append(ids, created.Data.Article.Id)
+	}
+
+	if resp, _ := s.BookmarkArticle(context.Background(), &pb.BookmarkArticleRequest{ArticleId: ids[0]}); resp.Code != response.CodeUnauthenticated {
+		t.Errorf("BookmarkArticle without token code = %s, want %s", resp.Code, response.CodeUnauthenticated)
+	}
+	if resp, _ := s.BookmarkArticle(bob, &pb.BookmarkArticleRequest{ArticleId: 99}); resp.Code != response.CodeNotFound {
+		t.Errorf("BookmarkArticle(missing article) code = %s, want %s", resp.Code, response.CodeNotFound)
+	}
+	saved, _ := s.BookmarkArticle(bob, &pb.BookmarkArticleRequest{ArticleId: ids[0]})
+	if saved.Code != response.CodeSuccess || !saved.Data.Changed || saved.Data.Bookmark.Article.GetUser().GetName() != "Alice" {
+		t.Fatalf("BookmarkArticle = %v, want a new bookmark with the article and its author", saved)
+	}
+	if again, _ := s.BookmarkArticle(bob, &pb.BookmarkArticleRequest{ArticleId: ids[0]}); again.Code != response.CodeSuccess || again.Data.Changed {
+		t.Errorf("BookmarkArticle(again) = %v, want unchanged", again)
+	}
+	s.BookmarkArticle(bob, &pb.BookmarkArticleRequest{ArticleId: ids[1]})
+	s.BookmarkArticle(bob, &pb.BookmarkArticleRequest{ArticleId: ids[2]})
+
+	// Pages follow the cursor, most recently saved first
+	first, _ := s.ListBookmarks(bob, &pb.ListBookmarksRequest{PageSize: 2})
+	if first.Code != response.CodeSuccess || len(first.Data.Bookmarks) != 2 || first.Data.Bookmarks[0].ArticleId != ids[2] || first.Data.NextPageToken == "" {
+		t.Fatalf("ListBookmarks page 1 = %v, want 2 bookmarks newest first and a next page", first)
+	}
+	second, _ := s.ListBookmarks(bob, &pb.ListBookmarksRequest{PageSize: 2, PageToken: first.Data.NextPageToken})
+	if len(second.Data.Bookmarks) != 1 || second.Data.Bookmarks[0].ArticleId != ids[0] || second.Data.NextPageToken != "" {
+		t.Errorf("ListBookmarks page 2 = %v, want the oldest bookmark and no next page", second)
+	}
+	if resp, _ := s.ListBookmarks(bob, &pb.ListBookmarksRequest{PageToken: "%%%"}); resp.Code != response.CodeInvalidRequest {
+		t.Errorf("ListBookmarks(bad token) code = %s, want %s", resp.Code, response.CodeInvalidRequest)
+	}
+
+	// A bookmark outlives its article but comes back without it
+	s.DeleteArticle(alice, &pb.DeleteArticleRequest{Id: ids[1]})
+	listed, _ := s.ListBookmarks(bob, &pb.ListBookmarksRequest{})
+	if len(listed.Data.Bookmarks) != 3 || listed.Data.Bookmarks[1].Article != nil || listed.Data.Bookmarks[0].Article == nil {
+		t.Errorf("ListBookmarks after delete = %v, want the deleted article unset", listed)
+	}
+
+	// Collections
+	reading, _ := s.CreateCollection(bob, &pb.CreateCollectionRequest{Name: "Reading"})
+	later, _ := s.CreateCollection(bob, &pb.CreateCollectionRequest{Name: "Later"})
+	if reading.Code != response.CodeSuccess || later.Data.Collection.Position != 1 {
+		t.Fatalf("CreateCollection = %v, %v, want positions 0 and 1", reading, later)
+	}
+	if dup, _ := s.CreateCollection(bob, &pb.CreateCollectionRequest{Name: "Reading"}); dup.Code != response.CodeAlreadyExists {
+		t.Errorf("CreateCollection(duplicate) code = %s, want %s", dup.Code, response.CodeAlreadyExists)
+	}
+	collectionID := reading.Data.Collection.Id
+	if resp, _ := s.BookmarkArticle(alice, &pb.BookmarkArticleRequest{ArticleId: ids[0], CollectionId: collectionID}); resp.Code != response.CodeNotFound {
+		t.Errorf("BookmarkArticle(someone else's collection) code = %s, want %s", resp.Code, response.CodeNotFound)
+	}
+	s.BookmarkArticle(bob, &pb.BookmarkArticleRequest{ArticleId: ids[0], CollectionId: collectionID})
+
+	if partial, _ := s.ReorderCollections(bob, &pb.ReorderCollectionsRequest{Ids: []int32{collectionID}}); partial.Code != response.CodeInvalidRequest {
+		t.Errorf("ReorderCollections(partial) code = %s, want %s", partial.Code, response.CodeInvalidRequest)
+	}
+	reordered, _ := s.ReorderCollections(bob, &pb.ReorderCollectionsRequest{Ids: []int32{later.Data.Collection.Id, collectionID}})
+	if reordered.Code != response.CodeSuccess || reordered.Data.Collections[0].Id != later.Data.Collection.Id {
+		t.Errorf("ReorderCollections = %v, want Later first", reordered)
+	}
+
+	// Sharing
+	shared, _ := s.ShareCollection(bob, &pb.ShareCollectionRequest{Id: collectionID, Shared: true})
+	token := shared.Data.GetCollection().GetShareToken()
+	if shared.Code != response.CodeSuccess || token == "" {
+		t.Fatalf("ShareCollection = %v, want a share token", shared)
+	}
+	public, _ := s.GetSharedCollection(context.Background(), &pb.GetSharedCollectionRequest{ShareToken: token})
+	if public.Code != response.CodeSuccess || public.Data.Collection.ShareToken != "" || len(public.Data.Bookmarks) != 1 || public.Data.Bookmarks[0].ArticleId != ids[0] {
+		t.Errorf("GetSharedCollection = %v, want the collection without its token and one bookmark", public)
+	}
+	s.ShareCollection(bob, &pb.ShareCollectionRequest{Id: collectionID})
+	if revoked, _ := s.GetSharedCollection(context.Background(), &pb.GetSharedCollectionRequest{ShareToken: token}); revoked.Code != response.CodeNotFound {
+		t.Errorf("GetSharedCollection(revoked) code = %s, want %s", revoked.Code, response.CodeNotFound)
+	}
+
+	if deleted, _ := s.DeleteCollection(bob, &pb.DeleteCollectionRequest{Id: collectionID}); deleted.Code != response.CodeSuccess {
+		t.Errorf("DeleteCollection = %v, want success", deleted)
+	}
+	if resp, _ := s.ListBookmarks(bob, &pb.ListBookmarksRequest{CollectionId: collectionID}); resp.Code != response.CodeNotFound {
+		t.Errorf("ListBookmarks(deleted collection) code = %s, want %s", resp.Code, response.CodeNotFound)
+	}
+}
+
+func TestViewsAndTrending(t *testing.T) {
+	articles := repository.NewArticleMemoryRepository()
+	s := newTestServerWithRepo(articles)
+	alice, bob := authContext(t, 1), authContext(t, 2)
+	anonymous := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("203.0.113.7"), Port: 4000}})
+
+	if resp, _ := s.ListTrendingArticles(context.Background(), &pb.ListTrendingArticlesRequest{}); resp.Code != response.CodeUnimplemented {
+		t.Fatalf("ListTrendingArticles without views = %v, want Unimplemented", resp)
+	}
+	viewRepo := repository.NewViewMemoryRepository(articles)
+	counter := views.NewCounter(views.NewMemoryDeduper(time.Hour), viewRepo, views.Options{FlushInterval: time.Hour})
+	s.EnableViews(counter, viewRepo)
+
+	popular, _ := s.CreateArticle(alice, &pb.CreateArticleRequest{Title: "Popular", Content: "World"})
+	quiet, _ := s.CreateArticle(bob, &pb.CreateArticleRequest{Title: "Quiet", Content: "World"})
+	popularID, quietID := popular.Data.Article.Id, quiet.Data.Article.Id
+
+	for _, ctx := range []context.Context{alice, alice, bob, anonymous, anonymous} {
+		s.GetArticle(ctx, &pb.GetArticleRequest{Id: popularID})
+	}
+	s.GetArticle(alice, &pb.GetArticleRequest{Id: quietID})
+	server.NewArticleServerV2(s).GetArticle(bob, &pbv2.GetArticleRequest{Name: fmt.Sprintf("articles/%d", quietID)})
+	s.GetArticle(bob, &pb.GetArticleRequest{Id: 99}) // Not found: not counted
+
+	// Stopping the counter flushes the views it counted
+	ctx, stop := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		counter.Run(ctx)
+		close(done)
+	}()
+	stop()
+	<-done
+
+	got, _ := s.GetArticle(alice, &pb.GetArticleRequest{Id: popularID})
+	if got.Data.Article.Article.ViewCount != 3 {
+		t.Errorf("view_count = %d, want 3 (Alice, Bob and one IP)", got.Data.Article.Article.ViewCount)
+	}
+
+	trending, _ := s.ListTrendingArticles(context.Background(), &pb.ListTrendingArticlesRequest{Window: pb.TrendingWindow_TRENDING_WINDOW_WEEK})
+	if trending.Code != response.CodeSuccess || len(trending.Data.Articles) != 2 {
+		t.Fatalf("ListTrendingArticles = %v, want 2 articles", trending)
+	}
+	first, second := trending.Data.Articles[0], trending.Data.Articles[1]
+	if first.Article.Article.Id != popularID || first.WindowViews != 3 || first.Article.User.GetName() != "Alice" || first.Article.Article.Content != "" {
+		t.Errorf("first = %v, want the popular article in the BASIC view with 3 views and its author", first)
+	}
+	if second.Article.Article.Id != quietID || second.WindowViews != 2 || second.Score >= first.Score {
+		t.Errorf("second = %v, want the quiet article with 2 views", second)
+	}
+
+	invalid, _ := s.ListTrendingArticles(context.Background(), &pb.ListTrendingArticlesRequest{Window: 9, Limit: 101})
+	if got := violatedFields(t, invalid.Details); invalid.Code != response.CodeInvalidRequest || !slices.Equal(got, []string{"window", "limit"}) {
+		t.Errorf("ListTrendingArticles(invalid) = %s %v, want InvalidRequest on window and limit", invalid.Code, got)
+	}
+}
+
+func TestRelatedArticles(t *testing.T) {
+	s := newTestServer()
+	alice, bob := authContext(t, 1), authContext(t, 2)
+
+	create := func(ctx context.Context, title, content string) int32 {
+		t.Helper()
+		resp, _ := s.CreateArticle(ctx, &pb.CreateArticleRequest{Title: title, Content: content})
+		if resp.Code != response.CodeSuccess {
+			t.Fatalf("CreateArticle(%q) = %v", title, resp)
+		}
+		return resp.Data.Article.Id
+	}
+	source := create(alice, "Go concurrency patterns", "Channels and goroutines in Go.")
+	similar := create(bob, "Concurrency in Go", "Goroutines explained.")
+	sameAuthor := create(alice, "Travel notes", "A week by the sea.")
+	create(bob, "Cooking pasta", "Boil water first.")
+
+	resp, _ := s.GetRelatedArticles(context.Background(), &pb.GetRelatedArticlesRequest{ArticleId: source})
+	if resp.Code != response.CodeSuccess || len(resp.Data.Articles) != 2 {
+		t.Fatalf("GetRelatedArticles = %v, want 2 articles", resp)
+	}
+	byID := make(map[int32]*pb.ArticleWithUser)
+	for _, related := range resp.Data.Articles {
+		byID[related.Article.Id] = related
+	}
+	if got := byID[similar]; got.GetUser().GetName() != "Bob" || got.Article.Content != "" {
+		t.Errorf("similar article = %v, want it in the BASIC view with its author", got)
+	}
+	if got := byID[sameAuthor]; got.GetUser().GetName() != "Alice" {
+		t.Errorf("same author article = %v, want it with its author", got)
+	}
+
+	// Deleted articles are not related anymore
+	s.DeleteArticle(bob, &pb.DeleteArticleRequest{Id: similar})
+	resp, _ = s.GetRelatedArticles(context.Background(), &pb.GetRelatedArticlesRequest{ArticleId: source, Limit: 1})
+	if len(resp.Data.Articles) != 1 || resp.Data.Articles[0].Article.Id != sameAuthor {
+		t.Errorf("GetRelatedArticles after delete = %v, want only %d", resp.Data.Articles, sameAuthor)
+	}
+
+	if missing, _ := s.GetRelatedArticles(context.Background(), &pb.GetRelatedArticlesRequest{ArticleId: 99}); missing.Code != response.CodeNotFound {
+		t.Errorf("GetRelatedArticles(missing) code = %s, want %s", missing.Code, response.CodeNotFound)
+	}
+	invalid, _ := s.GetRelatedArticles(context.Background(), &pb.GetRelatedArticlesRequest{ArticleId: source, Limit: 21})
+	if got := violatedFields(t, invalid.Details); invalid.Code != response.CodeInvalidRequest || !slices.Equal(got, []string{"limit"}) {
+		t.Errorf("GetRelatedArticles(invalid) = %s %v, want InvalidRequest on limit", invalid.Code, got)
+	}
+}
+
+func TestFollowsAndFeed(t *testing.T) {
+	articles := repository.NewArticleMemoryRepository()
+	s := newTestServerWithRepo(articles)
+	alice, bob := authContext(t, 1), authContext(t, 2)
+
+	if resp, _ := s.GetFeed(alice, &pb.GetFeedRequest{}); resp.Code != response.CodeUnimplemented {
+		t.Fatalf("GetFeed without follows = %v, want Unimplemented", resp)
+	}
+	s.EnableFollows(repository.NewFollowMemoryRepository(articles))
+
+	followed, _ := s.FollowAuthor(alice, &pb.FollowAuthorRequest{AuthorId: 2})
+	if followed.Code != response.CodeSuccess || !followed.Data.Changed || followed.Data.Follow.AuthorId != 2 {
+		t.Fatalf("FollowAuthor = %v, want a new follow of Bob", followed)
+	}
+	if again, _ := s.FollowAuthor(alice, &pb.FollowAuthorRequest{AuthorId: 2}); again.Code != response.CodeSuccess || again.Data.Changed {
+		t.Errorf("FollowAuthor(again) = %v, want unchanged", again)
+	}
+	if self, _ := s.FollowAuthor(alice, &pb.FollowAuthorRequest{AuthorId: 1}); self.Code != response.CodeInvalidRequest {
+		t.Errorf("FollowAuthor(self) code = %s, want %s", self.Code, response.CodeInvalidRequest)
+	}
+	if missing, _ := s.FollowAuthor(alice, &pb.FollowAuthorRequest{AuthorId: 3}); missing.Code != response.CodeInvalidRequest {
+		t.Errorf("FollowAuthor(unknown user) code = %s, want %s", missing.Code, response.CodeInvalidRequest)
+	}
+	following, _ := s.ListFollowing(alice, &pb.ListFollowingRequest{})
+	if following.Code != response.CodeSuccess || len(following.Data.Follows) != 1 || following.Data.Follows[0].AuthorId != 2 {
+		t.Errorf("ListFollowing = %v, want Bob", following)
+	}
+
+	var bobs []int32
+	for _, title := range []string{"First", "Second", "Third"} {
+		created, _ := s.CreateArticle(bob, &pb.CreateArticleRequest{Title: title, Content: "By Bob"})
+		bobs = append(bobs, created.Data.Article.Id)
+	}
+	s.CreateArticle(alice, &pb.CreateArticleRequest{Title: "Mine", Content: "Not in my feed"})
+
+	// Newest first across pages, with the authors
+	first, _ := s.GetFeed(alice, &pb.GetFeedRequest{PageSize: 2})
+	if first.Code != response.CodeSuccess || len(first.Data.Articles) != 2 || first.Data.NextPageToken == "" {
+		t.Fatalf("GetFeed page 1 = %v, want 2 articles and a next page", first)
+	}
+	if got := first.Data.Articles[0]; got.Article.Id != bobs[2] || got.User.GetName() != "Bob" || got.Article.Content != "" {
+		t.Errorf("GetFeed first article = %v, want Bob's newest in the BASIC view with its author", got)
+	}
+	second, _ := s.GetFeed(alice, &pb.GetFeedRequest{PageSize: 2, PageToken: first.Data.NextPageToken})
+	if len(second.Data.Articles) != 1 || second.Data.Articles[0].Article.Id != bobs[0] || second.Data.NextPageToken != "" {
+		t.Errorf("GetFeed page 2 = %v, want Bob's first article and no next page", second)
+	}
+	invalid, _ := s.GetFeed(alice, &pb.GetFeedRequest{PageToken: "not-a-token"})
+	if got := violatedFields(t, invalid.Details); invalid.Code != response.CodeInvalidRequest || !slices.Equal(got, []string{"page_token"}) {
+		t.Errorf("GetFeed(invalid token) = %s %v, want InvalidRequest on page_token", invalid.Code, got)
+	}
+	if anonymous, _ := s.GetFeed(context.Background(), &pb.GetFeedRequest{}); anonymous.Code != response.CodeUnauthenticated {
+		t.Errorf("GetFeed(anonymous) code = %s, want %s", anonymous.Code, response.CodeUnauthenticated)
+	}
+
+	if unfollowed, _ := s.UnfollowAuthor(alice, &pb.UnfollowAuthorRequest{AuthorId: 2}); unfollowed.Code != response.CodeSuccess || !unfollowed.Data.Changed {
+		t.Errorf("UnfollowAuthor = %v, want changed", unfollowed)
+	}
+	if empty, _ := s.GetFeed(alice, &pb.GetFeedRequest{}); empty.Code != response.CodeSuccess || len(empty.Data.Articles) != 0 {
+		t.Errorf("GetFeed after UnfollowAuthor = %v, want no articles", empty)
+	}
+}
@@ -0,0 +1,368 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/thatlq1812/service-2-article/internal/response"
+	"github.com/thatlq1812/service-2-article/internal/validator"
+	pb "github.com/thatlq1812/service-2-article/proto"
+	pbv2 "github.com/thatlq1812/service-2-article/proto/v2"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// ArticleServerV2 serves the article.v2 API from the same core and repository as v1.
+// Requests are translated to v1 messages so both versions share validation and business
+// rules; errors are returned as gRPC status errors instead of response envelopes.
+type ArticleServerV2 struct {
+	pbv2.UnimplementedArticleServiceServer
+	core *ArticleServer
+}
+
+func NewArticleServerV2(core *ArticleServer) *ArticleServerV2 {
+	return &ArticleServerV2{core: core}
+}
+
+// v2Fields maps v2 Article field names to the v1 fields they are loaded from
+var v2Fields = map[string]string{
+	"name":                 "id",
+	"title":                "title",
+	"content":              "content",
+	"content_format":       "content_format",
+	"content_html":         "content_html",
+	"author":               "user_id",
+	"excerpt":              "excerpt",
+	"word_count":           "word_count",
+	"reading_time_minutes": "reading_time_minutes",
+	"create_time":          "create_time",
+	"update_time":          "update_time",
+	"comment_count":        "comment_count",
+	"reaction_count":       "reaction_count",
+	"view_count":           "view_count",
+}
+
+// v2Orders maps the order_by values of v2 ListArticles to v1 orders
+var v2Orders = map[string]pb.ArticleOrder{
+	"":                    pb.ArticleOrder_ARTICLE_ORDER_NEWEST,
+	"create_time desc":    pb.ArticleOrder_ARTICLE_ORDER_NEWEST,
+	"reaction_count desc": pb.ArticleOrder_ARTICLE_ORDER_POPULAR,
+}
+
+// parseName extracts the ID from a resource name such as "articles/42"
+func parseName(name, collection string) (int32, bool) {
+	id, ok := strings.CutPrefix(name, collection+"/")
+	if !ok {
+		return 0, false
+	}
+	value, err := strconv.ParseInt(id, 10, 32)
+	if err != nil || value <= 0 {
+		return 0, false
+	}
+	return int32(value), true
+}
+
+// articleName formats the resource name of an article
+func articleName(id int32) string {
+	return fmt.Sprintf("articles/%d", id)
+}
+
+// userName formats the resource name of a user
+func userName(id int32) string {
+	return fmt.Sprintf("users/%d", id)
+}
+
+// v1Format maps a v2 content format to v1. UNSPECIFIED means PLAIN; unknown
+// values map to an invalid v1 value so the validator rejects them.
+func v1Format(format pbv2.ContentFormat) pb.ContentFormat {
+	switch format {
+	case pbv2.ContentFormat_CONTENT_FORMAT_UNSPECIFIED, pbv2.ContentFormat_CONTENT_FORMAT_PLAIN:
+		return pb.ContentFormat_CONTENT_FORMAT_PLAIN
+	case pbv2.ContentFormat_CONTENT_FORMAT_MARKDOWN:
+		return pb.ContentFormat_CONTENT_FORMAT_MARKDOWN
+	case pbv2.ContentFormat_CONTENT_FORMAT_HTML:
+		return pb.ContentFormat_CONTENT_FORMAT_HTML
+	default:
+		return pb.ContentFormat(-1)
+	}
+}
+
+// v2Format maps a stored v1 content format to v2
+func v2Format(format pb.ContentFormat) pbv2.ContentFormat {
+	switch format {
+	case pb.ContentFormat_CONTENT_FORMAT_MARKDOWN:
+		return pbv2.ContentFormat_CONTENT_FORMAT_MARKDOWN
+	case pb.ContentFormat_CONTENT_FORMAT_HTML:
+		return pbv2.ContentFormat_CONTENT_FORMAT_HTML
+	default:
+		return pbv2.ContentFormat_CONTENT_FORMAT_PLAIN
+	}
+}
+
+// toV2Article converts a v1 article loaded with fields (nil for every field).
+// Fields that were not loaded are zero in v1 and stay zero (UNSPECIFIED) here.
+func toV2Article(article *pb.Article, fields []string) *pbv2.Article {
+	result := &pbv2.Article{
+		Name:               articleName(article.Id),
+		Title:              article.Title,
+		Content:            article.Content,
+		ContentHtml:        article.ContentHtml,
+		Author:             userName(article.UserId),
+		Excerpt:            article.Excerpt,
+		WordCount:          article.WordCount,
+		ReadingTimeMinutes: article.ReadingTimeMinutes,
+		CreateTime:         article.CreateTime,
+		UpdateTime:         article.UpdateTime,
+		CommentCount:       article.CommentCount,
+		ReactionCount:      article.ReactionCount,
+		ViewCount:          article.ViewCount,
+	}
+	// PLAIN is the zero value in v1, so only report a format that was actually loaded
+	if fields == nil || slices.Contains(fields, "content_format") {
+		result.ContentFormat = v2Format(article.ContentFormat)
+	}
+	return result
+}
+
+// v1Mask translates a v2 read mask to v1 field names, reporting unknown paths against field
+func v1Mask(mask *fieldmaskpb.FieldMask, field string) (*fieldmaskpb.FieldMask, validator.Violations) {
+	if mask == nil {
+		return nil, nil
+	}
+	var violations validator.Violations
+	paths := make([]string, 0, len(mask.Paths))
+	for _, path := range mask.Paths {
+		v1Path, ok := v2Fields[path]
+		if !ok {
+			violations = append(violations, response.FieldViolation{Field: field, Description: fmt.Sprintf("unknown field path %q", path)})
+			continue
+		}
+		paths = append(paths, v1Path)
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}, violations
+}
+
+// violationsOf returns the violations in a validator error
+func violationsOf(err error) validator.Violations {
+	var violations validator.Violations
+	if errors.As(err, &violations) {
+		return violations
+	}
+	return nil
+}
+
+// renameFields rewrites v1 field names in violations to their v2 equivalents
+func renameFields(violations validator.Violations, names map[string]string) validator.Violations {
+	renamed := make(validator.Violations, 0, len(violations))
+	for _, v := range violations {
+		if name, ok := names[v.Field]; ok {
+			v.Field = name
+		}
+		renamed = append(renamed, v)
+	}
+	return renamed
+}
+
+// articleFieldNames renames violations of fields nested in the request's article
+var articleFieldNames = map[string]string{
+	"id":             "article.name",
+	"title":          "article.title",
+	"content":        "article.content",
+	"content_format": "article.content_format",
+}
+
+// Page tokens are opaque to clients; they encode the offset of the next page
+func encodePageToken(offset int32) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(int(offset))))
+}
+
+func decodePageToken(token string) (int32, bool) {
+	if token == "" {
+		return 0, true
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, false
+	}
+	offset, err := strconv.ParseInt(string(raw), 10, 32)
+	if err != nil || offset < 0 {
+		return 0, false
+	}
+	return int32(offset), true
+}
+
+// CreateArticle creates an article authored by the authenticated user
+func (s *ArticleServerV2) CreateArticle(ctx context.Context, req *pbv2.CreateArticleRequest) (*pbv2.Article, error) {
+	userID, err := s.core.authenticate(ctx, "CreateArticle")
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Article == nil {
+		return nil, invalidArgument(validator.Violations{{Field: "article", Description: "is required"}})
+	}
+	v1Req := &pb.CreateArticleRequest{
+		Title:         req.Article.Title,
+		Content:       req.Article.Content,
+		ContentFormat: v1Format(req.Article.ContentFormat),
+	}
+	if err := validator.ValidateCreateArticle(v1Req); err != nil {
+		log.Printf("[v2.CreateArticle] Invalid argument: %v", err)
+		return nil, invalidArgument(renameFields(violationsOf(err), articleFieldNames))
+	}
+
+	article, err := s.core.createArticle(ctx, &pb.Article{
+		Title:         v1Req.Title,
+		Content:       v1Req.Content,
+		UserId:        userID,
+		ContentFormat: v1Req.ContentFormat,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toV2Article(article, nil), nil
+}
+
+// GetArticle returns an article; view and read_mask select the fields
+func (s *ArticleServerV2) GetArticle(ctx context.Context, req *pbv2.GetArticleRequest) (*pbv2.Article, error) {
+	var violations validator.Violations
+	id, ok := parseName(req.Name, "articles")
+	if !ok {
+		violations = append(violations, response.FieldViolation{Field: "name", Description: "must be of the form articles/{article}"})
+	}
+	mask, maskViolations := v1Mask(req.ReadMask, "read_mask")
+	violations = append(violations, maskViolations...)
+	v1Req := &pb.GetArticleRequest{Id: id, View: pb.ArticleView(req.View), ReadMask: mask}
+	if ok {
+		// Validate the translated request so both versions share the same rules
+		violations = append(violations, violationsOf(validator.ValidateGetArticle(v1Req))...)
+	}
+	if len(violations) > 0 {
+		log.Printf("[v2.GetArticle] Invalid argument: %v", violations)
+		return nil, invalidArgument(violations)
+	}
+
+	fields := readFields(v1Req.ReadMask, v1Req.View, pb.ArticleView_ARTICLE_VIEW_FULL)
+	article, err := s.core.loadArticle(ctx, id, fields)
+	if err != nil {
+		return nil, err
+	}
+	s.core.recordView(ctx, id)
+	return toV2Article(article, fields), nil
+}
+
+// UpdateArticle updates the fields listed in update_mask ("*" for all updatable fields)
+func (s *ArticleServerV2) UpdateArticle(ctx context.Context, req *pbv2.UpdateArticleRequest) (*pbv2.Article, error) {
+	if req.Article == nil {
+		return nil, invalidArgument(validator.Violations{{Field: "article", Description: "is required"}})
+	}
+	id, ok := parseName(req.Article.Name, "articles")
+	if !ok {
+		return nil, invalidArgument(validator.Violations{{Field: "article.name", Description: "must be of the form articles/{article}"}})
+	}
+
+	v1Req := &pb.UpdateArticleRequest{
+		Id:      id,
+		Title:   req.Article.Title,
+		Content: req.Article.Content,
+	}
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 1 && paths[0] == "*" {
+		paths = validator.UpdatableFields
+	}
+	if len(paths) > 0 {
+		v1Req.UpdateMask = &fieldmaskpb.FieldMask{Paths: paths}
+	}
+	// Without a mask, UNSPECIFIED leaves the format unchanged; with a mask it means PLAIN
+	if slices.Contains(paths, "content_format") || (len(paths) == 0 && req.Article.ContentFormat != pbv2.ContentFormat_CONTENT_FORMAT_UNSPECIFIED) {
+		format := v1Format(req.Article.ContentFormat)
+		v1Req.ContentFormat = &format
+	}
+
+	if err := validator.ValidateUpdateArticle(v1Req); err != nil {
+		log.Printf("[v2.UpdateArticle] Invalid argument: %v", err)
+		return nil, invalidArgument(renameFields(violationsOf(err), articleFieldNames))
+	}
+
+	article, err := s.core.updateArticle(ctx, v1Req)
+	if err != nil {
+		return nil, err
+	}
+	return toV2Article(article, nil), nil
+}
+
+// DeleteArticle deletes an article
+func (s *ArticleServerV2) DeleteArticle(ctx context.Context, req *pbv2.DeleteArticleRequest) (*emptypb.Empty, error) {
+	id, ok := parseName(req.Name, "articles")
+	if !ok {
+		return nil, invalidArgument(validator.Violations{{Field: "name", Description: "must be of the form articles/{article}"}})
+	}
+	if err := s.core.deleteArticle(ctx, id); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ListArticles returns a page of articles, newest or most reacted first, optionally filtered by author.
+// Unlike v1, authors are returned by resource name only and not resolved through User Service.
+func (s *ArticleServerV2) ListArticles(ctx context.Context, req *pbv2.ListArticlesRequest) (*pbv2.ListArticlesResponse, error) {
+	var violations validator.Violations
+	var userID int32
+	if req.Author != "" {
+		id, ok := parseName(req.Author, "users")
+		if !ok {
+			violations = append(violations, response.FieldViolation{Field: "author", Description: "must be of the form users/{user}"})
+		}
+		userID = id
+	}
+	offset, ok := decodePageToken(req.PageToken)
+	if !ok {
+		violations = append(violations, response.FieldViolation{Field: "page_token", Description: "is not a valid page token"})
+	}
+	order, ok := v2Orders[strings.ToLower(strings.Join(strings.Fields(req.OrderBy), " "))]
+	if !ok {
+		violations = append(violations, response.FieldViolation{Field: "order_by", Description: `must be "create_time desc" or "reaction_count desc"`})
+	}
+	mask, maskViolations := v1Mask(req.ReadMask, "read_mask")
+	violations = append(violations, maskViolations...)
+	v1Req := &pb.ListArticlesRequest{PageSize: req.PageSize, UserId: userID, View: pb.ArticleView(req.View), ReadMask: mask, OrderBy: order}
+	violations = append(violations, violationsOf(validator.ValidateListArticles(v1Req))...)
+	if req.PageSize > validator.MaxPageSize {
+		// v1 clamps page_size; v2 rejects it
+		violations = append(violations, response.FieldViolation{Field: "page_size", Description: fmt.Sprintf("must be at most %d", validator.MaxPageSize)})
+	}
+	if len(violations) > 0 {
+		log.Printf("[v2.ListArticles] Invalid argument: %v", violations)
+		return nil, invalidArgument(violations)
+	}
+
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+
+	fields := readFields(v1Req.ReadMask, v1Req.View, pb.ArticleView_ARTICLE_VIEW_BASIC)
+	articles, total, err := s.core.listPage(ctx, userID, order, pageSize, offset, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pbv2.ListArticlesResponse{
+		Articles:  make([]*pbv2.Article, 0, len(articles)),
+		TotalSize: total,
+	}
+	for _, article := range articles {
+		resp.Articles = append(resp.Articles, toV2Article(article, fields))
+	}
+	if next := offset + int32(len(articles)); len(articles) > 0 && next < total {
+		resp.NextPageToken = encodePageToken(next)
+	}
+	return resp, nil
+}
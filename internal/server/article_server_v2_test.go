@@ -0,0 +1,153 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/thatlq1812/service-2-article/internal/server"
+	pb "github.com/thatlq1812/service-2-article/proto"
+	pbv2 "github.com/thatlq1812/service-2-article/proto/v2"
+)
+
+// badRequestFields returns the BadRequest field names of a status error
+func badRequestFields(t *testing.T, err error) []string {
+	t.Helper()
+
+	var fields []string
+	for _, d := range status.Convert(err).Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			for _, v := range br.FieldViolations {
+				fields = append(fields, v.Field)
+			}
+		}
+	}
+	return fields
+}
+
+func TestArticleServerV2Lifecycle(t *testing.T) {
+	core := newTestServer()
+	s := server.NewArticleServerV2(core)
+	ctx := authContext(t, 1)
+
+	created, err := s.CreateArticle(ctx, &pbv2.CreateArticleRequest{Article: &pbv2.Article{
+		Title:         "Hello",
+		Content:       "# World",
+		ContentFormat: pbv2.ContentFormat_CONTENT_FORMAT_MARKDOWN,
+	}})
+	if err != nil {
+		t.Fatalf("CreateArticle failed: %v", err)
+	}
+	if created.Author != "users/1" || created.ContentHtml == "" || created.CreateTime == nil {
+		t.Errorf("CreateArticle = %+v, want author users/1, rendered HTML and create_time", created)
+	}
+
+	// Both versions read the same repository
+	v1, err := core.GetArticle(ctx, &pb.GetArticleRequest{Id: 1})
+	if err != nil || v1.Data.Article.Article.Title != "Hello" {
+		t.Fatalf("v1 GetArticle = %v, %v; want the v2 article", v1, err)
+	}
+
+	updated, err := s.UpdateArticle(ctx, &pbv2.UpdateArticleRequest{
+		Article:    &pbv2.Article{Name: created.Name, Title: "Hello again"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateArticle failed: %v", err)
+	}
+	if updated.Title != "Hello again" || updated.Content != "# World" || updated.ContentFormat != pbv2.ContentFormat_CONTENT_FORMAT_MARKDOWN {
+		t.Errorf("UpdateArticle = %+v, want new title and unchanged content", updated)
+	}
+
+	got, err := s.GetArticle(ctx, &pbv2.GetArticleRequest{Name: created.Name, ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}}})
+	if err != nil {
+		t.Fatalf("GetArticle failed: %v", err)
+	}
+	if got.Title != "Hello again" || got.Content != "" || got.Name != created.Name || got.Author != "users/1" {
+		t.Errorf("GetArticle(read_mask=title) = %+v, want title, name and author only", got)
+	}
+
+	if _, err := s.DeleteArticle(ctx, &pbv2.DeleteArticleRequest{Name: created.Name}); err != nil {
+		t.Fatalf("DeleteArticle failed: %v", err)
+	}
+	if _, err := s.GetArticle(ctx, &pbv2.GetArticleRequest{Name: created.Name}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetArticle after delete = %v, want NotFound", err)
+	}
+}
+
+func TestArticleServerV2Errors(t *testing.T) {
+	s := server.NewArticleServerV2(newTestServer())
+	ctx := authContext(t, 1)
+
+	_, err := s.CreateArticle(context.Background(), &pbv2.CreateArticleRequest{Article: &pbv2.Article{Title: "t", Content: "c"}})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("CreateArticle without token = %v, want Unauthenticated", err)
+	}
+
+	_, err = s.CreateArticle(ctx, &pbv2.CreateArticleRequest{Article: &pbv2.Article{Content: "c", ContentFormat: 9}})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("CreateArticle(invalid) = %v, want InvalidArgument", err)
+	}
+	if got := badRequestFields(t, err); len(got) != 2 || got[0] != "article.title" || got[1] != "article.content_format" {
+		t.Errorf("CreateArticle violations = %v, want [article.title article.content_format]", got)
+	}
+
+	_, err = s.GetArticle(ctx, &pbv2.GetArticleRequest{Name: "posts/1"})
+	if status.Code(err) != codes.InvalidArgument || badRequestFields(t, err)[0] != "name" {
+		t.Errorf("GetArticle(bad name) = %v, want InvalidArgument on name", err)
+	}
+
+	_, err = s.ListArticles(ctx, &pbv2.ListArticlesRequest{PageToken: "!", ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"id"}}})
+	if got := badRequestFields(t, err); status.Code(err) != codes.InvalidArgument || len(got) != 2 {
+		t.Errorf("ListArticles(bad token and mask) = %v (%v), want InvalidArgument on page_token and read_mask", err, got)
+	}
+
+	_, err = s.ListArticles(ctx, &pbv2.ListArticlesRequest{OrderBy: "title"})
+	if got := badRequestFields(t, err); status.Code(err) != codes.InvalidArgument || len(got) != 1 || got[0] != "order_by" {
+		t.Errorf("ListArticles(bad order_by) = %v (%v), want InvalidArgument on order_by", err, got)
+	}
+}
+
+func TestArticleServerV2ListPages(t *testing.T) {
+	s := server.NewArticleServerV2(newTestServer())
+	ctx := authContext(t, 1)
+
+	for _, title := range []string{"one", "two", "three"} {
+		if _, err := s.CreateArticle(ctx, &pbv2.CreateArticleRequest{Article: &pbv2.Article{Title: title, Content: "body"}}); err != nil {
+			t.Fatalf("CreateArticle(%s) failed: %v", title, err)
+		}
+	}
+
+	var names []string
+	req := &pbv2.ListArticlesRequest{PageSize: 2, Author: "users/1"}
+	for page := 0; ; page++ {
+		resp, err := s.ListArticles(ctx, req)
+		if err != nil {
+			t.Fatalf("ListArticles page %d failed: %v", page, err)
+		}
+		if resp.TotalSize != 3 {
+			t.Errorf("ListArticles total_size = %d, want 3", resp.TotalSize)
+		}
+		for _, a := range resp.Articles {
+			if a.Content != "" {
+				t.Errorf("ListArticles returned content %q, want BASIC view", a.Content)
+			}
+			names = append(names, a.Name)
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		req.PageToken = resp.NextPageToken
+	}
+	if len(names) != 3 {
+		t.Errorf("ListArticles pages returned %v, want 3 articles", names)
+	}
+
+	if _, err := s.ListArticles(ctx, &pbv2.ListArticlesRequest{PageSize: 500}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ListArticles(page_size 500) = %v, want InvalidArgument", err)
+	}
+}
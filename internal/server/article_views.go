@@ -0,0 +1,119 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"time"
+
+	"github.com/thatlq1812/service-2-article/internal/auth"
+	"github.com/thatlq1812/service-2-article/internal/repository"
+	"github.com/thatlq1812/service-2-article/internal/response"
+	"github.com/thatlq1812/service-2-article/internal/validator"
+	pb "github.com/thatlq1812/service-2-article/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+)
+
+// ViewRecorder counts article views in the background (implemented by views.Counter).
+// Record must not block.
+type ViewRecorder interface {
+	Record(articleID int32, viewer string)
+}
+
+// EnableViews counts GetArticle views with recorder and serves ListTrendingArticles from repo;
+// without it views are not counted and ListTrendingArticles returns Unimplemented
+func (s *ArticleServer) EnableViews(recorder ViewRecorder, repo repository.ViewRepository) {
+	s.viewRecorder = recorder
+	s.views = repo
+}
+
+// errViewsDisabled is returned by ListTrendingArticles when view counting is not enabled
+var errViewsDisabled = response.StatusError(codes.Unimplemented, "view counting is not enabled on this server")
+
+// trendingWindow is the period and decay of a TrendingWindow
+type trendingWindow struct {
+	length   time.Duration
+	halfLife time.Duration // Views lose half their weight every halfLife
+}
+
+var trendingWindows = map[pb.TrendingWindow]trendingWindow{
+	pb.TrendingWindow_TRENDING_WINDOW_UNSPECIFIED: {length: 24 * time.Hour, halfLife: 6 * time.Hour},
+	pb.TrendingWindow_TRENDING_WINDOW_DAY:         {length: 24 * time.Hour, halfLife: 6 * time.Hour},
+	pb.TrendingWindow_TRENDING_WINDOW_WEEK:        {length: 7 * 24 * time.Hour, halfLife: 48 * time.Hour},
+}
+
+// viewerKey identifies who viewed an article: the user of a valid JWT, or the peer IP otherwise.
+// The token is only verified, not checked against the blacklist, which would cost a Redis call.
+func (s *ArticleServer) viewerKey(ctx context.Context) string {
+	if userID, err := auth.GetUserIDFromContext(ctx, s.jwtSecret); err == nil {
+		return fmt.Sprintf("user:%d", userID)
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		host, _, err := net.SplitHostPort(p.Addr.String())
+		if err != nil {
+			host = p.Addr.String()
+		}
+		return "ip:" + host
+	}
+	return "ip:unknown"
+}
+
+// recordView hands a view of article id to the recorder, when views are counted
+func (s *ArticleServer) recordView(ctx context.Context, id int32) {
+	if s.viewRecorder != nil {
+		s.viewRecorder.Record(id, s.viewerKey(ctx))
+	}
+}
+
+// listTrending ranks articles over window and attaches their authors, asking User Service once per author
+func (s *ArticleServer) listTrending(ctx context.Context, req *pb.ListTrendingArticlesRequest) ([]*pb.TrendingArticle, error) {
+	window := trendingWindows[req.Window]
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	fields := readFields(req.ReadMask, req.View, pb.ArticleView_ARTICLE_VIEW_BASIC)
+
+	// Buckets are hourly, so the window starts at the beginning of its first hour
+	now := time.Now()
+	since := now.Add(-window.length).Truncate(time.Hour)
+	trending, err := s.views.ListTrending(ctx, since, now, window.halfLife, limit, fields...)
+	if err != nil {
+		log.Printf("[ListTrendingArticles] Database error: window=%s, error=%v", req.Window, err)
+		return nil, response.StatusError(response.GRPCCodeFromError(err), "failed to list trending articles")
+	}
+
+	articles := make([]*pb.Article, len(trending))
+	for i, entry := range trending {
+		articles[i] = entry.Article.Article
+	}
+	authors := s.fetchAuthors(ctx, "ListTrendingArticles", articles)
+	for _, entry := range trending {
+		entry.Article.User = authors[entry.Article.Article.UserId]
+	}
+	return trending, nil
+}
+
+// ListTrendingArticles ranks articles by their deduplicated views in the window, recent views
+// weighing more. No authentication is needed.
+func (s *ArticleServer) ListTrendingArticles(ctx context.Context, req *pb.ListTrendingArticlesRequest) (*pb.ListTrendingArticlesResponse, error) {
+	if s.views == nil {
+		code, message, details := fromStatus(errViewsDisabled)
+		return response.ListTrendingArticlesError(code, message, details...), nil
+	}
+	if err := validator.ValidateListTrendingArticles(req); err != nil {
+		log.Printf("[ListTrendingArticles] Invalid argument: %v", err)
+		message, details := validationFailure(err)
+		return response.ListTrendingArticlesError(codes.InvalidArgument, message, details...), nil
+	}
+
+	trending, err := s.listTrending(ctx, req)
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.ListTrendingArticlesError(code, message, details...), nil
+	}
+	return response.ListTrendingArticlesSuccess(trending), nil
+}
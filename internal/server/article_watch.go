@@ -0,0 +1,234 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thatlq1812/service-2-article/internal/outbox"
+	"github.com/thatlq1812/service-2-article/internal/response"
+	"github.com/thatlq1812/service-2-article/internal/validator"
+	pb "github.com/thatlq1812/service-2-article/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultHeartbeatInterval = 30 * time.Second
+
+	// resumeOverlap is how many event ids before a resume token are replayed again. Ids are
+	// assigned when a transaction writes its events but delivered when it commits, so an event
+	// with a lower id than one already sent can still arrive (e.g. from a long import batch).
+	// Replaying the overlap keeps a resume from missing it, at the cost of duplicates.
+	resumeOverlap = 1000
+
+	// recentWindow is how many sent event ids a watch remembers, to skip live events it replayed
+	recentWindow = 4096
+)
+
+// ArticleEvents is the change feed WatchArticles reads (implemented by outbox.Hub)
+type ArticleEvents interface {
+	// Subscribe returns events committed from now on; the channel is closed if events were missed
+	Subscribe() (<-chan *pb.ArticleEvent, func())
+	// Replay calls fn for every stored event with an id above afterID, in id order
+	Replay(ctx context.Context, afterID int64, fn func(*pb.ArticleEvent) error) error
+	// LastID returns the highest stored event id
+	LastID(ctx context.Context) (int64, error)
+}
+
+// EnableWatch serves WatchArticles from events; resume tokens older than retention are
+// rejected since the events they point at may have been pruned (0 keeps them forever)
+func (s *ArticleServer) EnableWatch(events ArticleEvents, retention time.Duration) {
+	s.events = events
+	s.eventsRetention = retention
+}
+
+// encodeResumeToken returns the opaque token for a position in the change feed
+func encodeResumeToken(id int64, issued time.Time) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d:%d", id, issued.Unix())))
+}
+
+func decodeResumeToken(token string) (int64, time.Time, bool) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, time.Time{}, false
+	}
+	idPart, issuedPart, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return 0, time.Time{}, false
+	}
+	id, err := strconv.ParseInt(idPart, 10, 64)
+	if err != nil || id < 0 {
+		return 0, time.Time{}, false
+	}
+	issued, err := strconv.ParseInt(issuedPart, 10, 64)
+	if err != nil {
+		return 0, time.Time{}, false
+	}
+	return id, time.Unix(issued, 0), true
+}
+
+// recentIDs remembers the last recentWindow ids added
+type recentIDs struct {
+	ring []int64
+	next int
+	seen map[int64]struct{}
+}
+
+func newRecentIDs() *recentIDs {
+	return &recentIDs{ring: make([]int64, 0, recentWindow), seen: make(map[int64]struct{}, recentWindow)}
+}
+
+// add records id and reports whether it is new
+func (r *recentIDs) add(id int64) bool {
+	if _, ok := r.seen[id]; ok {
+		return false
+	}
+	if len(r.ring) < recentWindow {
+		r.ring = append(r.ring, id)
+	} else {
+		delete(r.seen, r.ring[r.next])
+		r.ring[r.next] = id
+		r.next = (r.next + 1) % recentWindow
+	}
+	r.seen[id] = struct{}{}
+	return true
+}
+
+// articleWatch is the state of one WatchArticles stream
+type articleWatch struct {
+	req    *pb.WatchArticlesRequest
+	stream pb.ArticleService_WatchArticlesServer
+	last   int64 // highest event id seen, whether sent or filtered out
+	recent *recentIDs
+}
+
+// matches applies the request filters
+func (w *articleWatch) matches(event *pb.ArticleEvent) bool {
+	if len(w.req.EventTypes) > 0 && !slices.Contains(w.req.EventTypes, outbox.Type(event)) {
+		return false
+	}
+	return w.req.UserId == 0 || outbox.Article(event).GetUserId() == w.req.UserId
+}
+
+// deliver sends event unless it is a duplicate or filtered out, and reports whether it was sent
+func (w *articleWatch) deliver(event *pb.ArticleEvent) (bool, error) {
+	if !w.recent.add(event.Id) {
+		return false, nil
+	}
+	w.last = max(w.last, event.Id)
+	if !w.matches(event) {
+		return false, nil
+	}
+	return true, w.stream.Send(&pb.WatchArticlesResponse{
+		ResumeToken: encodeResumeToken(w.last, time.Now()),
+		Item:        &pb.WatchArticlesResponse_Event{Event: event},
+	})
+}
+
+// WatchArticles streams article changes as they are committed on any replica. With a resume
+// token, the changes since that token are replayed first. A heartbeat carrying a fresh resume
+// token is sent whenever no event was sent for the heartbeat interval.
+func (s *ArticleServer) WatchArticles(req *pb.WatchArticlesRequest, stream pb.ArticleService_WatchArticlesServer) error {
+	// Validate input
+	if err := validator.ValidateWatchArticles(req); err != nil {
+		log.Printf("[WatchArticles] Invalid argument: %v", err)
+		return invalidArgument(err)
+	}
+	if s.events == nil {
+		return response.StatusError(codes.Unimplemented, "WatchArticles is disabled on this server")
+	}
+
+	var resumeID int64
+	if req.ResumeToken != "" {
+		id, issued, ok := decodeResumeToken(req.ResumeToken)
+		if !ok {
+			return invalidArgument(validator.Violations{{Field: "resume_token", Description: "is not a valid resume token"}})
+		}
+		if s.eventsRetention > 0 && time.Since(issued) > s.eventsRetention {
+			return response.StatusError(codes.OutOfRange, "resume token has expired; reload articles and watch without a token",
+				response.ErrorInfo(response.ReasonResumeTokenExpired, map[string]string{"retention": s.eventsRetention.String()}))
+		}
+		resumeID = id
+	}
+
+	ctx := stream.Context()
+	// Subscribe before replaying so nothing committed in between is lost
+	events, unsubscribe := s.events.Subscribe()
+	defer unsubscribe()
+
+	w := &articleWatch{req: req, stream: stream, recent: newRecentIDs()}
+	if req.ResumeToken != "" {
+		w.last = resumeID
+		err := s.events.Replay(ctx, max(resumeID-resumeOverlap, 0), func(event *pb.ArticleEvent) error {
+			_, err := w.deliver(event)
+			return err
+		})
+		if err != nil {
+			return watchError(ctx, "replay", err)
+		}
+	} else {
+		last, err := s.events.LastID(ctx)
+		if err != nil {
+			return watchError(ctx, "read position", err)
+		}
+		w.last = last
+	}
+	log.Printf("[WatchArticles] Started: user_id=%d, event_types=%v, resumed=%t", req.UserId, req.EventTypes, req.ResumeToken != "")
+
+	interval := defaultHeartbeatInterval
+	if req.HeartbeatInterval != nil {
+		interval = req.HeartbeatInterval.AsDuration()
+	}
+	heartbeat := time.NewTimer(interval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+
+		case event, ok := <-events:
+			if !ok {
+				return response.StatusError(codes.Unavailable, "change feed interrupted; reconnect with the last resume_token")
+			}
+			sent, err := w.deliver(event)
+			if err != nil {
+				return err
+			}
+			if sent {
+				heartbeat.Reset(interval)
+			}
+
+		case now := <-heartbeat.C:
+			err := stream.Send(&pb.WatchArticlesResponse{
+				ResumeToken: encodeResumeToken(w.last, now),
+				Item:        &pb.WatchArticlesResponse_Heartbeat{Heartbeat: &pb.Heartbeat{Time: timestamppb.New(now)}},
+			})
+			if err != nil {
+				return err
+			}
+			heartbeat.Reset(interval)
+		}
+	}
+}
+
+// watchError converts a change feed failure to a status
+func watchError(ctx context.Context, step string, err error) error {
+	if ctx.Err() != nil {
+		return status.FromContextError(ctx.Err()).Err()
+	}
+	if _, ok := status.FromError(err); ok {
+		// Already a status, e.g. from stream.Send
+		return err
+	}
+	log.Printf("[WatchArticles] ERROR: Failed to %s: %v", step, err)
+	return response.StatusError(codes.Unavailable, "change feed unavailable")
+}
@@ -0,0 +1,220 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/thatlq1812/service-2-article/internal/repository"
+	"github.com/thatlq1812/service-2-article/internal/response"
+	"github.com/thatlq1812/service-2-article/internal/validator"
+	pb "github.com/thatlq1812/service-2-article/proto"
+
+	"google.golang.org/grpc/codes"
+)
+
+// EnableWebhooks serves the webhook RPCs from repo; without it they return Unimplemented
+func (s *ArticleServer) EnableWebhooks(repo repository.WebhookRepository) {
+	s.webhooks = repo
+}
+
+// errWebhooksDisabled is returned by the webhook RPCs when webhooks are not enabled
+var errWebhooksDisabled = response.StatusError(codes.Unimplemented, "webhooks are not enabled on this server")
+
+func webhookNotFound(id int32) error {
+	return response.StatusError(codes.NotFound, fmt.Sprintf("webhook with ID %d not found", id),
+		response.ErrorInfo(response.ReasonWebhookNotFound, map[string]string{"webhook_id": fmt.Sprint(id)}))
+}
+
+// createWebhook stores a webhook owned by userID
+func (s *ArticleServer) createWebhook(ctx context.Context, userID int32, req *pb.CreateWebhookRequest) (*pb.Webhook, error) {
+	created, err := s.webhooks.CreateWebhook(ctx, &pb.Webhook{
+		UserId:     userID,
+		Url:        req.Url,
+		EventTypes: req.EventTypes,
+		AuthorId:   req.AuthorId,
+	}, req.Secret)
+	if err != nil {
+		log.Printf("[CreateWebhook] Database error: user_id=%d, error=%v", userID, err)
+		return nil, response.StatusError(response.GRPCCodeFromError(err), "failed to create webhook")
+	}
+	log.Printf("[CreateWebhook] Success: webhook_id=%d, user_id=%d", created.Id, userID)
+	return created, nil
+}
+
+// deleteWebhook deletes a webhook owned by userID; other users' webhooks are reported as not found
+func (s *ArticleServer) deleteWebhook(ctx context.Context, userID, id int32) error {
+	if err := s.webhooks.DeleteWebhook(ctx, userID, id); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return webhookNotFound(id)
+		}
+		log.Printf("[DeleteWebhook] Database error: webhook_id=%d, error=%v", id, err)
+		return response.StatusError(response.GRPCCodeFromError(err), "failed to delete webhook")
+	}
+	log.Printf("[DeleteWebhook] Success: webhook_id=%d, user_id=%d", id, userID)
+	return nil
+}
+
+// listWebhookDeliveries returns one page of deliveries of a webhook owned by userID
+func (s *ArticleServer) listWebhookDeliveries(ctx context.Context, userID int32, req *pb.ListWebhookDeliveriesRequest, limit, offset int32) ([]*pb.WebhookDelivery, int32, error) {
+	deliveries, total, err := s.webhooks.ListDeliveries(ctx, userID, req.WebhookId, req.Status, limit, offset)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, 0, webhookNotFound(req.WebhookId)
+		}
+		log.Printf("[ListWebhookDeliveries] Database error: webhook_id=%d, error=%v", req.WebhookId, err)
+		return nil, 0, response.StatusError(response.GRPCCodeFromError(err), "failed to list webhook deliveries")
+	}
+	return deliveries, total, nil
+}
+
+// retryWebhookDelivery re-queues a dead-lettered delivery of a webhook owned by userID
+func (s *ArticleServer) retryWebhookDelivery(ctx context.Context, userID int32, id int64) (*pb.WebhookDelivery, error) {
+	delivery, err := s.webhooks.RetryDelivery(ctx, userID, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrNotFound):
+			return nil, response.StatusError(codes.NotFound, fmt.Sprintf("delivery with ID %d not found", id),
+				response.ErrorInfo(response.ReasonDeliveryNotFound, map[string]string{"delivery_id": fmt.Sprint(id)}))
+		case errors.Is(err, repository.ErrConflict):
+			return nil, response.StatusError(codes.FailedPrecondition, "only dead deliveries can be retried",
+				response.ErrorInfo(response.ReasonDeliveryNotDead, map[string]string{"delivery_id": fmt.Sprint(id)}),
+				response.PreconditionFailure("STATUS", fmt.Sprintf("delivery:%d", id), "delivery is not DEAD"))
+		}
+		log.Printf("[RetryWebhookDelivery] Database error: delivery_id=%d, error=%v", id, err)
+		return nil, response.StatusError(response.GRPCCodeFromError(err), "failed to retry webhook delivery")
+	}
+	log.Printf("[RetryWebhookDelivery] Success: delivery_id=%d, user_id=%d", id, userID)
+	return delivery, nil
+}
+
+// CreateWebhook subscribes a URL to article events; the caller owns the webhook
+func (s *ArticleServer) CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.CreateWebhookResponse, error) {
+	if s.webhooks == nil {
+		code, message, details := fromStatus(errWebhooksDisabled)
+		return response.CreateWebhookError(code, message, details...), nil
+	}
+	userID, err := s.authenticate(ctx, "CreateWebhook")
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.CreateWebhookError(code, message, details...), nil
+	}
+	if err := validator.ValidateCreateWebhook(req); err != nil {
+		log.Printf("[CreateWebhook] Invalid argument: %v", err)
+		message, details := validationFailure(err)
+		return response.CreateWebhookError(codes.InvalidArgument, message, details...), nil
+	}
+
+	webhook, err := s.createWebhook(ctx, userID, req)
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.CreateWebhookError(code, message, details...), nil
+	}
+	return response.CreateWebhookSuccess(webhook), nil
+}
+
+// ListWebhooks lists the caller's webhooks
+func (s *ArticleServer) ListWebhooks(ctx context.Context, req *pb.ListWebhooksRequest) (*pb.ListWebhooksResponse, error) {
+	if s.webhooks == nil {
+		code, message, details := fromStatus(errWebhooksDisabled)
+		return response.ListWebhooksError(code, message, details...), nil
+	}
+	userID, err := s.authenticate(ctx, "ListWebhooks")
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.ListWebhooksError(code, message, details...), nil
+	}
+
+	webhooks, err := s.webhooks.ListWebhooks(ctx, userID)
+	if err != nil {
+		log.Printf("[ListWebhooks] Database error: user_id=%d, error=%v", userID, err)
+		return response.ListWebhooksError(response.GRPCCodeFromError(err), "failed to list webhooks"), nil
+	}
+	return response.ListWebhooksSuccess(webhooks), nil
+}
+
+// DeleteWebhook deletes one of the caller's webhooks with its delivery history
+func (s *ArticleServer) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*pb.DeleteWebhookResponse, error) {
+	if s.webhooks == nil {
+		code, message, details := fromStatus(errWebhooksDisabled)
+		return response.DeleteWebhookError(code, message, details...), nil
+	}
+	userID, err := s.authenticate(ctx, "DeleteWebhook")
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.DeleteWebhookError(code, message, details...), nil
+	}
+	if err := validator.ValidateDeleteWebhook(req); err != nil {
+		log.Printf("[DeleteWebhook] Invalid argument: %v", err)
+		message, details := validationFailure(err)
+		return response.DeleteWebhookError(codes.InvalidArgument, message, details...), nil
+	}
+
+	if err := s.deleteWebhook(ctx, userID, req.Id); err != nil {
+		code, message, details := fromStatus(err)
+		return response.DeleteWebhookError(code, message, details...), nil
+	}
+	return response.DeleteWebhookSuccess(), nil
+}
+
+// ListWebhookDeliveries lists the deliveries of one of the caller's webhooks, newest first,
+// with the attempts made for each
+func (s *ArticleServer) ListWebhookDeliveries(ctx context.Context, req *pb.ListWebhookDeliveriesRequest) (*pb.ListWebhookDeliveriesResponse, error) {
+	if s.webhooks == nil {
+		code, message, details := fromStatus(errWebhooksDisabled)
+		return response.ListWebhookDeliveriesError(code, message, details...), nil
+	}
+	userID, err := s.authenticate(ctx, "ListWebhookDeliveries")
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.ListWebhookDeliveriesError(code, message, details...), nil
+	}
+	if err := validator.ValidateListWebhookDeliveries(req); err != nil {
+		log.Printf("[ListWebhookDeliveries] Invalid argument: %v", err)
+		message, details := validationFailure(err)
+		return response.ListWebhookDeliveriesError(codes.InvalidArgument, message, details...), nil
+	}
+
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	pageNumber := req.PageNumber
+	if pageNumber < 1 {
+		pageNumber = 1
+	}
+
+	deliveries, total, err := s.listWebhookDeliveries(ctx, userID, req, pageSize, (pageNumber-1)*pageSize)
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.ListWebhookDeliveriesError(code, message, details...), nil
+	}
+	totalPages := (total + pageSize - 1) / pageSize
+	return response.ListWebhookDeliveriesSuccess(deliveries, total, pageNumber, totalPages), nil
+}
+
+// RetryWebhookDelivery sends a dead-lettered delivery again, with a fresh set of attempts
+func (s *ArticleServer) RetryWebhookDelivery(ctx context.Context, req *pb.RetryWebhookDeliveryRequest) (*pb.RetryWebhookDeliveryResponse, error) {
+	if s.webhooks == nil {
+		code, message, details := fromStatus(errWebhooksDisabled)
+		return response.RetryWebhookDeliveryError(code, message, details...), nil
+	}
+	userID, err := s.authenticate(ctx, "RetryWebhookDelivery")
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.RetryWebhookDeliveryError(code, message, details...), nil
+	}
+	if err := validator.ValidateRetryWebhookDelivery(req); err != nil {
+		log.Printf("[RetryWebhookDelivery] Invalid argument: %v", err)
+		message, details := validationFailure(err)
+		return response.RetryWebhookDeliveryError(codes.InvalidArgument, message, details...), nil
+	}
+
+	delivery, err := s.retryWebhookDelivery(ctx, userID, req.Id)
+	if err != nil {
+		code, message, details := fromStatus(err)
+		return response.RetryWebhookDeliveryError(code, message, details...), nil
+	}
+	return response.RetryWebhookDeliverySuccess(delivery), nil
+}
@@ -0,0 +1,150 @@
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+// reloadCheckInterval limits how often files are stat'ed for changes (a variable for tests)
+var reloadCheckInterval = 10 * time.Second
+
+// fileReloader re-reads a set of files when their modification time changes.
+// Checks happen lazily during TLS handshakes, so no background goroutine is needed.
+type fileReloader struct {
+	mu        sync.Mutex
+	files     []string
+	modTimes  map[string]time.Time
+	lastCheck time.Time
+	load      func() error
+}
+
+func newFileReloader(load func() error, files ...string) (*fileReloader, error) {
+	r := &fileReloader{
+		files:    files,
+		modTimes: make(map[string]time.Time),
+		load:     load,
+	}
+
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	r.modTimes = r.currentModTimes()
+	r.lastCheck = time.Now()
+	return r, nil
+}
+
+// maybeReload reloads the files if any of them changed since the last load.
+// A failed reload keeps the previously loaded material.
+func (r *fileReloader) maybeReload() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if time.Since(r.lastCheck) < reloadCheckInterval {
+		return
+	}
+	r.lastCheck = time.Now()
+
+	current := r.currentModTimes()
+	changed := false
+	for file, modTime := range current {
+		if !modTime.Equal(r.modTimes[file]) {
+			changed = true
+			break
+		}
+	}
+	if !changed {
+		return
+	}
+
+	if err := r.load(); err != nil {
+		log.Printf("[TLS] ERROR: Failed to reload certificates, keeping previous ones: files=%v, error=%v", r.files, err)
+		return
+	}
+	r.modTimes = current
+	log.Printf("[TLS] Reloaded certificates: files=%v", r.files)
+}
+
+func (r *fileReloader) currentModTimes() map[string]time.Time {
+	modTimes := make(map[string]time.Time, len(r.files))
+	for _, file := range r.files {
+		if info, err := os.Stat(file); err == nil {
+			modTimes[file] = info.ModTime()
+		}
+	}
+	return modTimes
+}
+
+// keyPair holds a hot-reloadable certificate and private key
+type keyPair struct {
+	reloader *fileReloader
+	mu       sync.RWMutex
+	cert     *tls.Certificate
+}
+
+func newKeyPair(certFile, keyFile string) (*keyPair, error) {
+	kp := &keyPair{}
+	reloader, err := newFileReloader(func() error {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return fmt.Errorf("load key pair failed: %w", err)
+		}
+		kp.mu.Lock()
+		kp.cert = &cert
+		kp.mu.Unlock()
+		return nil
+	}, certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	kp.reloader = reloader
+	return kp, nil
+}
+
+func (kp *keyPair) current() *tls.Certificate {
+	kp.reloader.maybeReload()
+	kp.mu.RLock()
+	defer kp.mu.RUnlock()
+	return kp.cert
+}
+
+// certPool holds a hot-reloadable CA bundle
+type certPool struct {
+	reloader *fileReloader
+	mu       sync.RWMutex
+	pool     *x509.CertPool
+}
+
+func newCertPool(caFile string) (*certPool, error) {
+	cp := &certPool{}
+	reloader, err := newFileReloader(func() error {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return fmt.Errorf("read CA bundle failed: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no valid certificates found in CA bundle %s", caFile)
+		}
+		cp.mu.Lock()
+		cp.pool = pool
+		cp.mu.Unlock()
+		return nil
+	}, caFile)
+	if err != nil {
+		return nil, err
+	}
+	cp.reloader = reloader
+	return cp, nil
+}
+
+func (cp *certPool) current() *x509.CertPool {
+	cp.reloader.maybeReload()
+	cp.mu.RLock()
+	defer cp.mu.RUnlock()
+	return cp.pool
+}
@@ -0,0 +1,218 @@
+package tlsconfig
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// testCA signs certificates for the tests
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	file string // PEM bundle holding only this CA
+}
+
+func newTestCA(t *testing.T, name string) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate CA key failed: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create CA certificate failed: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("parse CA certificate failed: %v", err)
+	}
+	file := filepath.Join(t.TempDir(), name+".pem")
+	writePEM(t, file, "CERTIFICATE", der)
+	return &testCA{cert: cert, key: key, file: file}
+}
+
+// issue writes a certificate for dnsName signed by ca, and its key, to dir
+func (ca *testCA) issue(t *testing.T, dir, dnsName string, usage x509.ExtKeyUsage) (certFile, keyFile string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key failed: %v", err)
+	}
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	if err != nil {
+		t.Fatalf("generate serial failed: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: dnsName},
+		DNSNames:     []string{dnsName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatalf("create certificate failed: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("marshal key failed: %v", err)
+	}
+	certFile = filepath.Join(dir, dnsName+".pem")
+	keyFile = filepath.Join(dir, dnsName+"-key.pem")
+	writePEM(t, certFile, "CERTIFICATE", der)
+	writePEM(t, keyFile, "EC PRIVATE KEY", keyDER)
+	return certFile, keyFile
+}
+
+func writePEM(t *testing.T, file, blockType string, der []byte) {
+	t.Helper()
+	if err := os.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600); err != nil {
+		t.Fatalf("write %s failed: %v", file, err)
+	}
+}
+
+// handshake runs a TLS handshake between server and client over loopback TCP
+// and returns the first error of either side
+func handshake(t *testing.T, server, client *tls.Config) error {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen failed: %v", err)
+	}
+	defer listener.Close()
+
+	serverErr := make(chan error, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			serverErr <- err
+			return
+		}
+		defer conn.Close()
+		serverErr <- tls.Server(conn, server).Handshake()
+	}()
+
+	conn, err := tls.Dial("tcp", listener.Addr().String(), client)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	// TLS 1.3 clients finish first; wait for the server, which verifies client certificates last
+	return <-serverErr
+}
+
+func TestHandshake(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t, "ca")
+	otherCA := newTestCA(t, "other-ca")
+	serverCert, serverKey := ca.issue(t, dir, "article.local", x509.ExtKeyUsageServerAuth)
+	clientCert, clientKey := ca.issue(t, dir, "client.local", x509.ExtKeyUsageClientAuth)
+	strangerCert, strangerKey := otherCA.issue(t, dir, "stranger.local", x509.ExtKeyUsageClientAuth)
+
+	server, err := NewServerConfig(ServerOptions{CertFile: serverCert, KeyFile: serverKey})
+	if err != nil {
+		t.Fatalf("NewServerConfig failed: %v", err)
+	}
+	mtlsServer, err := NewServerConfig(ServerOptions{CertFile: serverCert, KeyFile: serverKey, ClientCAFile: ca.file})
+	if err != nil {
+		t.Fatalf("NewServerConfig(mTLS) failed: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		server  *tls.Config
+		client  ClientOptions
+		wantErr bool
+	}{
+		{name: "trusted server", server: server, client: ClientOptions{CAFile: ca.file, ServerName: "article.local"}},
+		{name: "wrong CA", server: server, client: ClientOptions{CAFile: otherCA.file, ServerName: "article.local"}, wantErr: true},
+		{name: "hostname mismatch", server: server, client: ClientOptions{CAFile: ca.file, ServerName: "other.local"}, wantErr: true},
+		{name: "mTLS without client certificate", server: mtlsServer, client: ClientOptions{CAFile: ca.file, ServerName: "article.local"}, wantErr: true},
+		{
+			name:    "mTLS with untrusted client certificate",
+			server:  mtlsServer,
+			client:  ClientOptions{CAFile: ca.file, ServerName: "article.local", CertFile: strangerCert, KeyFile: strangerKey},
+			wantErr: true,
+		},
+		{
+			name:   "mTLS with trusted client certificate",
+			server: mtlsServer,
+			client: ClientOptions{CAFile: ca.file, ServerName: "article.local", CertFile: clientCert, KeyFile: clientKey},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := NewClientConfig(tt.client)
+			if err != nil {
+				t.Fatalf("NewClientConfig failed: %v", err)
+			}
+			if err := handshake(t, tt.server, client); (err != nil) != tt.wantErr {
+				t.Errorf("handshake error = %v, want error %t", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestServerCertificateReload(t *testing.T) {
+	defer func(interval time.Duration) { reloadCheckInterval = interval }(reloadCheckInterval)
+	reloadCheckInterval = 0
+
+	dir := t.TempDir()
+	ca := newTestCA(t, "ca")
+	certFile, keyFile := ca.issue(t, dir, "old.local", x509.ExtKeyUsageServerAuth)
+	server, err := NewServerConfig(ServerOptions{CertFile: certFile, KeyFile: keyFile})
+	if err != nil {
+		t.Fatalf("NewServerConfig failed: %v", err)
+	}
+	client := func(serverName string) *tls.Config {
+		cfg, err := NewClientConfig(ClientOptions{CAFile: ca.file, ServerName: serverName})
+		if err != nil {
+			t.Fatalf("NewClientConfig failed: %v", err)
+		}
+		return cfg
+	}
+	if err := handshake(t, server, client("old.local")); err != nil {
+		t.Fatalf("handshake before reload failed: %v", err)
+	}
+
+	// Replace the files in place, as a certificate renewal would
+	newCert, newKey := ca.issue(t, dir, "new.local", x509.ExtKeyUsageServerAuth)
+	for from, to := range map[string]string{newCert: certFile, newKey: keyFile} {
+		if err := os.Rename(from, to); err != nil {
+			t.Fatalf("rename failed: %v", err)
+		}
+		later := time.Now().Add(time.Minute)
+		if err := os.Chtimes(to, later, later); err != nil {
+			t.Fatalf("chtimes failed: %v", err)
+		}
+	}
+
+	if err := handshake(t, server, client("new.local")); err != nil {
+		t.Errorf("handshake after reload failed: %v", err)
+	}
+	if err := handshake(t, server, client("old.local")); err == nil {
+		t.Error("handshake for the old name succeeded after reload, want the new certificate")
+	}
+}
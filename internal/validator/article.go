@@ -0,0 +1,252 @@
+package validator
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/thatlq1812/service-2-article/internal/outbox"
+	"github.com/thatlq1812/service-2-article/internal/response"
+	pb "github.com/thatlq1812/service-2-article/proto"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Article field limits
+const (
+	MaxTitleLength   = 255 // matches articles.title VARCHAR(255)
+	MaxContentLength = 100_000
+	MaxPageSize      = 100
+
+	MinHeartbeatInterval = time.Second
+	MaxHeartbeatInterval = 5 * time.Minute
+)
+
+// Declarative rules for article fields
+var (
+	titleRule = StringRule{
+		Field:     "title",
+		Required:  true,
+		MaxLength: MaxTitleLength,
+		Trimmed:   true,
+	}
+	contentRule = StringRule{
+		Field:         "content",
+		Required:      true,
+		MaxLength:     MaxContentLength,
+		AllowNewlines: true,
+	}
+	idRule       = IntRule{Field: "id", Min: 1}
+	pageSizeRule = IntRule{Field: "page_size", Min: 0, Max: MaxPageSize}
+	// v1 ListArticles clamps page_size to MaxPageSize, as it did before validation existed
+	listPageSizeRule = IntRule{Field: "page_size", Min: 0}
+	pageNumberRule   = IntRule{Field: "page_number", Min: 0}
+	userIDRule       = IntRule{Field: "user_id", Min: 0}
+)
+
+// checkContentFormat rejects values outside the ContentFormat enum
+func checkContentFormat(format pb.ContentFormat) Violations {
+	if _, ok := pb.ContentFormat_name[int32(format)]; !ok {
+		return Violations{{Field: "content_format", Description: "must be PLAIN, MARKDOWN or HTML"}}
+	}
+	return nil
+}
+
+// checkView rejects values outside the ArticleView enum
+func checkView(view pb.ArticleView) Violations {
+	if _, ok := pb.ArticleView_name[int32(view)]; !ok {
+		return Violations{{Field: "view", Description: "must be BASIC or FULL"}}
+	}
+	return nil
+}
+
+// checkOrder rejects values outside the ArticleOrder enum
+func checkOrder(order pb.ArticleOrder) Violations {
+	if _, ok := pb.ArticleOrder_name[int32(order)]; !ok {
+		return Violations{{Field: "order_by", Description: "must be NEWEST or POPULAR"}}
+	}
+	return nil
+}
+
+// UpdatableFields are the Article fields UpdateArticle can set through update_mask
+var UpdatableFields = []string{"title", "content", "content_format"}
+
+// checkReadMask rejects paths that are not Article fields
+func checkReadMask(mask *fieldmaskpb.FieldMask) Violations {
+	return checkPaths("read_mask", mask.GetPaths(), func(path string) bool {
+		return (&pb.Article{}).ProtoReflect().Descriptor().Fields().ByName(protoreflect.Name(path)) != nil
+	})
+}
+
+// checkPaths reports every path that allowed rejects
+func checkPaths(field string, paths []string, allowed func(string) bool) Violations {
+	var violations Violations
+	for _, path := range paths {
+		if !allowed(path) {
+			violations = append(violations, response.FieldViolation{Field: field, Description: fmt.Sprintf("unknown field path %q", path)})
+		}
+	}
+	return violations
+}
+
+// optional relaxes Required so an empty value means "not provided"
+func optional(rule StringRule) StringRule {
+	rule.Required = false
+	return rule
+}
+
+// ValidateCreateArticle returns Violations (as error) for every invalid field, or nil
+func ValidateCreateArticle(req *pb.CreateArticleRequest) error {
+	return collect(
+		titleRule.Check(req.Title),
+		contentRule.Check(req.Content),
+		checkContentFormat(req.ContentFormat),
+	)
+}
+
+// ValidateGetArticle validates a GetArticleRequest
+func ValidateGetArticle(req *pb.GetArticleRequest) error {
+	return collect(idRule.Check(req.Id), checkView(req.View), checkReadMask(req.ReadMask))
+}
+
+// ValidateUpdateArticle validates an UpdateArticleRequest.
+// With an update_mask only the listed fields are checked and content may be empty;
+// without one, empty fields are left unchanged.
+func ValidateUpdateArticle(req *pb.UpdateArticleRequest) error {
+	if paths := req.GetUpdateMask().GetPaths(); len(paths) > 0 {
+		return validateMaskedUpdate(req, paths)
+	}
+
+	var missing, format Violations
+	if req.Title == "" && req.Content == "" && req.ContentFormat == nil {
+		missing = Violations{
+			{Field: "title", Description: "at least title or content must be provided"},
+			{Field: "content", Description: "at least title or content must be provided"},
+		}
+	}
+	if req.ContentFormat != nil {
+		format = checkContentFormat(*req.ContentFormat)
+	}
+	return collect(
+		idRule.Check(req.Id),
+		optional(titleRule).Check(req.Title),
+		optional(contentRule).Check(req.Content),
+		format,
+		missing,
+	)
+}
+
+func validateMaskedUpdate(req *pb.UpdateArticleRequest, paths []string) error {
+	results := []Violations{
+		idRule.Check(req.Id),
+		checkPaths("update_mask", paths, func(path string) bool {
+			return slices.Contains(UpdatableFields, path)
+		}),
+	}
+	for _, path := range paths {
+		switch path {
+		case "title":
+			results = append(results, titleRule.Check(req.Title))
+		case "content":
+			results = append(results, optional(contentRule).Check(req.Content))
+		case "content_format":
+			results = append(results, checkContentFormat(req.GetContentFormat()))
+		}
+	}
+	return collect(results...)
+}
+
+// ValidateDeleteArticle validates a DeleteArticleRequest
+func ValidateDeleteArticle(req *pb.DeleteArticleRequest) error {
+	return collect(idRule.Check(req.Id))
+}
+
+// ValidateListArticles validates pagination bounds, view and order; zero values select the defaults.
+// page_size above MaxPageSize is accepted and clamped by the server.
+func ValidateListArticles(req *pb.ListArticlesRequest) error {
+	return collect(
+		listPageSizeRule.Check(req.PageSize),
+		pageNumberRule.Check(req.PageNumber),
+		userIDRule.Check(req.UserId),
+		checkView(req.View),
+		checkReadMask(req.ReadMask),
+		checkOrder(req.OrderBy),
+	)
+}
+
+// ValidateExportArticles validates the export filters, view and read mask
+func ValidateExportArticles(req *pb.ExportArticlesRequest) error {
+	return collect(
+		userIDRule.Check(req.UserId),
+		checkTimeRange(req.CreatedAfter, req.CreatedBefore),
+		checkView(req.View),
+		checkReadMask(req.ReadMask),
+	)
+}
+
+// checkTimeRange requires set timestamps to be valid and after to precede before
+func checkTimeRange(after, before *timestamppb.Timestamp) Violations {
+	var violations Violations
+	if after != nil && after.CheckValid() != nil {
+		violations = append(violations, response.FieldViolation{Field: "created_after", Description: "must be a valid timestamp"})
+	}
+	if before != nil && before.CheckValid() != nil {
+		violations = append(violations, response.FieldViolation{Field: "created_before", Description: "must be a valid timestamp"})
+	}
+	if len(violations) == 0 && after != nil && before != nil && !after.AsTime().Before(before.AsTime()) {
+		violations = append(violations, response.FieldViolation{Field: "created_before", Description: "must be after created_after"})
+	}
+	return violations
+}
+
+// ValidateWatchArticles validates the change feed filters and heartbeat interval.
+// The resume token is opaque and checked by the server.
+func ValidateWatchArticles(req *pb.WatchArticlesRequest) error {
+	violations := checkEventTypes(req.EventTypes)
+	if interval := req.HeartbeatInterval; interval != nil {
+		if interval.CheckValid() != nil || interval.AsDuration() < MinHeartbeatInterval || interval.AsDuration() > MaxHeartbeatInterval {
+			violations = append(violations, response.FieldViolation{
+				Field:       "heartbeat_interval",
+				Description: fmt.Sprintf("must be between %s and %s", MinHeartbeatInterval, MaxHeartbeatInterval),
+			})
+		}
+	}
+	return collect(userIDRule.Check(req.UserId), violations)
+}
+
+// checkEventTypes rejects unknown article event types
+func checkEventTypes(eventTypes []string) Violations {
+	var violations Violations
+	for i, eventType := range eventTypes {
+		switch eventType {
+		case outbox.EventCreated, outbox.EventUpdated, outbox.EventDeleted:
+		default:
+			violations = append(violations, response.FieldViolation{
+				Field:       fmt.Sprintf("event_types[%d]", i),
+				Description: fmt.Sprintf("must be %s, %s or %s", outbox.EventCreated, outbox.EventUpdated, outbox.EventDeleted),
+			})
+		}
+	}
+	return violations
+}
+
+// ValidateImportedArticle validates one row of a bulk import
+func ValidateImportedArticle(row *pb.ImportedArticle) error {
+	results := []Violations{
+		titleRule.Check(row.Title),
+		contentRule.Check(row.Content),
+		checkContentFormat(row.ContentFormat),
+		userIDRule.Check(row.UserId),
+	}
+	if row.CreateTime != nil && row.CreateTime.CheckValid() != nil {
+		results = append(results, Violations{{Field: "create_time", Description: "must be a valid timestamp"}})
+	}
+	if row.UpdateTime != nil && row.UpdateTime.CheckValid() != nil {
+		results = append(results, Violations{{Field: "update_time", Description: "must be a valid timestamp"}})
+	} else if row.UpdateTime != nil && row.CreateTime != nil && row.UpdateTime.AsTime().Before(row.CreateTime.AsTime()) {
+		results = append(results, Violations{{Field: "update_time", Description: "must not be before create_time"}})
+	}
+	return collect(results...)
+}
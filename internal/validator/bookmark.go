@@ -0,0 +1,86 @@
+package validator
+
+import (
+	"fmt"
+
+	"github.com/thatlq1812/service-2-article/internal/response"
+	pb "github.com/thatlq1812/service-2-article/proto"
+)
+
+// Collection limits
+const (
+	MaxCollectionNameLength = 100 // matches collections.name VARCHAR(100)
+	MaxCollections          = 100 // Per user, so ReorderCollections can list them all
+)
+
+var (
+	collectionNameRule = StringRule{
+		Field:     "name",
+		Required:  true,
+		MaxLength: MaxCollectionNameLength,
+		Trimmed:   true,
+	}
+	collectionIDRule = IntRule{Field: "collection_id", Min: 0}
+	shareTokenRule   = StringRule{Field: "share_token", Required: true, MaxLength: 64}
+)
+
+// ValidateBookmarkArticle validates a BookmarkArticleRequest
+func ValidateBookmarkArticle(req *pb.BookmarkArticleRequest) error {
+	return collect(articleIDRule.Check(req.ArticleId), collectionIDRule.Check(req.CollectionId))
+}
+
+// ValidateUnbookmarkArticle validates an UnbookmarkArticleRequest
+func ValidateUnbookmarkArticle(req *pb.UnbookmarkArticleRequest) error {
+	return collect(articleIDRule.Check(req.ArticleId), collectionIDRule.Check(req.CollectionId))
+}
+
+// ValidateListBookmarks validates the list and page size; the page token is checked by the server
+func ValidateListBookmarks(req *pb.ListBookmarksRequest) error {
+	return collect(collectionIDRule.Check(req.CollectionId), pageSizeRule.Check(req.PageSize))
+}
+
+// ValidateCreateCollection validates a CreateCollectionRequest
+func ValidateCreateCollection(req *pb.CreateCollectionRequest) error {
+	return collect(collectionNameRule.Check(req.Name))
+}
+
+// ValidateRenameCollection validates a RenameCollectionRequest
+func ValidateRenameCollection(req *pb.RenameCollectionRequest) error {
+	return collect(idRule.Check(req.Id), collectionNameRule.Check(req.Name))
+}
+
+// ValidateReorderCollections requires positive, distinct IDs; whether they are exactly the
+// caller's collections is checked by the server
+func ValidateReorderCollections(req *pb.ReorderCollectionsRequest) error {
+	if len(req.Ids) > MaxCollections {
+		return collect(Violations{{Field: "ids", Description: fmt.Sprintf("must have at most %d IDs", MaxCollections)}})
+	}
+	var violations Violations
+	seen := make(map[int32]bool, len(req.Ids))
+	for i, id := range req.Ids {
+		field := fmt.Sprintf("ids[%d]", i)
+		switch {
+		case id < 1:
+			violations = append(violations, response.FieldViolation{Field: field, Description: "must be at least 1"})
+		case seen[id]:
+			violations = append(violations, response.FieldViolation{Field: field, Description: fmt.Sprintf("duplicate ID %d", id)})
+		}
+		seen[id] = true
+	}
+	return collect(violations)
+}
+
+// ValidateShareCollection validates a ShareCollectionRequest
+func ValidateShareCollection(req *pb.ShareCollectionRequest) error {
+	return collect(idRule.Check(req.Id))
+}
+
+// ValidateDeleteCollection validates a DeleteCollectionRequest
+func ValidateDeleteCollection(req *pb.DeleteCollectionRequest) error {
+	return collect(idRule.Check(req.Id))
+}
+
+// ValidateGetSharedCollection validates the share token and page size
+func ValidateGetSharedCollection(req *pb.GetSharedCollectionRequest) error {
+	return collect(shareTokenRule.Check(req.ShareToken), pageSizeRule.Check(req.PageSize))
+}
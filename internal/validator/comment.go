@@ -0,0 +1,71 @@
+package validator
+
+import (
+	pb "github.com/thatlq1812/service-2-article/proto"
+)
+
+const (
+	// MaxCommentLength limits comment content, which is stored and returned as plain text
+	MaxCommentLength = 10_000
+	// MaxReplyPageSize limits the replies ListComments returns per top-level comment, so a
+	// page stays small however popular its threads are
+	MaxReplyPageSize = 20
+)
+
+var (
+	commentContentRule = StringRule{
+		Field:         "content",
+		Required:      true,
+		MaxLength:     MaxCommentLength,
+		AllowNewlines: true,
+	}
+	articleIDRule     = IntRule{Field: "article_id", Min: 1}
+	parentIDRule      = IntRule{Field: "parent_id", Min: 0}
+	commentIDRule     = IntRule{Field: "comment_id", Min: 1}
+	replyPageSizeRule = IntRule{Field: "reply_page_size", Min: 0, Max: MaxReplyPageSize}
+)
+
+// ValidateCreateComment validates a CreateCommentRequest
+func ValidateCreateComment(req *pb.CreateCommentRequest) error {
+	return collect(
+		articleIDRule.Check(req.ArticleId),
+		parentIDRule.Check(req.ParentId),
+		commentContentRule.Check(req.Content),
+	)
+}
+
+// ValidateListComments validates the article and pagination
+func ValidateListComments(req *pb.ListCommentsRequest) error {
+	return collect(
+		articleIDRule.Check(req.ArticleId),
+		pageSizeRule.Check(req.PageSize),
+		pageNumberRule.Check(req.PageNumber),
+		replyPageSizeRule.Check(req.ReplyPageSize),
+	)
+}
+
+// ValidateListReplies validates the comment and page size; the page token is checked when decoded
+func ValidateListReplies(req *pb.ListRepliesRequest) error {
+	return collect(
+		commentIDRule.Check(req.CommentId),
+		pageSizeRule.Check(req.PageSize),
+	)
+}
+
+// ValidateUpdateComment validates an UpdateCommentRequest
+func ValidateUpdateComment(req *pb.UpdateCommentRequest) error {
+	return collect(
+		idRule.Check(req.Id),
+		commentContentRule.Check(req.Content),
+	)
+}
+
+// ValidateDeleteComment validates a DeleteCommentRequest
+func ValidateDeleteComment(req *pb.DeleteCommentRequest) error {
+	return collect(idRule.Check(req.Id))
+}
+
+// ValidateModerateComment validates a ModerateCommentRequest
+func ValidateModerateComment(req *pb.ModerateCommentRequest) error {
+	return collect(idRule.Check(req.Id))
+}
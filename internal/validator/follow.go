@@ -0,0 +1,34 @@
+package validator
+
+import (
+	pb "github.com/thatlq1812/service-2-article/proto"
+)
+
+// MaxFollowing bounds the authors a user follows; GetFeed reads one index range per author
+const MaxFollowing = 1000
+
+var followedAuthorRule = IntRule{Field: "author_id", Min: 1}
+
+// ValidateFollowAuthor validates a FollowAuthorRequest
+func ValidateFollowAuthor(req *pb.FollowAuthorRequest) error {
+	return collect(followedAuthorRule.Check(req.AuthorId))
+}
+
+// ValidateUnfollowAuthor validates an UnfollowAuthorRequest
+func ValidateUnfollowAuthor(req *pb.UnfollowAuthorRequest) error {
+	return collect(followedAuthorRule.Check(req.AuthorId))
+}
+
+// ValidateListFollowing validates the page size; the page token is checked by the server
+func ValidateListFollowing(req *pb.ListFollowingRequest) error {
+	return collect(pageSizeRule.Check(req.PageSize))
+}
+
+// ValidateGetFeed validates the page size, view and read mask; the page token is checked by the server
+func ValidateGetFeed(req *pb.GetFeedRequest) error {
+	return collect(
+		pageSizeRule.Check(req.PageSize),
+		checkView(req.View),
+		checkReadMask(req.ReadMask),
+	)
+}
@@ -0,0 +1,34 @@
+package validator
+
+import (
+	pb "github.com/thatlq1812/service-2-article/proto"
+)
+
+// checkReactionType rejects values outside the ReactionType enum, and UNSPECIFIED unless allowed
+func checkReactionType(reactionType pb.ReactionType, allowUnspecified bool) Violations {
+	_, known := pb.ReactionType_name[int32(reactionType)]
+	if !known || (!allowUnspecified && reactionType == pb.ReactionType_REACTION_TYPE_UNSPECIFIED) {
+		return Violations{{Field: "type", Description: "must be LIKE, LOVE, INSIGHTFUL, FUNNY or CELEBRATE"}}
+	}
+	return nil
+}
+
+// ValidateAddReaction validates an AddReactionRequest
+func ValidateAddReaction(req *pb.AddReactionRequest) error {
+	return collect(articleIDRule.Check(req.ArticleId), checkReactionType(req.Type, false))
+}
+
+// ValidateRemoveReaction validates a RemoveReactionRequest
+func ValidateRemoveReaction(req *pb.RemoveReactionRequest) error {
+	return collect(articleIDRule.Check(req.ArticleId), checkReactionType(req.Type, false))
+}
+
+// ValidateListReactions validates the article, type filter (UNSPECIFIED lists every type) and pagination
+func ValidateListReactions(req *pb.ListReactionsRequest) error {
+	return collect(
+		articleIDRule.Check(req.ArticleId),
+		checkReactionType(req.Type, true),
+		pageSizeRule.Check(req.PageSize),
+		pageNumberRule.Check(req.PageNumber),
+	)
+}
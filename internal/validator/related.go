@@ -0,0 +1,20 @@
+package validator
+
+import (
+	pb "github.com/thatlq1812/service-2-article/proto"
+)
+
+// MaxRelatedArticles bounds GetRelatedArticles; the article cache keeps this many per article
+const MaxRelatedArticles = 20
+
+var relatedLimitRule = IntRule{Field: "limit", Min: 0, Max: MaxRelatedArticles}
+
+// ValidateGetRelatedArticles validates the article, limit, view and read mask
+func ValidateGetRelatedArticles(req *pb.GetRelatedArticlesRequest) error {
+	return collect(
+		articleIDRule.Check(req.ArticleId),
+		relatedLimitRule.Check(req.Limit),
+		checkView(req.View),
+		checkReadMask(req.ReadMask),
+	)
+}
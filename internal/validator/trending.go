@@ -0,0 +1,26 @@
+package validator
+
+import (
+	pb "github.com/thatlq1812/service-2-article/proto"
+)
+
+// limitRule bounds the number of articles of a ranking
+var limitRule = IntRule{Field: "limit", Min: 0, Max: MaxPageSize}
+
+// checkTrendingWindow rejects values outside the TrendingWindow enum
+func checkTrendingWindow(window pb.TrendingWindow) Violations {
+	if _, ok := pb.TrendingWindow_name[int32(window)]; !ok {
+		return Violations{{Field: "window", Description: "must be DAY or WEEK"}}
+	}
+	return nil
+}
+
+// ValidateListTrendingArticles validates the window, limit, view and read mask
+func ValidateListTrendingArticles(req *pb.ListTrendingArticlesRequest) error {
+	return collect(
+		checkTrendingWindow(req.Window),
+		limitRule.Check(req.Limit),
+		checkView(req.View),
+		checkReadMask(req.ReadMask),
+	)
+}
@@ -0,0 +1,113 @@
+package validator
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/thatlq1812/service-2-article/internal/response"
+)
+
+// Violations collects every invalid field of a request
+type Violations []response.FieldViolation
+
+// Error summarises all violations in one message
+func (v Violations) Error() string {
+	parts := make([]string, 0, len(v))
+	for _, violation := range v {
+		parts = append(parts, fmt.Sprintf("%s: %s", violation.Field, violation.Description))
+	}
+	return "invalid request: " + strings.Join(parts, "; ")
+}
+
+// StringRule declares the constraints for one string field
+type StringRule struct {
+	Field         string
+	Required      bool
+	MinLength     int  // in characters, checked when the value is non-empty
+	MaxLength     int  // in characters, 0 means unlimited
+	Trimmed       bool // no leading or trailing whitespace
+	AllowNewlines bool // permit \n, \r and \t; other control characters are always rejected
+}
+
+// Check returns the first violation of the rule for value, so each field is reported once
+func (r StringRule) Check(value string) Violations {
+	if value == "" {
+		if r.Required {
+			return Violations{{Field: r.Field, Description: "is required"}}
+		}
+		return nil
+	}
+
+	violation := func(format string, args ...interface{}) Violations {
+		return Violations{{Field: r.Field, Description: fmt.Sprintf(format, args...)}}
+	}
+
+	if !utf8.ValidString(value) {
+		// Length and character checks are meaningless on invalid UTF-8
+		return violation("must be valid UTF-8")
+	}
+	if r.Required && strings.TrimSpace(value) == "" {
+		return violation("must not be blank")
+	}
+	if r.Trimmed && strings.TrimSpace(value) != value {
+		return violation("must not have leading or trailing whitespace")
+	}
+
+	length := utf8.RuneCountInString(value)
+	if r.MinLength > 0 && length < r.MinLength {
+		return violation("must be at least %d characters", r.MinLength)
+	}
+	if r.MaxLength > 0 && length > r.MaxLength {
+		return violation("must be at most %d characters (got %d)", r.MaxLength, length)
+	}
+
+	if hasDisallowedControl(value, r.AllowNewlines) {
+		return violation("must not contain control characters")
+	}
+	return nil
+}
+
+// IntRule declares inclusive bounds for one integer field
+type IntRule struct {
+	Field string
+	Min   int32
+	Max   int32 // 0 means unbounded
+}
+
+// Check returns a violation when value is out of bounds
+func (r IntRule) Check(value int32) Violations {
+	if value < r.Min {
+		return Violations{{Field: r.Field, Description: fmt.Sprintf("must be at least %d", r.Min)}}
+	}
+	if r.Max > 0 && value > r.Max {
+		return Violations{{Field: r.Field, Description: fmt.Sprintf("must be at most %d", r.Max)}}
+	}
+	return nil
+}
+
+// collect merges the results of several checks, returning nil when all pass
+func collect(results ...Violations) error {
+	var all Violations
+	for _, v := range results {
+		all = append(all, v...)
+	}
+	if len(all) == 0 {
+		return nil
+	}
+	return all
+}
+
+func hasDisallowedControl(value string, allowNewlines bool) bool {
+	for _, r := range value {
+		if !unicode.IsControl(r) {
+			continue
+		}
+		if allowNewlines && (r == '\n' || r == '\r' || r == '\t') {
+			continue
+		}
+		return true
+	}
+	return false
+}
@@ -0,0 +1,74 @@
+package validator
+
+import (
+	"net/url"
+
+	pb "github.com/thatlq1812/service-2-article/proto"
+)
+
+// Webhook field limits
+const (
+	MaxWebhookURLLength = 2048
+	MinSecretLength     = 16
+	MaxSecretLength     = 256
+)
+
+var (
+	webhookURLRule = StringRule{Field: "url", Required: true, MaxLength: MaxWebhookURLLength, Trimmed: true}
+	secretRule     = StringRule{Field: "secret", Required: true, MinLength: MinSecretLength, MaxLength: MaxSecretLength}
+	webhookIDRule  = IntRule{Field: "webhook_id", Min: 1}
+	authorIDRule   = IntRule{Field: "author_id", Min: 0}
+)
+
+// checkWebhookURL requires an absolute http(s) URL without credentials.
+// Whether the host is publicly reachable is checked when delivering, after DNS resolution.
+func checkWebhookURL(raw string) Violations {
+	if violations := webhookURLRule.Check(raw); len(violations) > 0 {
+		return violations
+	}
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return Violations{{Field: "url", Description: "must be an absolute http or https URL"}}
+	}
+	if u.User != nil {
+		return Violations{{Field: "url", Description: "must not contain credentials"}}
+	}
+	return nil
+}
+
+// ValidateCreateWebhook validates a CreateWebhookRequest
+func ValidateCreateWebhook(req *pb.CreateWebhookRequest) error {
+	return collect(
+		checkWebhookURL(req.Url),
+		secretRule.Check(req.Secret),
+		checkEventTypes(req.EventTypes),
+		authorIDRule.Check(req.AuthorId),
+	)
+}
+
+// ValidateDeleteWebhook validates a DeleteWebhookRequest
+func ValidateDeleteWebhook(req *pb.DeleteWebhookRequest) error {
+	return collect(idRule.Check(req.Id))
+}
+
+// ValidateListWebhookDeliveries validates the webhook, status filter and pagination
+func ValidateListWebhookDeliveries(req *pb.ListWebhookDeliveriesRequest) error {
+	var status Violations
+	if _, ok := pb.WebhookDeliveryStatus_name[int32(req.Status)]; !ok {
+		status = Violations{{Field: "status", Description: "must be PENDING, DELIVERED or DEAD"}}
+	}
+	return collect(
+		webhookIDRule.Check(req.WebhookId),
+		status,
+		pageSizeRule.Check(req.PageSize),
+		pageNumberRule.Check(req.PageNumber),
+	)
+}
+
+// ValidateRetryWebhookDelivery validates a RetryWebhookDeliveryRequest
+func ValidateRetryWebhookDelivery(req *pb.RetryWebhookDeliveryRequest) error {
+	if req.Id < 1 {
+		return collect(Violations{{Field: "id", Description: "must be at least 1"}})
+	}
+	return nil
+}
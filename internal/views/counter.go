@@ -0,0 +1,202 @@
+// Package views counts article views off the request path: GetArticle hands each view to a
+// Counter, which deduplicates views in batches and adds the counts to the database periodically.
+package views
+
+import (
+	"context"
+	"log"
+	"sync/atomic"
+	"time"
+)
+
+// View is one read of an article
+type View struct {
+	ArticleID int32
+	Viewer    string // "user:<id>" or "ip:<address>"
+	Time      time.Time
+}
+
+// Count is the number of deduplicated views of an article in the hour starting at Hour
+type Count struct {
+	ArticleID int32
+	Hour      time.Time
+	Views     int64
+}
+
+// Store persists counts (implemented by repository.ViewRepository)
+type Store interface {
+	// AddViews adds counts to the articles' totals and hourly buckets; counts of articles that
+	// no longer exist are dropped
+	AddViews(ctx context.Context, counts []Count) error
+
+	// PruneViews deletes the counts of hours before before
+	PruneViews(ctx context.Context, before time.Time) (int64, error)
+}
+
+// Options configure a Counter
+type Options struct {
+	Window        time.Duration // A viewer counts once per article per window
+	FlushInterval time.Duration // How often counts are written to the Store
+	BufferSize    int           // Views waiting to be deduplicated; further views are dropped
+	BatchSize     int           // Views deduplicated per round trip
+	Retention     time.Duration // How long hourly counts are kept; 0 keeps them forever
+}
+
+// stopTimeout bounds the final flush when a Counter stops
+const stopTimeout = 5 * time.Second
+
+// pruneInterval is how often counts older than the retention are deleted
+const pruneInterval = time.Hour
+
+// countKey identifies a Count
+type countKey struct {
+	articleID int32
+	hour      time.Time
+}
+
+// Counter deduplicates views and flushes aggregated counts to a Store
+type Counter struct {
+	dedup   Deduper
+	store   Store
+	opts    Options
+	queue   chan View
+	dropped atomic.Int64
+
+	// Counted since the last successful flush; only used by Run
+	pending map[countKey]int64
+}
+
+// NewCounter creates a counter; zero options get defaults
+// (30m window, 10s flush, 10000 queued views, 500 views per batch)
+func NewCounter(dedup Deduper, store Store, opts Options) *Counter {
+	if opts.Window <= 0 {
+		opts.Window = 30 * time.Minute
+	}
+	if opts.FlushInterval <= 0 {
+		opts.FlushInterval = 10 * time.Second
+	}
+	if opts.BufferSize <= 0 {
+		opts.BufferSize = 10000
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 500
+	}
+	return &Counter{
+		dedup:   dedup,
+		store:   store,
+		opts:    opts,
+		queue:   make(chan View, opts.BufferSize),
+		pending: make(map[countKey]int64),
+	}
+}
+
+// Record queues a view of articleID by viewer. It never blocks: when the queue is full the
+// view is dropped, so a slow Redis or database never slows down reads.
+func (c *Counter) Record(articleID int32, viewer string) {
+	select {
+	case c.queue <- View{ArticleID: articleID, Viewer: viewer, Time: time.Now()}:
+	default:
+		if dropped := c.dropped.Add(1); dropped%1000 == 1 {
+			log.Printf("[Views] WARN: Queue full, dropped %d views so far", dropped)
+		}
+	}
+}
+
+// Run counts queued views until ctx is cancelled, then counts the views still queued and
+// flushes once more
+func (c *Counter) Run(ctx context.Context) {
+	log.Printf("[Views] Counter started (window=%s, flush=%s)", c.opts.Window, c.opts.FlushInterval)
+	ticker := time.NewTicker(c.opts.FlushInterval)
+	defer ticker.Stop()
+	var lastPrune time.Time
+
+	for {
+		select {
+		case <-ctx.Done():
+			c.stop()
+			return
+		case view := <-c.queue:
+			c.count(ctx, c.batch(view))
+		case <-ticker.C:
+			c.flush(ctx)
+			if c.opts.Retention > 0 && time.Since(lastPrune) >= pruneInterval {
+				c.prune(ctx)
+				lastPrune = time.Now()
+			}
+		}
+	}
+}
+
+// stop drains the queue and flushes; ctx of Run is done, so it gets its own timeout
+func (c *Counter) stop() {
+	ctx, cancel := context.WithTimeout(context.Background(), stopTimeout)
+	defer cancel()
+	for {
+		select {
+		case view := <-c.queue:
+			c.count(ctx, c.batch(view))
+		default:
+			c.flush(ctx)
+			log.Printf("[Views] Counter stopped")
+			return
+		}
+	}
+}
+
+// batch returns first and the views queued behind it, up to BatchSize
+func (c *Counter) batch(first View) []View {
+	views := []View{first}
+	for len(views) < c.opts.BatchSize {
+		select {
+		case view := <-c.queue:
+			views = append(views, view)
+		default:
+			return views
+		}
+	}
+	return views
+}
+
+// count adds the first views of a batch to the pending counts
+func (c *Counter) count(ctx context.Context, views []View) {
+	first, err := c.dedup.FirstViews(ctx, views)
+	if err != nil {
+		log.Printf("[Views] ERROR: Dedup failed, dropped %d views: %v", len(views), err)
+		return
+	}
+	for i, view := range views {
+		if first[i] {
+			c.pending[countKey{articleID: view.ArticleID, hour: view.Time.UTC().Truncate(time.Hour)}]++
+		}
+	}
+}
+
+// flush writes the pending counts; after a failure they are kept and retried on the next flush
+func (c *Counter) flush(ctx context.Context) {
+	if len(c.pending) == 0 {
+		return
+	}
+	counts := make([]Count, 0, len(c.pending))
+	for key, views := range c.pending {
+		counts = append(counts, Count{ArticleID: key.articleID, Hour: key.hour, Views: views})
+	}
+	if err := c.store.AddViews(ctx, counts); err != nil {
+		log.Printf("[Views] ERROR: Flush of %d counts failed, retrying later: %v", len(counts), err)
+		return
+	}
+	clear(c.pending)
+}
+
+// prune deletes hourly counts older than the retention
+func (c *Counter) prune(ctx context.Context) {
+	pruned, err := c.store.PruneViews(ctx, time.Now().Add(-c.opts.Retention))
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("[Views] ERROR: Prune failed: %v", err)
+		}
+		return
+	}
+	if pruned > 0 {
+		log.Printf("[Views] Pruned %d hourly counts", pruned)
+	}
+}
@@ -0,0 +1,69 @@
+package views_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thatlq1812/service-2-article/internal/repository"
+	"github.com/thatlq1812/service-2-article/internal/views"
+	pb "github.com/thatlq1812/service-2-article/proto"
+)
+
+func TestCounterDeduplicatesAndFlushes(t *testing.T) {
+	ctx := context.Background()
+	articles := repository.NewArticleMemoryRepository()
+	first, _ := articles.Create(ctx, &pb.Article{Title: "First", Content: "Content", UserId: 1})
+	second, _ := articles.Create(ctx, &pb.Article{Title: "Second", Content: "Content", UserId: 1})
+
+	counter := views.NewCounter(views.NewMemoryDeduper(time.Hour), repository.NewViewMemoryRepository(articles), views.Options{FlushInterval: time.Hour})
+	counter.Record(first.Id, "user:1")
+	counter.Record(first.Id, "user:1") // Same viewer in the same window
+	counter.Record(first.Id, "ip:10.0.0.1")
+	counter.Record(second.Id, "user:1")
+	counter.Record(999, "user:1") // No such article
+
+	// Stopping counts the queued views and flushes
+	runCtx, stop := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		counter.Run(runCtx)
+		close(done)
+	}()
+	stop()
+	<-done
+
+	for id, want := range map[int32]int64{first.Id: 2, second.Id: 1} {
+		article, err := articles.GetByID(ctx, id)
+		if err != nil || article.ViewCount != want {
+			t.Errorf("article %d view_count = %d, %v; want %d", id, article.GetViewCount(), err, want)
+		}
+	}
+}
+
+type failingDeduper struct{}
+
+func (failingDeduper) FirstViews(context.Context, []views.View) ([]bool, error) {
+	return nil, errors.New("redis is down")
+}
+
+func TestFallbackDeduper(t *testing.T) {
+	dedup := views.NewFallbackDeduper(failingDeduper{}, views.NewMemoryDeduper(time.Hour))
+	now := time.Now()
+	batch := []views.View{
+		{ArticleID: 1, Viewer: "user:1", Time: now},
+		{ArticleID: 1, Viewer: "user:1", Time: now},
+		{ArticleID: 1, Viewer: "user:2", Time: now},
+	}
+
+	first, err := dedup.FirstViews(context.Background(), batch)
+	if err != nil || len(first) != 3 || !first[0] || first[1] || !first[2] {
+		t.Errorf("FirstViews = %v, %v; want [true false true] from the fallback", first, err)
+	}
+	// The next window counts the viewer again
+	next, _ := dedup.FirstViews(context.Background(), []views.View{{ArticleID: 1, Viewer: "user:1", Time: now.Add(time.Hour)}})
+	if !next[0] {
+		t.Error("FirstViews in the next window = false, want true")
+	}
+}
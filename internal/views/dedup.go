@@ -0,0 +1,123 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+// Deduper decides which views count: a viewer counts once per article per window
+type Deduper interface {
+	// FirstViews reports, for each view, whether it is the viewer's first of the article in
+	// the window the view falls in
+	FirstViews(ctx context.Context, views []View) ([]bool, error)
+}
+
+// HyperLogLogStore is implemented by db.RedisClient
+type HyperLogLogStore interface {
+	AddToHyperLogLogs(ctx context.Context, keys, elements []string, ttl time.Duration) ([]bool, error)
+}
+
+// windowStart returns the start of the dedup window t falls in
+func windowStart(t time.Time, window time.Duration) time.Time {
+	return t.Truncate(window)
+}
+
+// redisDeduper keeps one HyperLogLog of viewers per article and window, shared across replicas.
+// A HyperLogLog takes at most 12 KB however many viewers it holds; in exchange a new viewer is
+// occasionally reported as seen, so counts are slightly low for very popular articles.
+type redisDeduper struct {
+	store  HyperLogLogStore
+	window time.Duration
+}
+
+// NewRedisDeduper creates a deduper backed by Redis HyperLogLogs
+func NewRedisDeduper(store HyperLogLogStore, window time.Duration) Deduper {
+	return &redisDeduper{store: store, window: window}
+}
+
+func (d *redisDeduper) FirstViews(ctx context.Context, views []View) ([]bool, error) {
+	keys := make([]string, len(views))
+	viewers := make([]string, len(views))
+	for i, v := range views {
+		keys[i] = fmt.Sprintf("views:%d:%d", v.ArticleID, windowStart(v.Time, d.window).Unix())
+		viewers[i] = v.Viewer
+	}
+	// A key is only written during its window, so it can expire one window later
+	return d.store.AddToHyperLogLogs(ctx, keys, viewers, 2*d.window)
+}
+
+// memoryKey identifies a viewer of an article in one window
+type memoryKey struct {
+	articleID int32
+	viewer    string
+	window    int64
+}
+
+// memoryDeduper remembers viewers per process.
+// Used as a fallback when Redis is unavailable, so views are deduplicated per replica.
+type memoryDeduper struct {
+	mu          sync.Mutex
+	window      time.Duration
+	seen        map[memoryKey]time.Time // When the window ends
+	lastCleanup time.Time
+}
+
+// NewMemoryDeduper creates an in-memory deduper
+func NewMemoryDeduper(window time.Duration) Deduper {
+	return &memoryDeduper{window: window, seen: make(map[memoryKey]time.Time), lastCleanup: time.Now()}
+}
+
+func (d *memoryDeduper) FirstViews(_ context.Context, views []View) ([]bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.cleanup(time.Now())
+	first := make([]bool, len(views))
+	for i, v := range views {
+		start := windowStart(v.Time, d.window)
+		key := memoryKey{articleID: v.ArticleID, viewer: v.Viewer, window: start.UnixNano()}
+		if _, ok := d.seen[key]; !ok {
+			d.seen[key] = start.Add(d.window)
+			first[i] = true
+		}
+	}
+	return first, nil
+}
+
+// cleanup forgets viewers of windows that have ended
+func (d *memoryDeduper) cleanup(now time.Time) {
+	if now.Sub(d.lastCleanup) < d.window {
+		return
+	}
+	for key, end := range d.seen {
+		if now.After(end) {
+			delete(d.seen, key)
+		}
+	}
+	d.lastCleanup = now
+}
+
+// fallbackDeduper uses the primary deduper and degrades to the secondary one
+// when the primary returns an error (e.g. Redis outage)
+type fallbackDeduper struct {
+	primary   Deduper
+	secondary Deduper
+}
+
+// NewFallbackDeduper creates a deduper that falls back to secondary when primary fails
+func NewFallbackDeduper(primary, secondary Deduper) Deduper {
+	return &fallbackDeduper{primary: primary, secondary: secondary}
+}
+
+func (d *fallbackDeduper) FirstViews(ctx context.Context, views []View) ([]bool, error) {
+	first, err := d.primary.FirstViews(ctx, views)
+	if err == nil {
+		return first, nil
+	}
+
+	log.Printf("[Views] WARN: Primary deduper failed, using in-memory fallback: views=%d, error=%v", len(views), err)
+	return d.secondary.FirstViews(ctx, views)
+}
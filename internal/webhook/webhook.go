@@ -0,0 +1,93 @@
+// Package webhook delivers article events to subscriber URLs as signed JSON POSTs.
+//
+// Deliveries are queued in the same transaction as the article change (see the repository),
+// and a Worker sends them, retrying failures with exponential backoff until MaxAttempts,
+// after which a delivery is dead-lettered. Every request is signed so receivers can check
+// that it came from this service and was not replayed:
+//
+//	X-Webhook-Timestamp: 1760780000
+//	X-Webhook-Signature: v1=hex(HMAC-SHA256(secret, "1760780000." + body))
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thatlq1812/service-2-article/internal/outbox"
+	pb "github.com/thatlq1812/service-2-article/proto"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Request headers
+const (
+	HeaderID        = "X-Webhook-Id"        // Delivery id, stable across retries
+	HeaderEvent     = "X-Webhook-Event"     // Event type, e.g. ArticleCreated
+	HeaderTimestamp = "X-Webhook-Timestamp" // Unix seconds the request was signed at
+	HeaderSignature = "X-Webhook-Signature" // "v1=" + hex HMAC-SHA256
+)
+
+// signatureVersion prefixes signatures so the scheme can change without breaking receivers
+const signatureVersion = "v1="
+
+// Sign returns the X-Webhook-Signature value for body sent at timestamp
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte{'.'})
+	mac.Write(body)
+	return signatureVersion + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the timestamp and signature headers of a received body, rejecting requests
+// signed more than tolerance ago (0 skips the age check). Receivers written in Go can use it as-is.
+func Verify(secret, timestamp, signature string, body []byte, tolerance time.Duration) error {
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid %s %q", HeaderTimestamp, timestamp)
+	}
+	signedAt := time.Unix(unix, 0)
+	if tolerance > 0 && time.Since(signedAt).Abs() > tolerance {
+		return fmt.Errorf("%s is outside the %s tolerance", HeaderTimestamp, tolerance)
+	}
+	if !strings.HasPrefix(signature, signatureVersion) {
+		return fmt.Errorf("unsupported %s %q", HeaderSignature, signature)
+	}
+	if !hmac.Equal([]byte(signature), []byte(Sign(secret, signedAt, body))) {
+		return fmt.Errorf("%s does not match", HeaderSignature)
+	}
+	return nil
+}
+
+// payload is the JSON body of a webhook request
+type payload struct {
+	EventID   int64           `json:"event_id"`
+	Type      string          `json:"type"`
+	Time      time.Time       `json:"time"`
+	ArticleID int32           `json:"article_id"`
+	Article   json.RawMessage `json:"article"` // protojson with proto field names
+}
+
+// articleJSON renders articles with proto field names (user_id, content_html, ...)
+var articleJSON = protojson.MarshalOptions{UseProtoNames: true}
+
+// Payload returns the JSON body sent for event, which must already have its outbox id
+func Payload(event *pb.ArticleEvent) ([]byte, error) {
+	article, err := articleJSON.Marshal(outbox.Article(event))
+	if err != nil {
+		return nil, fmt.Errorf("marshal article failed: %w", err)
+	}
+	return json.Marshal(payload{
+		EventID:   event.Id,
+		Type:      outbox.Type(event),
+		Time:      event.Time.AsTime().UTC(),
+		ArticleID: event.ArticleId,
+		Article:   article,
+	})
+}
@@ -0,0 +1,253 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/thatlq1812/service-2-article/internal/outbox"
+	"github.com/thatlq1812/service-2-article/internal/repository"
+	"github.com/thatlq1812/service-2-article/internal/webhook"
+	pb "github.com/thatlq1812/service-2-article/proto"
+)
+
+const testSecret = "0123456789abcdef"
+
+func TestSignAndVerify(t *testing.T) {
+	body := []byte(`{"event_id":1}`)
+	now := time.Now()
+	ts := strconv.FormatInt(now.Unix(), 10)
+	signature := webhook.Sign(testSecret, now, body)
+
+	if !strings.HasPrefix(signature, "v1=") {
+		t.Fatalf("Sign = %q, want a v1= signature", signature)
+	}
+	if err := webhook.Verify(testSecret, ts, signature, body, time.Minute); err != nil {
+		t.Errorf("Verify(valid) = %v, want nil", err)
+	}
+	if err := webhook.Verify("another-secret-value", ts, signature, body, time.Minute); err == nil {
+		t.Error("Verify(wrong secret) = nil, want error")
+	}
+	if err := webhook.Verify(testSecret, ts, signature, []byte(`{"event_id":2}`), time.Minute); err == nil {
+		t.Error("Verify(tampered body) = nil, want error")
+	}
+	old := now.Add(-time.Hour)
+	if err := webhook.Verify(testSecret, strconv.FormatInt(old.Unix(), 10), webhook.Sign(testSecret, old, body), body, 5*time.Minute); err == nil {
+		t.Error("Verify(old timestamp) = nil, want error")
+	}
+}
+
+// receiver is an httptest server that checks signatures and answers with the next status
+type receiver struct {
+	t        *testing.T
+	server   *httptest.Server
+	mu       sync.Mutex
+	statuses []int // answered in order; the last one repeats
+	bodies   [][]byte
+}
+
+func newReceiver(t *testing.T, statuses ...int) *receiver {
+	r := &receiver{t: t, statuses: statuses}
+	r.server = httptest.NewServer(http.HandlerFunc(r.handle))
+	t.Cleanup(r.server.Close)
+	return r
+}
+
+func (r *receiver) handle(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+	if err := webhook.Verify(testSecret, req.Header.Get(webhook.HeaderTimestamp), req.Header.Get(webhook.HeaderSignature), body, time.Minute); err != nil {
+		r.t.Errorf("receiver: %v", err)
+	}
+	if req.Header.Get(webhook.HeaderEvent) != outbox.EventCreated || req.Header.Get(webhook.HeaderID) == "" {
+		r.t.Errorf("receiver: headers %v, want event type and delivery id", req.Header)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.bodies = append(r.bodies, body)
+	status := r.statuses[0]
+	if len(r.statuses) > 1 {
+		r.statuses = r.statuses[1:]
+	}
+	w.WriteHeader(status)
+}
+
+func (r *receiver) requests() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.bodies)
+}
+
+// setup creates a webhook for url and queues one ArticleCreated event for it
+func setup(t *testing.T, url string) (repository.WebhookRepository, int32) {
+	t.Helper()
+	ctx := context.Background()
+	repo := repository.NewWebhookMemoryRepository()
+	created, err := repo.CreateWebhook(ctx, &pb.Webhook{UserId: 1, Url: url}, testSecret)
+	if err != nil {
+		t.Fatalf("CreateWebhook failed: %v", err)
+	}
+	event := outbox.Created(&pb.Article{Id: 7, UserId: 1, Title: "Hello"})
+	event.Id = 42
+	if err := repo.Enqueue(ctx, event); err != nil {
+		t.Fatalf("Enqueue failed: %v", err)
+	}
+	return repo, created.Id
+}
+
+func listDeliveries(t *testing.T, repo repository.WebhookRepository, webhookID int32) []*pb.WebhookDelivery {
+	t.Helper()
+	deliveries, _, err := repo.ListDeliveries(context.Background(), 1, webhookID, 0, 10, 0)
+	if err != nil {
+		t.Fatalf("ListDeliveries failed: %v", err)
+	}
+	return deliveries
+}
+
+func TestWorkerDeliversSignedPayload(t *testing.T) {
+	r := newReceiver(t, http.StatusOK)
+	repo, webhookID := setup(t, r.server.URL)
+	worker := webhook.NewWorker(repo, webhook.Options{AllowPrivate: true})
+
+	if n, err := worker.ProcessBatch(context.Background()); n != 1 || err != nil {
+		t.Fatalf("ProcessBatch = %d, %v; want 1, nil", n, err)
+	}
+
+	var payload struct {
+		EventID   int64           `json:"event_id"`
+		Type      string          `json:"type"`
+		ArticleID int32           `json:"article_id"`
+		Article   json.RawMessage `json:"article"`
+	}
+	if err := json.Unmarshal(r.bodies[0], &payload); err != nil {
+		t.Fatalf("payload is not JSON: %v", err)
+	}
+	if payload.EventID != 42 || payload.Type != outbox.EventCreated || payload.ArticleID != 7 {
+		t.Errorf("payload = %+v, want event 42, ArticleCreated, article 7", payload)
+	}
+	if !strings.Contains(string(payload.Article), `"user_id":1`) {
+		t.Errorf("payload article = %s, want proto field names", payload.Article)
+	}
+
+	deliveries := listDeliveries(t, repo, webhookID)
+	if len(deliveries) != 1 || deliveries[0].Status != pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DELIVERED {
+		t.Fatalf("deliveries = %v, want one DELIVERED", deliveries)
+	}
+	if log := deliveries[0].AttemptLog; len(log) != 1 || log[0].StatusCode != http.StatusOK {
+		t.Errorf("attempt log = %v, want one 200 attempt", log)
+	}
+
+	// Nothing is due any more
+	if n, _ := worker.ProcessBatch(context.Background()); n != 0 || r.requests() != 1 {
+		t.Errorf("second ProcessBatch claimed %d, receiver got %d requests; want 0, 1", n, r.requests())
+	}
+}
+
+func TestWorkerRetriesThenDeadLetters(t *testing.T) {
+	r := newReceiver(t, http.StatusInternalServerError)
+	repo, webhookID := setup(t, r.server.URL)
+	worker := webhook.NewWorker(repo, webhook.Options{
+		MaxAttempts:  3,
+		BackoffBase:  time.Millisecond,
+		BackoffMax:   5 * time.Millisecond,
+		AllowPrivate: true,
+	})
+
+	for attempt := 1; attempt <= 3; attempt++ {
+		time.Sleep(10 * time.Millisecond) // let the backoff pass
+		if n, err := worker.ProcessBatch(context.Background()); n != 1 || err != nil {
+			t.Fatalf("attempt %d: ProcessBatch = %d, %v; want 1, nil", attempt, n, err)
+		}
+		want := pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING
+		if attempt == 3 {
+			want = pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD
+		}
+		if got := listDeliveries(t, repo, webhookID)[0]; got.Status != want || got.Attempts != int32(attempt) {
+			t.Fatalf("attempt %d: delivery status %s, attempts %d; want %s, %d", attempt, got.Status, got.Attempts, want, attempt)
+		}
+	}
+
+	dead := listDeliveries(t, repo, webhookID)[0]
+	if len(dead.AttemptLog) != 3 || !strings.Contains(dead.LastError, "500") {
+		t.Errorf("dead delivery = %+v, want 3 logged attempts and a 500 error", dead)
+	}
+	time.Sleep(10 * time.Millisecond)
+	if n, _ := worker.ProcessBatch(context.Background()); n != 0 {
+		t.Errorf("ProcessBatch claimed %d dead deliveries, want 0", n)
+	}
+
+	// A retried delivery gets a fresh set of attempts
+	r.mu.Lock()
+	r.statuses = []int{http.StatusNoContent}
+	r.mu.Unlock()
+	if _, err := repo.RetryDelivery(context.Background(), 1, dead.Id); err != nil {
+		t.Fatalf("RetryDelivery failed: %v", err)
+	}
+	if n, err := worker.ProcessBatch(context.Background()); n != 1 || err != nil {
+		t.Fatalf("ProcessBatch after retry = %d, %v; want 1, nil", n, err)
+	}
+	if got := listDeliveries(t, repo, webhookID)[0]; got.Status != pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DELIVERED || len(got.AttemptLog) != 4 {
+		t.Errorf("retried delivery = %+v, want DELIVERED after 4 attempts", got)
+	}
+}
+
+func TestWorkerBlocksPrivateAddresses(t *testing.T) {
+	r := newReceiver(t, http.StatusOK)
+	repo, webhookID := setup(t, r.server.URL)
+	worker := webhook.NewWorker(repo, webhook.Options{})
+
+	if _, err := worker.ProcessBatch(context.Background()); err != nil {
+		t.Fatalf("ProcessBatch failed: %v", err)
+	}
+	if r.requests() != 0 {
+		t.Errorf("receiver on loopback got %d requests, want 0", r.requests())
+	}
+	got := listDeliveries(t, repo, webhookID)[0]
+	if got.Status != pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING || !strings.Contains(got.LastError, "not publicly routable") {
+		t.Errorf("delivery = %+v, want PENDING with a blocked address error", got)
+	}
+}
+
+// The dialer refuses these before connecting, so no request leaves the test
+func TestWorkerBlocksInternalRanges(t *testing.T) {
+	for _, url := range []string{
+		"http://10.0.0.1/hook",
+		"http://169.254.169.254/latest/meta-data",
+		"http://100.64.0.1/hook",           // carrier-grade NAT
+		"http://100.127.255.254/hook",      // carrier-grade NAT
+		"http://198.18.0.1/hook",           // benchmarking
+		"http://198.19.255.254/hook",       // benchmarking
+		"http://[64:ff9b::a9fe:a9fe]/hook", // NAT64 of 169.254.169.254
+		"http://[::ffff:100.64.0.1]/hook",  // IPv4-mapped carrier-grade NAT
+		"http://[fe80::1]/hook",
+		"http://[::1]/hook",
+	} {
+		t.Run(url, func(t *testing.T) {
+			repo, webhookID := setup(t, url)
+			worker := webhook.NewWorker(repo, webhook.Options{Timeout: time.Second})
+
+			if _, err := worker.ProcessBatch(context.Background()); err != nil {
+				t.Fatalf("ProcessBatch failed: %v", err)
+			}
+			if got := listDeliveries(t, repo, webhookID)[0]; !strings.Contains(got.LastError, "not publicly routable") {
+				t.Errorf("delivery error = %q, want a blocked address error", got.LastError)
+			}
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	worker := webhook.NewWorker(nil, webhook.Options{BackoffBase: time.Second, BackoffMax: 10 * time.Second})
+	for attempts, want := range map[int32]time.Duration{1: time.Second, 2: 2 * time.Second, 4: 8 * time.Second, 5: 10 * time.Second, 40: 10 * time.Second} {
+		if got := worker.Backoff(attempts); got != want {
+			t.Errorf("Backoff(%d) = %s, want %s", attempts, got, want)
+		}
+	}
+}
@@ -0,0 +1,304 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	pb "github.com/thatlq1812/service-2-article/proto"
+)
+
+// Delivery is a queued request claimed by a Worker
+type Delivery struct {
+	ID        int64
+	WebhookID int32
+	URL       string
+	Secret    string
+	EventType string
+	Payload   []byte // JSON body, see Payload
+	Attempts  int32  // Attempts made before this one
+}
+
+// Attempt is the outcome of one HTTP request
+type Attempt struct {
+	Time       time.Time
+	StatusCode int32 // 0 when no response was received
+	Error      string
+	Duration   time.Duration
+}
+
+// Result is what a Worker records after an attempt: the delivery is DELIVERED, DEAD, or
+// PENDING again until NextAttempt
+type Result struct {
+	Attempt
+	Status      pb.WebhookDeliveryStatus
+	NextAttempt time.Time
+}
+
+// Store queues deliveries (implemented by repository.WebhookRepository)
+type Store interface {
+	// ClaimDeliveries returns up to limit PENDING deliveries that are due and hides them from
+	// other workers for lease, so a delivery whose worker dies is retried once the lease ends
+	ClaimDeliveries(ctx context.Context, limit int, lease time.Duration) ([]Delivery, error)
+
+	// RecordAttempt logs an attempt and moves the delivery to result.Status
+	RecordAttempt(ctx context.Context, id int64, result Result) error
+
+	// PruneDeliveries deletes DELIVERED and DEAD deliveries created before before
+	PruneDeliveries(ctx context.Context, before time.Time) (int64, error)
+}
+
+// Options configure a Worker
+type Options struct {
+	MaxAttempts  int32         // Attempts before a delivery is dead-lettered
+	BackoffBase  time.Duration // Wait after the first failure; doubles after each further one
+	BackoffMax   time.Duration // Longest wait between attempts
+	Timeout      time.Duration // Per request, including reading the response
+	PollInterval time.Duration // Wait between polls when no delivery is due
+	BatchSize    int           // Deliveries claimed, and sent concurrently, at a time
+	Retention    time.Duration // How long finished (DELIVERED, DEAD) deliveries are kept; 0 keeps them forever
+	AllowPrivate bool          // Allow loopback, private, link-local and other internal addresses (local development, tests)
+}
+
+// Worker sends queued deliveries
+type Worker struct {
+	store  Store
+	opts   Options
+	client *http.Client
+}
+
+// errBlockedAddress is returned when a webhook URL resolves to an address that is not allowed
+var errBlockedAddress = errors.New("webhook address is not publicly routable")
+
+// blockedPrefixes are non-public ranges that net.IP's predicates do not cover but that
+// commonly reach internal hosts in cloud networks
+var blockedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("100.64.0.0/10"), // Carrier-grade NAT (RFC 6598)
+	netip.MustParsePrefix("198.18.0.0/15"), // Benchmarking (RFC 2544)
+	netip.MustParsePrefix("64:ff9b::/96"),  // NAT64 (RFC 6052), embeds an IPv4 address
+}
+
+// NewWorker creates a worker; zero options get defaults
+// (8 attempts, 30s doubling up to 1h, 10s timeout, 2s poll, 20 deliveries per batch)
+func NewWorker(store Store, opts Options) *Worker {
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = 8
+	}
+	if opts.BackoffBase <= 0 {
+		opts.BackoffBase = 30 * time.Second
+	}
+	if opts.BackoffMax <= 0 {
+		opts.BackoffMax = time.Hour
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = 10 * time.Second
+	}
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = 2 * time.Second
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 20
+	}
+	return &Worker{store: store, opts: opts, client: newClient(opts)}
+}
+
+// newClient returns a client that does not follow redirects or use proxies and, unless
+// AllowPrivate is set, refuses to connect to internal addresses, so subscribers cannot
+// point webhooks at services inside the network
+func newClient(opts Options) *http.Client {
+	dialer := &net.Dialer{Timeout: opts.Timeout}
+	if !opts.AllowPrivate {
+		// Checked after DNS resolution, for the address actually dialed
+		dialer.Control = func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip, err := netip.ParseAddr(host)
+			if err != nil || !publicAddress(ip.Unmap()) {
+				return errBlockedAddress
+			}
+			return nil
+		}
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Timeout:   opts.Timeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// publicAddress reports whether ip may receive webhooks
+func publicAddress(ip netip.Addr) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsMulticast() {
+		return false
+	}
+	for _, prefix := range blockedPrefixes {
+		if prefix.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// Backoff returns the wait after the given number of failed attempts
+func (w *Worker) Backoff(attempts int32) time.Duration {
+	wait := w.opts.BackoffBase
+	for i := int32(1); i < attempts; i++ {
+		wait *= 2
+		if wait >= w.opts.BackoffMax {
+			return w.opts.BackoffMax
+		}
+	}
+	return min(wait, w.opts.BackoffMax)
+}
+
+// pruneInterval is how often finished deliveries older than the retention are deleted
+const pruneInterval = time.Hour
+
+// Run sends deliveries until ctx is cancelled
+func (w *Worker) Run(ctx context.Context) {
+	log.Printf("[Webhook] Worker started (batch=%d, poll=%s, max_attempts=%d)", w.opts.BatchSize, w.opts.PollInterval, w.opts.MaxAttempts)
+	var lastPrune time.Time
+
+	for {
+		processed, err := w.ProcessBatch(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Printf("[Webhook] ERROR: Batch failed: %v", err)
+		}
+
+		if w.opts.Retention > 0 && time.Since(lastPrune) >= pruneInterval {
+			w.prune(ctx)
+			lastPrune = time.Now()
+		}
+
+		// A full batch means more deliveries are probably due
+		if err == nil && processed == w.opts.BatchSize {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			log.Printf("[Webhook] Worker stopped")
+			return
+		case <-time.After(w.opts.PollInterval):
+		}
+	}
+}
+
+// ProcessBatch claims up to BatchSize due deliveries, sends them concurrently and records
+// the results. It returns the number of deliveries claimed.
+func (w *Worker) ProcessBatch(ctx context.Context) (int, error) {
+	// The lease outlives the slowest request, so nobody else claims a delivery in flight
+	deliveries, err := w.store.ClaimDeliveries(ctx, w.opts.BatchSize, 2*w.opts.Timeout+time.Minute)
+	if err != nil {
+		return 0, fmt.Errorf("claim deliveries failed: %w", err)
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, len(deliveries))
+	for i, d := range deliveries {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result := w.send(ctx, d)
+			if ctx.Err() != nil {
+				// Shutting down: the lease expires and the delivery is sent again
+				return
+			}
+			if err := w.store.RecordAttempt(ctx, d.ID, result); err != nil {
+				errs[i] = fmt.Errorf("record delivery %d failed: %w", d.ID, err)
+			}
+		}()
+	}
+	wg.Wait()
+	return len(deliveries), errors.Join(errs...)
+}
+
+// prune deletes finished deliveries older than the retention
+func (w *Worker) prune(ctx context.Context) {
+	pruned, err := w.store.PruneDeliveries(ctx, time.Now().Add(-w.opts.Retention))
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("[Webhook] ERROR: Prune failed: %v", err)
+		}
+		return
+	}
+	if pruned > 0 {
+		log.Printf("[Webhook] Pruned %d finished deliveries", pruned)
+	}
+}
+
+// send POSTs one delivery and decides what happens next
+func (w *Worker) send(ctx context.Context, d Delivery) Result {
+	start := time.Now()
+	statusCode, err := w.post(ctx, d, start)
+	result := Result{Attempt: Attempt{Time: start, StatusCode: int32(statusCode), Duration: time.Since(start)}}
+
+	attempts := d.Attempts + 1
+	switch {
+	case err == nil:
+		result.Status = pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DELIVERED
+		log.Printf("[Webhook] Delivered: id=%d, webhook_id=%d, event=%s, attempt=%d", d.ID, d.WebhookID, d.EventType, attempts)
+	case attempts >= w.opts.MaxAttempts:
+		result.Error = err.Error()
+		result.Status = pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD
+		log.Printf("[Webhook] WARNING: Dead-lettered: id=%d, webhook_id=%d, attempts=%d, error=%v", d.ID, d.WebhookID, attempts, err)
+	default:
+		result.Error = err.Error()
+		result.Status = pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING
+		result.NextAttempt = start.Add(w.Backoff(attempts))
+		log.Printf("[Webhook] Attempt failed: id=%d, webhook_id=%d, attempt=%d, retry_at=%s, error=%v",
+			d.ID, d.WebhookID, attempts, result.NextAttempt.Format(time.RFC3339), err)
+	}
+	return result
+}
+
+// maxErrorBody is how much of a failed response body is kept in the attempt log
+const maxErrorBody = 256
+
+// post sends the signed request; any status other than 2xx is an error
+func (w *Worker) post(ctx context.Context, d Delivery, now time.Time) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.URL, bytes.NewReader(d.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "article-service-webhook/1")
+	req.Header.Set(HeaderID, strconv.FormatInt(d.ID, 10))
+	req.Header.Set(HeaderEvent, d.EventType)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(now.Unix(), 10))
+	req.Header.Set(HeaderSignature, Sign(d.Secret, now, d.Payload))
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10)) // let the connection be reused
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		if text := strings.TrimSpace(strings.ToValidUTF8(string(body), "?")); text != "" {
+			return resp.StatusCode, fmt.Errorf("webhook returned %s: %s", resp.Status, text)
+		}
+		return resp.StatusCode, fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_articles_user_id;
+
+DROP TABLE IF EXISTS articles;
@@ -0,0 +1,4 @@
+-- content_format mirrors the ContentFormat proto enum (0 = PLAIN, 1 = MARKDOWN, 2 = HTML)
+ALTER TABLE articles
+    ADD COLUMN IF NOT EXISTS content_format SMALLINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS content_html TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,14 @@
+ALTER TABLE articles
+    ADD COLUMN IF NOT EXISTS excerpt TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS word_count INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS reading_time_minutes INTEGER NOT NULL DEFAULT 0;
+
+-- Approximate values for existing rows (markup is not stripped);
+-- the service recomputes them exactly on the next update
+UPDATE articles
+SET word_count = COALESCE(array_length(regexp_split_to_array(btrim(content), '\s+'), 1), 0),
+    excerpt = left(regexp_replace(btrim(content), '\s+', ' ', 'g'), 200)
+WHERE btrim(content) <> '';
+
+UPDATE articles
+SET reading_time_minutes = CEIL(word_count / 200.0)::INTEGER;
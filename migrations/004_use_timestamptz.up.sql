@@ -0,0 +1,13 @@
+-- Existing values were written in the server's time zone; interpret them in it
+ALTER TABLE articles
+    ALTER COLUMN created_at TYPE TIMESTAMPTZ USING created_at AT TIME ZONE current_setting('TimeZone'),
+    ALTER COLUMN updated_at TYPE TIMESTAMPTZ USING updated_at AT TIME ZONE current_setting('TimeZone');
+
+UPDATE articles
+SET created_at = COALESCE(created_at, CURRENT_TIMESTAMP),
+    updated_at = COALESCE(updated_at, created_at, CURRENT_TIMESTAMP)
+WHERE created_at IS NULL OR updated_at IS NULL;
+
+ALTER TABLE articles
+    ALTER COLUMN created_at SET NOT NULL,
+    ALTER COLUMN updated_at SET NOT NULL;
@@ -0,0 +1,13 @@
+-- Article events written in the same transaction as the change and published by the relay.
+-- Published rows are kept for OUTBOX_RETENTION so consumers can replay recent events.
+CREATE TABLE IF NOT EXISTS outbox (
+    id BIGSERIAL PRIMARY KEY,
+    article_id INTEGER NOT NULL,
+    event_type VARCHAR(50) NOT NULL,
+    payload BYTEA NOT NULL, -- serialized article.ArticleEvent without id
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    published_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_unpublished ON outbox(id) WHERE published_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_outbox_published_at ON outbox(published_at);
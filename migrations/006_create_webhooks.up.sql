@@ -0,0 +1,42 @@
+-- Partner webhook subscriptions; an empty event_types array and author_id 0 match everything
+CREATE TABLE IF NOT EXISTS webhooks (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL, -- owner
+    url TEXT NOT NULL,
+    secret TEXT NOT NULL,     -- HMAC-SHA256 key, never returned by the API
+    event_types TEXT[] NOT NULL DEFAULT '{}',
+    author_id INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhooks_user_id ON webhooks(user_id);
+
+-- One row per (webhook, event), written in the same transaction as the article change
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id BIGSERIAL PRIMARY KEY,
+    webhook_id INTEGER NOT NULL REFERENCES webhooks(id) ON DELETE CASCADE,
+    event_id BIGINT NOT NULL,
+    event_type VARCHAR(50) NOT NULL,
+    article_id INTEGER NOT NULL,
+    payload BYTEA NOT NULL, -- JSON body
+    status SMALLINT NOT NULL DEFAULT 1, -- article.WebhookDeliveryStatus: 1 pending, 2 delivered, 3 dead
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    delivered_at TIMESTAMPTZ,
+    UNIQUE (webhook_id, event_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due ON webhook_deliveries(next_attempt_at) WHERE status = 1;
+
+CREATE TABLE IF NOT EXISTS webhook_attempts (
+    id BIGSERIAL PRIMARY KEY,
+    delivery_id BIGINT NOT NULL REFERENCES webhook_deliveries(id) ON DELETE CASCADE,
+    attempted_at TIMESTAMPTZ NOT NULL,
+    status_code INTEGER NOT NULL DEFAULT 0, -- 0 when no response was received
+    error TEXT NOT NULL DEFAULT '',
+    duration_ms INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_attempts_delivery_id ON webhook_attempts(delivery_id);
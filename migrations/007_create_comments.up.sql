@@ -0,0 +1,20 @@
+-- Reader comments. Replies keep parent_id and the top-level comment of their thread (thread_id),
+-- so a page of top-level comments and all of their replies take two queries
+CREATE TABLE IF NOT EXISTS comments (
+    id SERIAL PRIMARY KEY,
+    article_id INTEGER NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    parent_id INTEGER REFERENCES comments(id) ON DELETE CASCADE, -- NULL for top-level comments
+    thread_id INTEGER REFERENCES comments(id) ON DELETE CASCADE, -- NULL for top-level comments
+    depth SMALLINT NOT NULL DEFAULT 0, -- 0 for top-level comments
+    user_id INTEGER NOT NULL,
+    content TEXT NOT NULL,
+    hidden BOOLEAN NOT NULL DEFAULT FALSE, -- hidden by the article author
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_comments_article_id ON comments(article_id, created_at, id);
+CREATE INDEX IF NOT EXISTS idx_comments_thread_id ON comments(thread_id);
+
+-- Visible comments, kept in step by the comment repository
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS comment_count INTEGER NOT NULL DEFAULT 0;
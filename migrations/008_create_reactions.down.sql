@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_articles_popular;
+ALTER TABLE articles
+    DROP COLUMN IF EXISTS reaction_counts,
+    DROP COLUMN IF EXISTS reaction_count;
+DROP TABLE IF EXISTS article_reactions;
@@ -0,0 +1,18 @@
+-- One row per (article, user, reaction type)
+CREATE TABLE IF NOT EXISTS article_reactions (
+    article_id INTEGER NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL,
+    type SMALLINT NOT NULL, -- article.ReactionType
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (article_id, user_id, type)
+);
+
+CREATE INDEX IF NOT EXISTS idx_article_reactions_article_id ON article_reactions(article_id, created_at DESC);
+
+-- Counters updated in the transaction that adds or removes a reaction;
+-- reaction_counts maps a ReactionType number to its count, e.g. {"1": 12, "3": 2}
+ALTER TABLE articles
+    ADD COLUMN IF NOT EXISTS reaction_count INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS reaction_counts JSONB NOT NULL DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS idx_articles_popular ON articles(reaction_count DESC, created_at DESC);
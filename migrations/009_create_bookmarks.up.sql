@@ -0,0 +1,28 @@
+-- Named reading lists; the default list of a user is implicit (bookmarks.collection_id IS NULL)
+CREATE TABLE IF NOT EXISTS collections (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    position INTEGER NOT NULL DEFAULT 0,
+    share_token TEXT, -- NULL unless shared
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (user_id, name)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_collections_share_token ON collections(share_token) WHERE share_token IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS bookmarks (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL,
+    article_id INTEGER NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    collection_id INTEGER REFERENCES collections(id) ON DELETE CASCADE, -- NULL for the default list
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- An article is saved once per list; the indexes also serve the newest-first keyset pagination
+CREATE UNIQUE INDEX IF NOT EXISTS idx_bookmarks_default ON bookmarks(user_id, article_id) WHERE collection_id IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_bookmarks_collection ON bookmarks(collection_id, article_id);
+CREATE INDEX IF NOT EXISTS idx_bookmarks_default_page ON bookmarks(user_id, id DESC) WHERE collection_id IS NULL;
+CREATE INDEX IF NOT EXISTS idx_bookmarks_collection_page ON bookmarks(collection_id, id DESC);
+CREATE INDEX IF NOT EXISTS idx_bookmarks_article_id ON bookmarks(article_id);
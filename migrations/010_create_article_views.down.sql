@@ -0,0 +1,2 @@
+ALTER TABLE articles DROP COLUMN IF EXISTS view_count;
+DROP TABLE IF EXISTS article_views;
@@ -0,0 +1,13 @@
+-- Deduplicated views per article and hour, flushed in batches by the view counter;
+-- ListTrendingArticles ranks articles over the recent buckets
+CREATE TABLE IF NOT EXISTS article_views (
+    article_id INTEGER NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    hour TIMESTAMPTZ NOT NULL, -- Start of the hour the views fell in
+    views BIGINT NOT NULL,
+    PRIMARY KEY (article_id, hour)
+);
+
+CREATE INDEX IF NOT EXISTS idx_article_views_hour ON article_views(hour, article_id);
+
+-- All-time total, updated with the buckets
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS view_count BIGINT NOT NULL DEFAULT 0;
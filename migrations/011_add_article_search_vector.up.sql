@@ -0,0 +1,9 @@
+-- Weighted title (A) and content (B) lexemes for GetRelatedArticles; kept current by Postgres
+-- on every insert and update. Adding a stored generated column rewrites the table once
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS search_vector TSVECTOR
+    GENERATED ALWAYS AS (
+        setweight(to_tsvector('english', title), 'A') ||
+        setweight(to_tsvector('english', content), 'B')
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_articles_search_vector ON articles USING GIN (search_vector);
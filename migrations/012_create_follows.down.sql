@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_articles_feed;
+DROP TABLE IF EXISTS follows;
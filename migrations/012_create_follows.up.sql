@@ -0,0 +1,14 @@
+-- Authors followed by users; both are User Service users, so there are no foreign keys
+CREATE TABLE IF NOT EXISTS follows (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL, -- The follower
+    author_id INTEGER NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (user_id, author_id)
+);
+
+-- Newest-first keyset pagination of ListFollowing
+CREATE INDEX IF NOT EXISTS idx_follows_page ON follows(user_id, id DESC);
+
+-- GetFeed reads the newest articles of each followed author from this index, then merges them
+CREATE INDEX IF NOT EXISTS idx_articles_feed ON articles(user_id, created_at DESC, id DESC);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_comments_thread_id;
+CREATE INDEX IF NOT EXISTS idx_comments_thread_id ON comments(thread_id);
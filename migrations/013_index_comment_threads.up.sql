@@ -0,0 +1,4 @@
+-- Replies are paged per thread in ID order (ListComments caps them, ListReplies continues),
+-- so the thread index also covers the order
+DROP INDEX IF EXISTS idx_comments_thread_id;
+CREATE INDEX IF NOT EXISTS idx_comments_thread_id ON comments(thread_id, id);
@@ -0,0 +1,8 @@
+// Package migrations embeds the SQL schema migrations into the binary.
+// Files are named NNN_description.up.sql / NNN_description.down.sql.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
@@ -9,6 +9,10 @@ package proto
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,14 +25,329 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ContentFormat describes how article content is written
+type ContentFormat int32
+
+const (
+	ContentFormat_CONTENT_FORMAT_PLAIN    ContentFormat = 0 // Plain text, rendered as-is
+	ContentFormat_CONTENT_FORMAT_MARKDOWN ContentFormat = 1 // CommonMark + GFM, rendered to content_html
+	ContentFormat_CONTENT_FORMAT_HTML     ContentFormat = 2 // HTML, sanitised on write
+)
+
+// Enum value maps for ContentFormat.
+var (
+	ContentFormat_name = map[int32]string{
+		0: "CONTENT_FORMAT_PLAIN",
+		1: "CONTENT_FORMAT_MARKDOWN",
+		2: "CONTENT_FORMAT_HTML",
+	}
+	ContentFormat_value = map[string]int32{
+		"CONTENT_FORMAT_PLAIN":    0,
+		"CONTENT_FORMAT_MARKDOWN": 1,
+		"CONTENT_FORMAT_HTML":     2,
+	}
+)
+
+func (x ContentFormat) Enum() *ContentFormat {
+	p := new(ContentFormat)
+	*p = x
+	return p
+}
+
+func (x ContentFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ContentFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_article_service_proto_enumTypes[0].Descriptor()
+}
+
+func (ContentFormat) Type() protoreflect.EnumType {
+	return &file_article_service_proto_enumTypes[0]
+}
+
+func (x ContentFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ContentFormat.Descriptor instead.
+func (ContentFormat) EnumDescriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{0}
+}
+
+// ArticleView selects how much of an article is returned
+type ArticleView int32
+
+const (
+	ArticleView_ARTICLE_VIEW_UNSPECIFIED ArticleView = 0 // FULL for GetArticle and ListArticles, BASIC for the feed, trending and related lists
+	ArticleView_ARTICLE_VIEW_BASIC       ArticleView = 1 // Everything except content and content_html
+	ArticleView_ARTICLE_VIEW_FULL        ArticleView = 2 // All fields
+)
+
+// Enum value maps for ArticleView.
+var (
+	ArticleView_name = map[int32]string{
+		0: "ARTICLE_VIEW_UNSPECIFIED",
+		1: "ARTICLE_VIEW_BASIC",
+		2: "ARTICLE_VIEW_FULL",
+	}
+	ArticleView_value = map[string]int32{
+		"ARTICLE_VIEW_UNSPECIFIED": 0,
+		"ARTICLE_VIEW_BASIC":       1,
+		"ARTICLE_VIEW_FULL":        2,
+	}
+)
+
+func (x ArticleView) Enum() *ArticleView {
+	p := new(ArticleView)
+	*p = x
+	return p
+}
+
+func (x ArticleView) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ArticleView) Descriptor() protoreflect.EnumDescriptor {
+	return file_article_service_proto_enumTypes[1].Descriptor()
+}
+
+func (ArticleView) Type() protoreflect.EnumType {
+	return &file_article_service_proto_enumTypes[1]
+}
+
+func (x ArticleView) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ArticleView.Descriptor instead.
+func (ArticleView) EnumDescriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{1}
+}
+
+// ReactionType is a reader's reaction to an article
+type ReactionType int32
+
+const (
+	ReactionType_REACTION_TYPE_UNSPECIFIED ReactionType = 0
+	ReactionType_REACTION_TYPE_LIKE        ReactionType = 1
+	ReactionType_REACTION_TYPE_LOVE        ReactionType = 2
+	ReactionType_REACTION_TYPE_INSIGHTFUL  ReactionType = 3
+	ReactionType_REACTION_TYPE_FUNNY       ReactionType = 4
+	ReactionType_REACTION_TYPE_CELEBRATE   ReactionType = 5
+)
+
+// Enum value maps for ReactionType.
+var (
+	ReactionType_name = map[int32]string{
+		0: "REACTION_TYPE_UNSPECIFIED",
+		1: "REACTION_TYPE_LIKE",
+		2: "REACTION_TYPE_LOVE",
+		3: "REACTION_TYPE_INSIGHTFUL",
+		4: "REACTION_TYPE_FUNNY",
+		5: "REACTION_TYPE_CELEBRATE",
+	}
+	ReactionType_value = map[string]int32{
+		"REACTION_TYPE_UNSPECIFIED": 0,
+		"REACTION_TYPE_LIKE":        1,
+		"REACTION_TYPE_LOVE":        2,
+		"REACTION_TYPE_INSIGHTFUL":  3,
+		"REACTION_TYPE_FUNNY":       4,
+		"REACTION_TYPE_CELEBRATE":   5,
+	}
+)
+
+func (x ReactionType) Enum() *ReactionType {
+	p := new(ReactionType)
+	*p = x
+	return p
+}
+
+func (x ReactionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReactionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_article_service_proto_enumTypes[2].Descriptor()
+}
+
+func (ReactionType) Type() protoreflect.EnumType {
+	return &file_article_service_proto_enumTypes[2]
+}
+
+func (x ReactionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReactionType.Descriptor instead.
+func (ReactionType) EnumDescriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{2}
+}
+
+// ArticleOrder sorts ListArticles
+type ArticleOrder int32
+
+const (
+	ArticleOrder_ARTICLE_ORDER_UNSPECIFIED ArticleOrder = 0 // NEWEST
+	ArticleOrder_ARTICLE_ORDER_NEWEST      ArticleOrder = 1 // Newest first
+	ArticleOrder_ARTICLE_ORDER_POPULAR     ArticleOrder = 2 // Most reactions first, then newest
+)
+
+// Enum value maps for ArticleOrder.
+var (
+	ArticleOrder_name = map[int32]string{
+		0: "ARTICLE_ORDER_UNSPECIFIED",
+		1: "ARTICLE_ORDER_NEWEST",
+		2: "ARTICLE_ORDER_POPULAR",
+	}
+	ArticleOrder_value = map[string]int32{
+		"ARTICLE_ORDER_UNSPECIFIED": 0,
+		"ARTICLE_ORDER_NEWEST":      1,
+		"ARTICLE_ORDER_POPULAR":     2,
+	}
+)
+
+func (x ArticleOrder) Enum() *ArticleOrder {
+	p := new(ArticleOrder)
+	*p = x
+	return p
+}
+
+func (x ArticleOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ArticleOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_article_service_proto_enumTypes[3].Descriptor()
+}
+
+func (ArticleOrder) Type() protoreflect.EnumType {
+	return &file_article_service_proto_enumTypes[3]
+}
+
+func (x ArticleOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ArticleOrder.Descriptor instead.
+func (ArticleOrder) EnumDescriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{3}
+}
+
+// TrendingWindow is the period ListTrendingArticles ranks views over
+type TrendingWindow int32
+
+const (
+	TrendingWindow_TRENDING_WINDOW_UNSPECIFIED TrendingWindow = 0 // DAY
+	TrendingWindow_TRENDING_WINDOW_DAY         TrendingWindow = 1 // Last 24 hours, views lose half their weight every 6 hours
+	TrendingWindow_TRENDING_WINDOW_WEEK        TrendingWindow = 2 // Last 7 days, views lose half their weight every 2 days
+)
+
+// Enum value maps for TrendingWindow.
+var (
+	TrendingWindow_name = map[int32]string{
+		0: "TRENDING_WINDOW_UNSPECIFIED",
+		1: "TRENDING_WINDOW_DAY",
+		2: "TRENDING_WINDOW_WEEK",
+	}
+	TrendingWindow_value = map[string]int32{
+		"TRENDING_WINDOW_UNSPECIFIED": 0,
+		"TRENDING_WINDOW_DAY":         1,
+		"TRENDING_WINDOW_WEEK":        2,
+	}
+)
+
+func (x TrendingWindow) Enum() *TrendingWindow {
+	p := new(TrendingWindow)
+	*p = x
+	return p
+}
+
+func (x TrendingWindow) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TrendingWindow) Descriptor() protoreflect.EnumDescriptor {
+	return file_article_service_proto_enumTypes[4].Descriptor()
+}
+
+func (TrendingWindow) Type() protoreflect.EnumType {
+	return &file_article_service_proto_enumTypes[4]
+}
+
+func (x TrendingWindow) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TrendingWindow.Descriptor instead.
+func (TrendingWindow) EnumDescriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{4}
+}
+
+type WebhookDeliveryStatus int32
+
+const (
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED WebhookDeliveryStatus = 0
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING     WebhookDeliveryStatus = 1 // Waiting for its first or next attempt
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DELIVERED   WebhookDeliveryStatus = 2 // A 2xx response was received
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD        WebhookDeliveryStatus = 3 // Gave up after the maximum attempts (dead letter)
+)
+
+// Enum value maps for WebhookDeliveryStatus.
+var (
+	WebhookDeliveryStatus_name = map[int32]string{
+		0: "WEBHOOK_DELIVERY_STATUS_UNSPECIFIED",
+		1: "WEBHOOK_DELIVERY_STATUS_PENDING",
+		2: "WEBHOOK_DELIVERY_STATUS_DELIVERED",
+		3: "WEBHOOK_DELIVERY_STATUS_DEAD",
+	}
+	WebhookDeliveryStatus_value = map[string]int32{
+		"WEBHOOK_DELIVERY_STATUS_UNSPECIFIED": 0,
+		"WEBHOOK_DELIVERY_STATUS_PENDING":     1,
+		"WEBHOOK_DELIVERY_STATUS_DELIVERED":   2,
+		"WEBHOOK_DELIVERY_STATUS_DEAD":        3,
+	}
+)
+
+func (x WebhookDeliveryStatus) Enum() *WebhookDeliveryStatus {
+	p := new(WebhookDeliveryStatus)
+	*p = x
+	return p
+}
+
+func (x WebhookDeliveryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebhookDeliveryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_article_service_proto_enumTypes[5].Descriptor()
+}
+
+func (WebhookDeliveryStatus) Type() protoreflect.EnumType {
+	return &file_article_service_proto_enumTypes[5]
+}
+
+func (x WebhookDeliveryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebhookDeliveryStatus.Descriptor instead.
+func (WebhookDeliveryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{5}
+}
+
 // User message - lightweight copy for Article Service
 type User struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	// Deprecated: Marked as deprecated in article_service.proto.
+	CreatedAt string `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Use create_time
+	// Deprecated: Marked as deprecated in article_service.proto.
+	UpdatedAt     string                 `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Use update_time
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -84,6 +403,7 @@ func (x *User) GetEmail() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in article_service.proto.
 func (x *User) GetCreatedAt() string {
 	if x != nil {
 		return x.CreatedAt
@@ -91,6 +411,7 @@ func (x *User) GetCreatedAt() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in article_service.proto.
 func (x *User) GetUpdatedAt() string {
 	if x != nil {
 		return x.UpdatedAt
@@ -98,16 +419,43 @@ func (x *User) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *User) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *User) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
 type Article struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
-	UserId        int32                  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Foregin key
-	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title   string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	UserId  int32                  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Foregin key
+	// Deprecated: Marked as deprecated in article_service.proto.
+	CreatedAt string `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC3339 in UTC; use create_time
+	// Deprecated: Marked as deprecated in article_service.proto.
+	UpdatedAt          string                 `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // RFC3339 in UTC; use update_time
+	ContentFormat      ContentFormat          `protobuf:"varint,7,opt,name=content_format,json=contentFormat,proto3,enum=article.ContentFormat" json:"content_format,omitempty"`
+	ContentHtml        string                 `protobuf:"bytes,8,opt,name=content_html,json=contentHtml,proto3" json:"content_html,omitempty"` // Sanitised HTML for MARKDOWN and HTML content, empty for PLAIN
+	Excerpt            string                 `protobuf:"bytes,9,opt,name=excerpt,proto3" json:"excerpt,omitempty"`                            // Up to 200 characters of plain text, cut at a sentence or word boundary
+	WordCount          int32                  `protobuf:"varint,10,opt,name=word_count,json=wordCount,proto3" json:"word_count,omitempty"`
+	ReadingTimeMinutes int32                  `protobuf:"varint,11,opt,name=reading_time_minutes,json=readingTimeMinutes,proto3" json:"reading_time_minutes,omitempty"` // Estimated at 200 words per minute
+	CreateTime         *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime         *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	CommentCount       int32                  `protobuf:"varint,14,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`      // Visible (not hidden) comments. Output only
+	ReactionCount      int32                  `protobuf:"varint,15,opt,name=reaction_count,json=reactionCount,proto3" json:"reaction_count,omitempty"`   // Reactions of every type. Output only
+	ReactionCounts     []*ReactionCount       `protobuf:"bytes,16,rep,name=reaction_counts,json=reactionCounts,proto3" json:"reaction_counts,omitempty"` // Per type, in ReactionType order; types without reactions are omitted. Output only
+	ViewCount          int64                  `protobuf:"varint,17,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`               // Deduplicated views, updated in batches. Output only
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *Article) Reset() {
@@ -147,64 +495,7094 @@ func (x *Article) GetId() int32 {
 	return 0
 }
 
-func (x *Article) GetTitle() string {
+func (x *Article) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Article) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Article) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in article_service.proto.
+func (x *Article) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in article_service.proto.
+func (x *Article) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Article) GetContentFormat() ContentFormat {
+	if x != nil {
+		return x.ContentFormat
+	}
+	return ContentFormat_CONTENT_FORMAT_PLAIN
+}
+
+func (x *Article) GetContentHtml() string {
+	if x != nil {
+		return x.ContentHtml
+	}
+	return ""
+}
+
+func (x *Article) GetExcerpt() string {
+	if x != nil {
+		return x.Excerpt
+	}
+	return ""
+}
+
+func (x *Article) GetWordCount() int32 {
+	if x != nil {
+		return x.WordCount
+	}
+	return 0
+}
+
+func (x *Article) GetReadingTimeMinutes() int32 {
+	if x != nil {
+		return x.ReadingTimeMinutes
+	}
+	return 0
+}
+
+func (x *Article) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Article) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *Article) GetCommentCount() int32 {
+	if x != nil {
+		return x.CommentCount
+	}
+	return 0
+}
+
+func (x *Article) GetReactionCount() int32 {
+	if x != nil {
+		return x.ReactionCount
+	}
+	return 0
+}
+
+func (x *Article) GetReactionCounts() []*ReactionCount {
+	if x != nil {
+		return x.ReactionCounts
+	}
+	return nil
+}
+
+func (x *Article) GetViewCount() int64 {
+	if x != nil {
+		return x.ViewCount
+	}
+	return 0
+}
+
+type ReactionCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          ReactionType           `protobuf:"varint,1,opt,name=type,proto3,enum=article.ReactionType" json:"type,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactionCount) Reset() {
+	*x = ReactionCount{}
+	mi := &file_article_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactionCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactionCount) ProtoMessage() {}
+
+func (x *ReactionCount) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactionCount.ProtoReflect.Descriptor instead.
+func (*ReactionCount) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReactionCount) GetType() ReactionType {
+	if x != nil {
+		return x.Type
+	}
+	return ReactionType_REACTION_TYPE_UNSPECIFIED
+}
+
+func (x *ReactionCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ArticleWithUser struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Article       *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	User          *User                  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArticleWithUser) Reset() {
+	*x = ArticleWithUser{}
+	mi := &file_article_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArticleWithUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArticleWithUser) ProtoMessage() {}
+
+func (x *ArticleWithUser) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArticleWithUser.ProtoReflect.Descriptor instead.
+func (*ArticleWithUser) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ArticleWithUser) GetArticle() *Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+func (x *ArticleWithUser) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type CreateArticleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	UserId        int32                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ContentFormat ContentFormat          `protobuf:"varint,4,opt,name=content_format,json=contentFormat,proto3,enum=article.ContentFormat" json:"content_format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateArticleRequest) Reset() {
+	*x = CreateArticleRequest{}
+	mi := &file_article_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateArticleRequest) ProtoMessage() {}
+
+func (x *CreateArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateArticleRequest.ProtoReflect.Descriptor instead.
+func (*CreateArticleRequest) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateArticleRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateArticleRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreateArticleRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateArticleRequest) GetContentFormat() ContentFormat {
+	if x != nil {
+		return x.ContentFormat
+	}
+	return ContentFormat_CONTENT_FORMAT_PLAIN
+}
+
+type GetArticleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	View  ArticleView            `protobuf:"varint,2,opt,name=view,proto3,enum=article.ArticleView" json:"view,omitempty"`
+	// Article fields to return (e.g. "title,excerpt"); overrides view. id and user_id are always returned
+	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetArticleRequest) Reset() {
+	*x = GetArticleRequest{}
+	mi := &file_article_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArticleRequest) ProtoMessage() {}
+
+func (x *GetArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArticleRequest.ProtoReflect.Descriptor instead.
+func (*GetArticleRequest) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetArticleRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetArticleRequest) GetView() ArticleView {
+	if x != nil {
+		return x.View
+	}
+	return ArticleView_ARTICLE_VIEW_UNSPECIFIED
+}
+
+func (x *GetArticleRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+type UpdateArticleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	ContentFormat *ContentFormat         `protobuf:"varint,4,opt,name=content_format,json=contentFormat,proto3,enum=article.ContentFormat,oneof" json:"content_format,omitempty"` // Unchanged when omitted
+	// Fields to update: title, content, content_format. Listed fields are set exactly as sent,
+	// so content can be cleared. Without a mask, empty fields are left unchanged
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateArticleRequest) Reset() {
+	*x = UpdateArticleRequest{}
+	mi := &file_article_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateArticleRequest) ProtoMessage() {}
+
+func (x *UpdateArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateArticleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateArticleRequest) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateArticleRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateArticleRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateArticleRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *UpdateArticleRequest) GetContentFormat() ContentFormat {
+	if x != nil && x.ContentFormat != nil {
+		return *x.ContentFormat
+	}
+	return ContentFormat_CONTENT_FORMAT_PLAIN
+}
+
+func (x *UpdateArticleRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteArticleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteArticleRequest) Reset() {
+	*x = DeleteArticleRequest{}
+	mi := &file_article_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteArticleRequest) ProtoMessage() {}
+
+func (x *DeleteArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteArticleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteArticleRequest) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteArticleRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListArticlesRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	PageSize   int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageNumber int32                  `protobuf:"varint,2,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	UserId     int32                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` //Filter by user
+	View       ArticleView            `protobuf:"varint,4,opt,name=view,proto3,enum=article.ArticleView" json:"view,omitempty"`
+	// Article fields to return; overrides view. id and user_id are always returned
+	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	OrderBy       ArticleOrder           `protobuf:"varint,6,opt,name=order_by,json=orderBy,proto3,enum=article.ArticleOrder" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListArticlesRequest) Reset() {
+	*x = ListArticlesRequest{}
+	mi := &file_article_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListArticlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArticlesRequest) ProtoMessage() {}
+
+func (x *ListArticlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArticlesRequest.ProtoReflect.Descriptor instead.
+func (*ListArticlesRequest) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListArticlesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListArticlesRequest) GetPageNumber() int32 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+func (x *ListArticlesRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListArticlesRequest) GetView() ArticleView {
+	if x != nil {
+		return x.View
+	}
+	return ArticleView_ARTICLE_VIEW_UNSPECIFIED
+}
+
+func (x *ListArticlesRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+func (x *ListArticlesRequest) GetOrderBy() ArticleOrder {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ArticleOrder_ARTICLE_ORDER_UNSPECIFIED
+}
+
+// ExportArticlesRequest filters an export; unset fields do not restrict it
+type ExportArticlesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                      // Filter by author
+	CreatedAfter  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`     // Inclusive
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`  // Exclusive
+	IncludeAuthor bool                   `protobuf:"varint,4,opt,name=include_author,json=includeAuthor,proto3" json:"include_author,omitempty"` // Resolve authors through User Service
+	View          ArticleView            `protobuf:"varint,5,opt,name=view,proto3,enum=article.ArticleView" json:"view,omitempty"`               // Default FULL
+	// Article fields to return; overrides view. id and user_id are always returned
+	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,6,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportArticlesRequest) Reset() {
+	*x = ExportArticlesRequest{}
+	mi := &file_article_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportArticlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportArticlesRequest) ProtoMessage() {}
+
+func (x *ExportArticlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportArticlesRequest.ProtoReflect.Descriptor instead.
+func (*ExportArticlesRequest) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ExportArticlesRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ExportArticlesRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *ExportArticlesRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *ExportArticlesRequest) GetIncludeAuthor() bool {
+	if x != nil {
+		return x.IncludeAuthor
+	}
+	return false
+}
+
+func (x *ExportArticlesRequest) GetView() ArticleView {
+	if x != nil {
+		return x.View
+	}
+	return ArticleView_ARTICLE_VIEW_UNSPECIFIED
+}
+
+func (x *ExportArticlesRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+// ImportedArticle is one article of a bulk import
+type ImportedArticle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	ContentFormat ContentFormat          `protobuf:"varint,3,opt,name=content_format,json=contentFormat,proto3,enum=article.ContentFormat" json:"content_format,omitempty"`
+	UserId        int32                  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`            // Author; admins only, defaults to the caller
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"` // Admins only, defaults to the import time
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"` // Admins only, defaults to create_time
+	Source        string                 `protobuf:"bytes,7,opt,name=source,proto3" json:"source,omitempty"`                           // Where the row came from (e.g. "posts.csv:12"), echoed in errors
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportedArticle) Reset() {
+	*x = ImportedArticle{}
+	mi := &file_article_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportedArticle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportedArticle) ProtoMessage() {}
+
+func (x *ImportedArticle) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportedArticle.ProtoReflect.Descriptor instead.
+func (*ImportedArticle) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ImportedArticle) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ImportedArticle) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ImportedArticle) GetContentFormat() ContentFormat {
+	if x != nil {
+		return x.ContentFormat
+	}
+	return ContentFormat_CONTENT_FORMAT_PLAIN
+}
+
+func (x *ImportedArticle) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ImportedArticle) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *ImportedArticle) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *ImportedArticle) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type ImportArticlesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DryRun        bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // Validate without inserting; read from the first message only
+	Article       *ImportedArticle       `protobuf:"bytes,2,opt,name=article,proto3" json:"article,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportArticlesRequest) Reset() {
+	*x = ImportArticlesRequest{}
+	mi := &file_article_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportArticlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportArticlesRequest) ProtoMessage() {}
+
+func (x *ImportArticlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportArticlesRequest.ProtoReflect.Descriptor instead.
+func (*ImportArticlesRequest) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ImportArticlesRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportArticlesRequest) GetArticle() *ImportedArticle {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+// ImportError reports one rejected row
+type ImportError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"` // 1-based position in the stream
+	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportError) Reset() {
+	*x = ImportError{}
+	mi := &file_article_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportError) ProtoMessage() {}
+
+func (x *ImportError) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportError.ProtoReflect.Descriptor instead.
+func (*ImportError) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ImportError) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportError) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ImportError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ImportArticlesResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Received        int32                  `protobuf:"varint,1,opt,name=received,proto3" json:"received,omitempty"`
+	Imported        int32                  `protobuf:"varint,2,opt,name=imported,proto3" json:"imported,omitempty"` // Rows inserted, or for a dry run rows that would be
+	Failed          int32                  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	DryRun          bool                   `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Errors          []*ImportError         `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"` // In row order, at most 1000
+	ErrorsTruncated bool                   `protobuf:"varint,6,opt,name=errors_truncated,json=errorsTruncated,proto3" json:"errors_truncated,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ImportArticlesResponse) Reset() {
+	*x = ImportArticlesResponse{}
+	mi := &file_article_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportArticlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportArticlesResponse) ProtoMessage() {}
+
+func (x *ImportArticlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportArticlesResponse.ProtoReflect.Descriptor instead.
+func (*ImportArticlesResponse) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ImportArticlesResponse) GetReceived() int32 {
+	if x != nil {
+		return x.Received
+	}
+	return 0
+}
+
+func (x *ImportArticlesResponse) GetImported() int32 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *ImportArticlesResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImportArticlesResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportArticlesResponse) GetErrors() []*ImportError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportArticlesResponse) GetErrorsTruncated() bool {
+	if x != nil {
+		return x.ErrorsTruncated
+	}
+	return false
+}
+
+// ArticleEvent is published for every committed article change (transactional outbox).
+// Delivery is at-least-once: consumers should deduplicate on id.
+type ArticleEvent struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // Outbox sequence; events of one article are published in increasing order
+	ArticleId int32                  `protobuf:"varint,2,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	Time      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"` // When the change was written
+	// Types that are valid to be assigned to Event:
+	//
+	//	*ArticleEvent_Created
+	//	*ArticleEvent_Updated
+	//	*ArticleEvent_Deleted
+	Event         isArticleEvent_Event `protobuf_oneof:"event"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArticleEvent) Reset() {
+	*x = ArticleEvent{}
+	mi := &file_article_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArticleEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArticleEvent) ProtoMessage() {}
+
+func (x *ArticleEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArticleEvent.ProtoReflect.Descriptor instead.
+func (*ArticleEvent) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ArticleEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ArticleEvent) GetArticleId() int32 {
+	if x != nil {
+		return x.ArticleId
+	}
+	return 0
+}
+
+func (x *ArticleEvent) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *ArticleEvent) GetEvent() isArticleEvent_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *ArticleEvent) GetCreated() *ArticleCreated {
+	if x != nil {
+		if x, ok := x.Event.(*ArticleEvent_Created); ok {
+			return x.Created
+		}
+	}
+	return nil
+}
+
+func (x *ArticleEvent) GetUpdated() *ArticleUpdated {
+	if x != nil {
+		if x, ok := x.Event.(*ArticleEvent_Updated); ok {
+			return x.Updated
+		}
+	}
+	return nil
+}
+
+func (x *ArticleEvent) GetDeleted() *ArticleDeleted {
+	if x != nil {
+		if x, ok := x.Event.(*ArticleEvent_Deleted); ok {
+			return x.Deleted
+		}
+	}
+	return nil
+}
+
+type isArticleEvent_Event interface {
+	isArticleEvent_Event()
+}
+
+type ArticleEvent_Created struct {
+	Created *ArticleCreated `protobuf:"bytes,4,opt,name=created,proto3,oneof"`
+}
+
+type ArticleEvent_Updated struct {
+	Updated *ArticleUpdated `protobuf:"bytes,5,opt,name=updated,proto3,oneof"`
+}
+
+type ArticleEvent_Deleted struct {
+	Deleted *ArticleDeleted `protobuf:"bytes,6,opt,name=deleted,proto3,oneof"`
+}
+
+func (*ArticleEvent_Created) isArticleEvent_Event() {}
+
+func (*ArticleEvent_Updated) isArticleEvent_Event() {}
+
+func (*ArticleEvent_Deleted) isArticleEvent_Event() {}
+
+type ArticleCreated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Article       *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArticleCreated) Reset() {
+	*x = ArticleCreated{}
+	mi := &file_article_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArticleCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArticleCreated) ProtoMessage() {}
+
+func (x *ArticleCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArticleCreated.ProtoReflect.Descriptor instead.
+func (*ArticleCreated) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ArticleCreated) GetArticle() *Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+type ArticleUpdated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Article       *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArticleUpdated) Reset() {
+	*x = ArticleUpdated{}
+	mi := &file_article_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArticleUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArticleUpdated) ProtoMessage() {}
+
+func (x *ArticleUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArticleUpdated.ProtoReflect.Descriptor instead.
+func (*ArticleUpdated) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ArticleUpdated) GetArticle() *Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+type ArticleDeleted struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Article       *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"` // The article as it was before deletion
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArticleDeleted) Reset() {
+	*x = ArticleDeleted{}
+	mi := &file_article_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArticleDeleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArticleDeleted) ProtoMessage() {}
+
+func (x *ArticleDeleted) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArticleDeleted.ProtoReflect.Descriptor instead.
+func (*ArticleDeleted) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ArticleDeleted) GetArticle() *Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+// WatchArticlesRequest filters a change feed; unset fields do not restrict it
+type WatchArticlesRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	UserId     int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`            // Only changes to articles of this author
+	EventTypes []string               `protobuf:"bytes,2,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"` // ArticleCreated, ArticleUpdated and/or ArticleDeleted
+	// From a previous response: changes since then are replayed before live ones
+	ResumeToken       string               `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	HeartbeatInterval *durationpb.Duration `protobuf:"bytes,4,opt,name=heartbeat_interval,json=heartbeatInterval,proto3" json:"heartbeat_interval,omitempty"` // 1s to 5m, default 30s
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *WatchArticlesRequest) Reset() {
+	*x = WatchArticlesRequest{}
+	mi := &file_article_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchArticlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchArticlesRequest) ProtoMessage() {}
+
+func (x *WatchArticlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchArticlesRequest.ProtoReflect.Descriptor instead.
+func (*WatchArticlesRequest) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *WatchArticlesRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *WatchArticlesRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *WatchArticlesRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *WatchArticlesRequest) GetHeartbeatInterval() *durationpb.Duration {
+	if x != nil {
+		return x.HeartbeatInterval
+	}
+	return nil
+}
+
+type WatchArticlesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Pass to WatchArticlesRequest to continue after this response
+	ResumeToken string `protobuf:"bytes,1,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	// Types that are valid to be assigned to Item:
+	//
+	//	*WatchArticlesResponse_Event
+	//	*WatchArticlesResponse_Heartbeat
+	Item          isWatchArticlesResponse_Item `protobuf_oneof:"item"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchArticlesResponse) Reset() {
+	*x = WatchArticlesResponse{}
+	mi := &file_article_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchArticlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchArticlesResponse) ProtoMessage() {}
+
+func (x *WatchArticlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchArticlesResponse.ProtoReflect.Descriptor instead.
+func (*WatchArticlesResponse) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *WatchArticlesResponse) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *WatchArticlesResponse) GetItem() isWatchArticlesResponse_Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *WatchArticlesResponse) GetEvent() *ArticleEvent {
+	if x != nil {
+		if x, ok := x.Item.(*WatchArticlesResponse_Event); ok {
+			return x.Event
+		}
+	}
+	return nil
+}
+
+func (x *WatchArticlesResponse) GetHeartbeat() *Heartbeat {
+	if x != nil {
+		if x, ok := x.Item.(*WatchArticlesResponse_Heartbeat); ok {
+			return x.Heartbeat
+		}
+	}
+	return nil
+}
+
+type isWatchArticlesResponse_Item interface {
+	isWatchArticlesResponse_Item()
+}
+
+type WatchArticlesResponse_Event struct {
+	Event *ArticleEvent `protobuf:"bytes,2,opt,name=event,proto3,oneof"`
+}
+
+type WatchArticlesResponse_Heartbeat struct {
+	Heartbeat *Heartbeat `protobuf:"bytes,3,opt,name=heartbeat,proto3,oneof"`
+}
+
+func (*WatchArticlesResponse_Event) isWatchArticlesResponse_Item() {}
+
+func (*WatchArticlesResponse_Heartbeat) isWatchArticlesResponse_Item() {}
+
+// Heartbeat is sent when no event was sent for heartbeat_interval
+type Heartbeat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Heartbeat) Reset() {
+	*x = Heartbeat{}
+	mi := &file_article_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Heartbeat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Heartbeat) ProtoMessage() {}
+
+func (x *Heartbeat) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Heartbeat.ProtoReflect.Descriptor instead.
+func (*Heartbeat) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Heartbeat) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+// Webhook delivers article events to a partner URL as signed JSON POSTs
+type Webhook struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Owner
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	EventTypes    []string               `protobuf:"bytes,4,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"` // ArticleCreated, ArticleUpdated, ArticleDeleted; empty for all
+	AuthorId      int32                  `protobuf:"varint,5,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`      // Only articles of this author; 0 for all
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"` // The secret is write-only and never returned
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	mi := &file_article_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Webhook) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Webhook) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *Webhook) GetAuthorId() int32 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *Webhook) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type CreateWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`       // http(s) URL
+	Secret        string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"` // HMAC-SHA256 key, at least 16 characters
+	EventTypes    []string               `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	AuthorId      int32                  `protobuf:"varint,4,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookRequest) Reset() {
+	*x = CreateWebhookRequest{}
+	mi := &file_article_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookRequest) ProtoMessage() {}
+
+func (x *CreateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *CreateWebhookRequest) GetAuthorId() int32 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+type ListWebhooksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksRequest) Reset() {
+	*x = ListWebhooksRequest{}
+	mi := &file_article_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksRequest) ProtoMessage() {}
+
+func (x *ListWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{23}
+}
+
+type DeleteWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookRequest) Reset() {
+	*x = DeleteWebhookRequest{}
+	mi := &file_article_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeleteWebhookRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// WebhookAttempt is one HTTP request of a delivery
+type WebhookAttempt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	StatusCode    int32                  `protobuf:"varint,2,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"` // 0 when no response was received
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	DurationMs    int32                  `protobuf:"varint,4,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookAttempt) Reset() {
+	*x = WebhookAttempt{}
+	mi := &file_article_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookAttempt) ProtoMessage() {}
+
+func (x *WebhookAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookAttempt.ProtoReflect.Descriptor instead.
+func (*WebhookAttempt) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *WebhookAttempt) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *WebhookAttempt) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *WebhookAttempt) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *WebhookAttempt) GetDurationMs() int32 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+// WebhookDelivery is one event sent, or to be sent, to one webhook
+type WebhookDelivery struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	WebhookId       int32                  `protobuf:"varint,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	EventId         int64                  `protobuf:"varint,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"` // ArticleEvent.id
+	EventType       string                 `protobuf:"bytes,4,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	ArticleId       int32                  `protobuf:"varint,5,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	Status          WebhookDeliveryStatus  `protobuf:"varint,6,opt,name=status,proto3,enum=article.WebhookDeliveryStatus" json:"status,omitempty"`
+	Attempts        int32                  `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	NextAttemptTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=next_attempt_time,json=nextAttemptTime,proto3" json:"next_attempt_time,omitempty"` // Set while PENDING
+	LastError       string                 `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	CreateTime      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	DeliveredTime   *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=delivered_time,json=deliveredTime,proto3" json:"delivered_time,omitempty"`
+	AttemptLog      []*WebhookAttempt      `protobuf:"bytes,12,rep,name=attempt_log,json=attemptLog,proto3" json:"attempt_log,omitempty"` // Oldest first
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_article_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *WebhookDelivery) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetWebhookId() int32 {
+	if x != nil {
+		return x.WebhookId
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetEventId() int64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetArticleId() int32 {
+	if x != nil {
+		return x.ArticleId
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetStatus() WebhookDeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED
+}
+
+func (x *WebhookDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetNextAttemptTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptTime
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetDeliveredTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliveredTime
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetAttemptLog() []*WebhookAttempt {
+	if x != nil {
+		return x.AttemptLog
+	}
+	return nil
+}
+
+type ListWebhookDeliveriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId     int32                  `protobuf:"varint,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`             // Required
+	Status        WebhookDeliveryStatus  `protobuf:"varint,2,opt,name=status,proto3,enum=article.WebhookDeliveryStatus" json:"status,omitempty"` // Filter; UNSPECIFIED for all
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                // Default 10, max 100
+	PageNumber    int32                  `protobuf:"varint,4,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`          // Default 1
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	mi := &file_article_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListWebhookDeliveriesRequest) GetWebhookId() int32 {
+	if x != nil {
+		return x.WebhookId
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesRequest) GetStatus() WebhookDeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED
+}
+
+func (x *ListWebhookDeliveriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesRequest) GetPageNumber() int32 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+// RetryWebhookDeliveryRequest schedules a DEAD delivery for another round of attempts
+type RetryWebhookDeliveryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryWebhookDeliveryRequest) Reset() {
+	*x = RetryWebhookDeliveryRequest{}
+	mi := &file_article_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryWebhookDeliveryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryWebhookDeliveryRequest) ProtoMessage() {}
+
+func (x *RetryWebhookDeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryWebhookDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*RetryWebhookDeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RetryWebhookDeliveryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Comment is a reader's comment on an article; replies point at their parent
+type Comment struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ArticleId  int32                  `protobuf:"varint,2,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	ParentId   int32                  `protobuf:"varint,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // 0 for top-level comments
+	UserId     int32                  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // Author of the comment
+	Content    string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`                    // Empty for hidden comments unless the caller may moderate or wrote them
+	Hidden     bool                   `protobuf:"varint,6,opt,name=hidden,proto3" json:"hidden,omitempty"`                     // Hidden by the article author; replies stay visible
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	User       *User                  `protobuf:"bytes,9,opt,name=user,proto3" json:"user,omitempty"`        // Author from User Service; unset when unavailable
+	Replies    []*Comment             `protobuf:"bytes,10,rep,name=replies,proto3" json:"replies,omitempty"` // Oldest first. Set by ListComments only, at most reply_page_size per thread
+	Depth      int32                  `protobuf:"varint,11,opt,name=depth,proto3" json:"depth,omitempty"`    // 0 for top-level comments, parent depth + 1 for replies
+	// Replies in the thread of a top-level comment, at any depth. Set by ListComments only
+	ReplyCount int32 `protobuf:"varint,12,opt,name=reply_count,json=replyCount,proto3" json:"reply_count,omitempty"`
+	// Set by ListComments when the thread has more replies than it returned; pass it to ListReplies
+	RepliesPageToken string `protobuf:"bytes,13,opt,name=replies_page_token,json=repliesPageToken,proto3" json:"replies_page_token,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	mi := &file_article_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Comment) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Comment) GetArticleId() int32 {
+	if x != nil {
+		return x.ArticleId
+	}
+	return 0
+}
+
+func (x *Comment) GetParentId() int32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *Comment) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Comment) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Comment) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *Comment) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Comment) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *Comment) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *Comment) GetReplies() []*Comment {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+func (x *Comment) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *Comment) GetReplyCount() int32 {
+	if x != nil {
+		return x.ReplyCount
+	}
+	return 0
+}
+
+func (x *Comment) GetRepliesPageToken() string {
+	if x != nil {
+		return x.RepliesPageToken
+	}
+	return ""
+}
+
+type CreateCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArticleId     int32                  `protobuf:"varint,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	ParentId      int32                  `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // Comment to reply to; 0 for a top-level comment
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCommentRequest) Reset() {
+	*x = CreateCommentRequest{}
+	mi := &file_article_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommentRequest) ProtoMessage() {}
+
+func (x *CreateCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommentRequest.ProtoReflect.Descriptor instead.
+func (*CreateCommentRequest) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CreateCommentRequest) GetArticleId() int32 {
+	if x != nil {
+		return x.ArticleId
+	}
+	return 0
+}
+
+func (x *CreateCommentRequest) GetParentId() int32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *CreateCommentRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// ListCommentsRequest pages through the top-level comments of an article, each with the first
+// replies of its thread
+type ListCommentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArticleId     int32                  `protobuf:"varint,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                  // Default 10, max 100
+	PageNumber    int32                  `protobuf:"varint,3,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`            // Default 1
+	ReplyPageSize int32                  `protobuf:"varint,4,opt,name=reply_page_size,json=replyPageSize,proto3" json:"reply_page_size,omitempty"` // Replies per top-level comment, at any depth. Default 3, max 20
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsRequest) Reset() {
+	*x = ListCommentsRequest{}
+	mi := &file_article_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsRequest) ProtoMessage() {}
+
+func (x *ListCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListCommentsRequest) GetArticleId() int32 {
+	if x != nil {
+		return x.ArticleId
+	}
+	return 0
+}
+
+func (x *ListCommentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCommentsRequest) GetPageNumber() int32 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+func (x *ListCommentsRequest) GetReplyPageSize() int32 {
+	if x != nil {
+		return x.ReplyPageSize
+	}
+	return 0
+}
+
+// ListRepliesRequest pages through the replies in the thread of a top-level comment, oldest first
+type ListRepliesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommentId     int32                  `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"` // A top-level comment
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`    // Default 10, max 100
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`  // replies_page_token from ListComments or next_page_token of the previous page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRepliesRequest) Reset() {
+	*x = ListRepliesRequest{}
+	mi := &file_article_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRepliesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRepliesRequest) ProtoMessage() {}
+
+func (x *ListRepliesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRepliesRequest.ProtoReflect.Descriptor instead.
+func (*ListRepliesRequest) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListRepliesRequest) GetCommentId() int32 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *ListRepliesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRepliesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type UpdateCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCommentRequest) Reset() {
+	*x = UpdateCommentRequest{}
+	mi := &file_article_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCommentRequest) ProtoMessage() {}
+
+func (x *UpdateCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCommentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCommentRequest) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UpdateCommentRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateCommentRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type DeleteCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentRequest) Reset() {
+	*x = DeleteCommentRequest{}
+	mi := &file_article_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentRequest) ProtoMessage() {}
+
+func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeleteCommentRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// ModerateCommentRequest hides or shows a comment (article author or admins only)
+type ModerateCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Hidden        bool                   `protobuf:"varint,2,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateCommentRequest) Reset() {
+	*x = ModerateCommentRequest{}
+	mi := &file_article_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateCommentRequest) ProtoMessage() {}
+
+func (x *ModerateCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateCommentRequest.ProtoReflect.Descriptor instead.
+func (*ModerateCommentRequest) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ModerateCommentRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ModerateCommentRequest) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+// Reaction is one user's reaction of one type to an article
+type Reaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArticleId     int32                  `protobuf:"varint,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Type          ReactionType           `protobuf:"varint,3,opt,name=type,proto3,enum=article.ReactionType" json:"type,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	User          *User                  `protobuf:"bytes,5,opt,name=user,proto3" json:"user,omitempty"` // From User Service; unset when unavailable
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reaction) Reset() {
+	*x = Reaction{}
+	mi := &file_article_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reaction) ProtoMessage() {}
+
+func (x *Reaction) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reaction.ProtoReflect.Descriptor instead.
+func (*Reaction) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Reaction) GetArticleId() int32 {
+	if x != nil {
+		return x.ArticleId
+	}
+	return 0
+}
+
+func (x *Reaction) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Reaction) GetType() ReactionType {
+	if x != nil {
+		return x.Type
+	}
+	return ReactionType_REACTION_TYPE_UNSPECIFIED
+}
+
+func (x *Reaction) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Reaction) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// ReactionSummary is the reaction counts of an article and the caller's own reactions
+type ReactionSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArticleId     int32                  `protobuf:"varint,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`                                // Article.reaction_count
+	Counts        []*ReactionCount       `protobuf:"bytes,3,rep,name=counts,proto3" json:"counts,omitempty"`                               // Article.reaction_counts
+	Mine          []ReactionType         `protobuf:"varint,4,rep,packed,name=mine,proto3,enum=article.ReactionType" json:"mine,omitempty"` // The caller's reactions; empty for anonymous callers
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactionSummary) Reset() {
+	*x = ReactionSummary{}
+	mi := &file_article_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactionSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactionSummary) ProtoMessage() {}
+
+func (x *ReactionSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactionSummary.ProtoReflect.Descriptor instead.
+func (*ReactionSummary) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ReactionSummary) GetArticleId() int32 {
+	if x != nil {
+		return x.ArticleId
+	}
+	return 0
+}
+
+func (x *ReactionSummary) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ReactionSummary) GetCounts() []*ReactionCount {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *ReactionSummary) GetMine() []ReactionType {
+	if x != nil {
+		return x.Mine
+	}
+	return nil
+}
+
+// AddReactionRequest reacts to an article; a user can add each type once
+type AddReactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArticleId     int32                  `protobuf:"varint,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	Type          ReactionType           `protobuf:"varint,2,opt,name=type,proto3,enum=article.ReactionType" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddReactionRequest) Reset() {
+	*x = AddReactionRequest{}
+	mi := &file_article_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddReactionRequest) ProtoMessage() {}
+
+func (x *AddReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddReactionRequest.ProtoReflect.Descriptor instead.
+func (*AddReactionRequest) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *AddReactionRequest) GetArticleId() int32 {
+	if x != nil {
+		return x.ArticleId
+	}
+	return 0
+}
+
+func (x *AddReactionRequest) GetType() ReactionType {
+	if x != nil {
+		return x.Type
+	}
+	return ReactionType_REACTION_TYPE_UNSPECIFIED
+}
+
+type RemoveReactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArticleId     int32                  `protobuf:"varint,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	Type          ReactionType           `protobuf:"varint,2,opt,name=type,proto3,enum=article.ReactionType" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveReactionRequest) Reset() {
+	*x = RemoveReactionRequest{}
+	mi := &file_article_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveReactionRequest) ProtoMessage() {}
+
+func (x *RemoveReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveReactionRequest.ProtoReflect.Descriptor instead.
+func (*RemoveReactionRequest) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RemoveReactionRequest) GetArticleId() int32 {
+	if x != nil {
+		return x.ArticleId
+	}
+	return 0
+}
+
+func (x *RemoveReactionRequest) GetType() ReactionType {
+	if x != nil {
+		return x.Type
+	}
+	return ReactionType_REACTION_TYPE_UNSPECIFIED
+}
+
+// ListReactionsRequest pages through the reactions to an article, newest first
+type ListReactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArticleId     int32                  `protobuf:"varint,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	Type          ReactionType           `protobuf:"varint,2,opt,name=type,proto3,enum=article.ReactionType" json:"type,omitempty"`     // Filter; UNSPECIFIED for all
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`       // Default 10, max 100
+	PageNumber    int32                  `protobuf:"varint,4,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"` // Default 1
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReactionsRequest) Reset() {
+	*x = ListReactionsRequest{}
+	mi := &file_article_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReactionsRequest) ProtoMessage() {}
+
+func (x *ListReactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListReactionsRequest) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListReactionsRequest) GetArticleId() int32 {
+	if x != nil {
+		return x.ArticleId
+	}
+	return 0
+}
+
+func (x *ListReactionsRequest) GetType() ReactionType {
+	if x != nil {
+		return x.Type
+	}
+	return ReactionType_REACTION_TYPE_UNSPECIFIED
+}
+
+func (x *ListReactionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListReactionsRequest) GetPageNumber() int32 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+// Collection is a named reading list of a user
+type Collection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Position      int32                  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`                      // Order among the user's collections, from 0
+	ShareToken    string                 `protobuf:"bytes,5,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"` // Set while shared; anyone with it can read the collection. Empty in shared views
+	BookmarkCount int32                  `protobuf:"varint,6,opt,name=bookmark_count,json=bookmarkCount,proto3" json:"bookmark_count,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Collection) Reset() {
+	*x = Collection{}
+	mi := &file_article_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Collection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Collection) ProtoMessage() {}
+
+func (x *Collection) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Collection.ProtoReflect.Descriptor instead.
+func (*Collection) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *Collection) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Collection) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Collection) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Collection) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *Collection) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+func (x *Collection) GetBookmarkCount() int32 {
+	if x != nil {
+		return x.BookmarkCount
+	}
+	return 0
+}
+
+func (x *Collection) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Collection) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+// Bookmark is an article saved by a user to the default reading list or to a collection
+type Bookmark struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId       int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ArticleId    int32                  `protobuf:"varint,3,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	CollectionId int32                  `protobuf:"varint,4,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"` // 0 for the default reading list
+	CreateTime   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// BASIC view of the article with its author; unset when the article is no longer available
+	Article       *ArticleWithUser `protobuf:"bytes,6,opt,name=article,proto3" json:"article,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Bookmark) Reset() {
+	*x = Bookmark{}
+	mi := &file_article_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Bookmark) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bookmark) ProtoMessage() {}
+
+func (x *Bookmark) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bookmark.ProtoReflect.Descriptor instead.
+func (*Bookmark) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Bookmark) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Bookmark) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Bookmark) GetArticleId() int32 {
+	if x != nil {
+		return x.ArticleId
+	}
+	return 0
+}
+
+func (x *Bookmark) GetCollectionId() int32 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+func (x *Bookmark) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Bookmark) GetArticle() *ArticleWithUser {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+// BookmarkArticleRequest saves an article for the caller; an article is saved once per list
+type BookmarkArticleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArticleId     int32                  `protobuf:"varint,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	CollectionId  int32                  `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"` // 0 for the default reading list
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookmarkArticleRequest) Reset() {
+	*x = BookmarkArticleRequest{}
+	mi := &file_article_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookmarkArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookmarkArticleRequest) ProtoMessage() {}
+
+func (x *BookmarkArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookmarkArticleRequest.ProtoReflect.Descriptor instead.
+func (*BookmarkArticleRequest) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *BookmarkArticleRequest) GetArticleId() int32 {
+	if x != nil {
+		return x.ArticleId
+	}
+	return 0
+}
+
+func (x *BookmarkArticleRequest) GetCollectionId() int32 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+type UnbookmarkArticleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArticleId     int32                  `protobuf:"varint,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	CollectionId  int32                  `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"` // 0 for the default reading list
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnbookmarkArticleRequest) Reset() {
+	*x = UnbookmarkArticleRequest{}
+	mi := &file_article_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnbookmarkArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbookmarkArticleRequest) ProtoMessage() {}
+
+func (x *UnbookmarkArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbookmarkArticleRequest.ProtoReflect.Descriptor instead.
+func (*UnbookmarkArticleRequest) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UnbookmarkArticleRequest) GetArticleId() int32 {
+	if x != nil {
+		return x.ArticleId
+	}
+	return 0
+}
+
+func (x *UnbookmarkArticleRequest) GetCollectionId() int32 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+// ListBookmarksRequest pages through one of the caller's lists, most recently saved first
+type ListBookmarksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CollectionId  int32                  `protobuf:"varint,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"` // 0 for the default reading list
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`             // Default 10, max 100
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`           // next_page_token of the previous page; empty for the first page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBookmarksRequest) Reset() {
+	*x = ListBookmarksRequest{}
+	mi := &file_article_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBookmarksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBookmarksRequest) ProtoMessage() {}
+
+func (x *ListBookmarksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBookmarksRequest.ProtoReflect.Descriptor instead.
+func (*ListBookmarksRequest) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListBookmarksRequest) GetCollectionId() int32 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+func (x *ListBookmarksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListBookmarksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type CreateCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCollectionRequest) Reset() {
+	*x = CreateCollectionRequest{}
+	mi := &file_article_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCollectionRequest) ProtoMessage() {}
+
+func (x *CreateCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCollectionRequest.ProtoReflect.Descriptor instead.
+func (*CreateCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CreateCollectionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListCollectionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionsRequest) Reset() {
+	*x = ListCollectionsRequest{}
+	mi := &file_article_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionsRequest) ProtoMessage() {}
+
+func (x *ListCollectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectionsRequest) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{47}
+}
+
+type RenameCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameCollectionRequest) Reset() {
+	*x = RenameCollectionRequest{}
+	mi := &file_article_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameCollectionRequest) ProtoMessage() {}
+
+func (x *RenameCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameCollectionRequest.ProtoReflect.Descriptor instead.
+func (*RenameCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RenameCollectionRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RenameCollectionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// ReorderCollectionsRequest lists every collection of the caller in the new order
+type ReorderCollectionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []int32                `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderCollectionsRequest) Reset() {
+	*x = ReorderCollectionsRequest{}
+	mi := &file_article_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderCollectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderCollectionsRequest) ProtoMessage() {}
+
+func (x *ReorderCollectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*ReorderCollectionsRequest) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ReorderCollectionsRequest) GetIds() []int32 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// ShareCollectionRequest creates a share token for a collection, or revokes it
+type ShareCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Shared        bool                   `protobuf:"varint,2,opt,name=shared,proto3" json:"shared,omitempty"` // false revokes the token; sharing again creates a new one
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareCollectionRequest) Reset() {
+	*x = ShareCollectionRequest{}
+	mi := &file_article_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareCollectionRequest) ProtoMessage() {}
+
+func (x *ShareCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareCollectionRequest.ProtoReflect.Descriptor instead.
+func (*ShareCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ShareCollectionRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ShareCollectionRequest) GetShared() bool {
+	if x != nil {
+		return x.Shared
+	}
+	return false
+}
+
+// DeleteCollectionRequest deletes a collection and its bookmarks
+type DeleteCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCollectionRequest) Reset() {
+	*x = DeleteCollectionRequest{}
+	mi := &file_article_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCollectionRequest) ProtoMessage() {}
+
+func (x *DeleteCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCollectionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DeleteCollectionRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// GetSharedCollectionRequest reads a shared collection; no authentication is needed
+type GetSharedCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShareToken    string                 `protobuf:"bytes,1,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // Default 10, max 100
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSharedCollectionRequest) Reset() {
+	*x = GetSharedCollectionRequest{}
+	mi := &file_article_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSharedCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharedCollectionRequest) ProtoMessage() {}
+
+func (x *GetSharedCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSharedCollectionRequest.ProtoReflect.Descriptor instead.
+func (*GetSharedCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetSharedCollectionRequest) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+func (x *GetSharedCollectionRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetSharedCollectionRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListTrendingArticlesRequest ranks articles by recent views; no authentication is needed
+type ListTrendingArticlesRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Window TrendingWindow         `protobuf:"varint,1,opt,name=window,proto3,enum=article.TrendingWindow" json:"window,omitempty"`
+	Limit  int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                        // Default 10, max 100
+	View   ArticleView            `protobuf:"varint,3,opt,name=view,proto3,enum=article.ArticleView" json:"view,omitempty"` // Default BASIC
+	// Article fields to return; overrides view. id and user_id are always returned
+	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrendingArticlesRequest) Reset() {
+	*x = ListTrendingArticlesRequest{}
+	mi := &file_article_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrendingArticlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrendingArticlesRequest) ProtoMessage() {}
+
+func (x *ListTrendingArticlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrendingArticlesRequest.ProtoReflect.Descriptor instead.
+func (*ListTrendingArticlesRequest) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListTrendingArticlesRequest) GetWindow() TrendingWindow {
+	if x != nil {
+		return x.Window
+	}
+	return TrendingWindow_TRENDING_WINDOW_UNSPECIFIED
+}
+
+func (x *ListTrendingArticlesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListTrendingArticlesRequest) GetView() ArticleView {
+	if x != nil {
+		return x.View
+	}
+	return ArticleView_ARTICLE_VIEW_UNSPECIFIED
+}
+
+func (x *ListTrendingArticlesRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+// GetRelatedArticlesRequest asks for articles similar to article_id; no authentication is needed
+type GetRelatedArticlesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ArticleId int32                  `protobuf:"varint,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	Limit     int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                        // Default 5, max 20
+	View      ArticleView            `protobuf:"varint,3,opt,name=view,proto3,enum=article.ArticleView" json:"view,omitempty"` // Default BASIC
+	// Article fields to return; overrides view. id and user_id are always returned
+	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRelatedArticlesRequest) Reset() {
+	*x = GetRelatedArticlesRequest{}
+	mi := &file_article_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRelatedArticlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelatedArticlesRequest) ProtoMessage() {}
+
+func (x *GetRelatedArticlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelatedArticlesRequest.ProtoReflect.Descriptor instead.
+func (*GetRelatedArticlesRequest) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetRelatedArticlesRequest) GetArticleId() int32 {
+	if x != nil {
+		return x.ArticleId
+	}
+	return 0
+}
+
+func (x *GetRelatedArticlesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetRelatedArticlesRequest) GetView() ArticleView {
+	if x != nil {
+		return x.View
+	}
+	return ArticleView_ARTICLE_VIEW_UNSPECIFIED
+}
+
+func (x *GetRelatedArticlesRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+// Follow is an author followed by a user; the author's articles appear in the user's feed
+type Follow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // The follower
+	AuthorId      int32                  `protobuf:"varint,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"` // A User Service user
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Follow) Reset() {
+	*x = Follow{}
+	mi := &file_article_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Follow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Follow) ProtoMessage() {}
+
+func (x *Follow) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Follow.ProtoReflect.Descriptor instead.
+func (*Follow) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *Follow) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Follow) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Follow) GetAuthorId() int32 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *Follow) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+// FollowAuthorRequest follows author_id as the caller; the author must exist in User Service
+type FollowAuthorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthorId      int32                  `protobuf:"varint,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowAuthorRequest) Reset() {
+	*x = FollowAuthorRequest{}
+	mi := &file_article_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowAuthorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowAuthorRequest) ProtoMessage() {}
+
+func (x *FollowAuthorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowAuthorRequest.ProtoReflect.Descriptor instead.
+func (*FollowAuthorRequest) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *FollowAuthorRequest) GetAuthorId() int32 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+type UnfollowAuthorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthorId      int32                  `protobuf:"varint,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfollowAuthorRequest) Reset() {
+	*x = UnfollowAuthorRequest{}
+	mi := &file_article_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfollowAuthorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowAuthorRequest) ProtoMessage() {}
+
+func (x *UnfollowAuthorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowAuthorRequest.ProtoReflect.Descriptor instead.
+func (*UnfollowAuthorRequest) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *UnfollowAuthorRequest) GetAuthorId() int32 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+// ListFollowingRequest pages through the authors the caller follows, most recently followed first
+type ListFollowingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Default 10, max 100
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page; empty for the first page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFollowingRequest) Reset() {
+	*x = ListFollowingRequest{}
+	mi := &file_article_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFollowingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowingRequest) ProtoMessage() {}
+
+func (x *ListFollowingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowingRequest.ProtoReflect.Descriptor instead.
+func (*ListFollowingRequest) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListFollowingRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListFollowingRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// GetFeedRequest pages through the articles of the authors the caller follows, newest first
+type GetFeedRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PageSize  int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Default 10, max 100
+	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page; empty for the first page
+	View      ArticleView            `protobuf:"varint,3,opt,name=view,proto3,enum=article.ArticleView" json:"view,omitempty"`  // Default BASIC
+	// Article fields to return; overrides view. id and user_id are always returned
+	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFeedRequest) Reset() {
+	*x = GetFeedRequest{}
+	mi := &file_article_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFeedRequest) ProtoMessage() {}
+
+func (x *GetFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetFeedRequest) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetFeedRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetFeedRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetFeedRequest) GetView() ArticleView {
+	if x != nil {
+		return x.View
+	}
+	return ArticleView_ARTICLE_VIEW_UNSPECIFIED
+}
+
+func (x *GetFeedRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+// TrendingArticle is an article with its rank in a TrendingWindow
+type TrendingArticle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Article       *ArticleWithUser       `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`                               // Views in the window, each weighted by its age
+	WindowViews   int64                  `protobuf:"varint,3,opt,name=window_views,json=windowViews,proto3" json:"window_views,omitempty"` // Views in the window
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrendingArticle) Reset() {
+	*x = TrendingArticle{}
+	mi := &file_article_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrendingArticle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendingArticle) ProtoMessage() {}
+
+func (x *TrendingArticle) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendingArticle.ProtoReflect.Descriptor instead.
+func (*TrendingArticle) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *TrendingArticle) GetArticle() *ArticleWithUser {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+func (x *TrendingArticle) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *TrendingArticle) GetWindowViews() int64 {
+	if x != nil {
+		return x.WindowViews
+	}
+	return 0
+}
+
+type CreateArticleResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    *CreateArticleData     `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Structured error details (google.rpc.ErrorInfo, BadRequest, RetryInfo, PreconditionFailure)
+	Details       []*anypb.Any `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateArticleResponse) Reset() {
+	*x = CreateArticleResponse{}
+	mi := &file_article_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateArticleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateArticleResponse) ProtoMessage() {}
+
+func (x *CreateArticleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateArticleResponse.ProtoReflect.Descriptor instead.
+func (*CreateArticleResponse) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CreateArticleResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateArticleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateArticleResponse) GetData() *CreateArticleData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CreateArticleResponse) GetDetails() []*anypb.Any {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type CreateArticleData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Article       *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateArticleData) Reset() {
+	*x = CreateArticleData{}
+	mi := &file_article_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateArticleData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateArticleData) ProtoMessage() {}
+
+func (x *CreateArticleData) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateArticleData.ProtoReflect.Descriptor instead.
+func (*CreateArticleData) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CreateArticleData) GetArticle() *Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+type GetArticleResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    *GetArticleData        `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Structured error details (google.rpc.ErrorInfo, BadRequest, RetryInfo, PreconditionFailure)
+	Details       []*anypb.Any `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetArticleResponse) Reset() {
+	*x = GetArticleResponse{}
+	mi := &file_article_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArticleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArticleResponse) ProtoMessage() {}
+
+func (x *GetArticleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArticleResponse.ProtoReflect.Descriptor instead.
+func (*GetArticleResponse) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetArticleResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *GetArticleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetArticleResponse) GetData() *GetArticleData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetArticleResponse) GetDetails() []*anypb.Any {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type GetArticleData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Article       *ArticleWithUser       `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetArticleData) Reset() {
+	*x = GetArticleData{}
+	mi := &file_article_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArticleData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArticleData) ProtoMessage() {}
+
+func (x *GetArticleData) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArticleData.ProtoReflect.Descriptor instead.
+func (*GetArticleData) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetArticleData) GetArticle() *ArticleWithUser {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+type UpdateArticleResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    *UpdateArticleData     `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Structured error details (google.rpc.ErrorInfo, BadRequest, RetryInfo, PreconditionFailure)
+	Details       []*anypb.Any `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateArticleResponse) Reset() {
+	*x = UpdateArticleResponse{}
+	mi := &file_article_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateArticleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateArticleResponse) ProtoMessage() {}
+
+func (x *UpdateArticleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateArticleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateArticleResponse) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *UpdateArticleResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *UpdateArticleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateArticleResponse) GetData() *UpdateArticleData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UpdateArticleResponse) GetDetails() []*anypb.Any {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type UpdateArticleData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Article       *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateArticleData) Reset() {
+	*x = UpdateArticleData{}
+	mi := &file_article_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateArticleData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateArticleData) ProtoMessage() {}
+
+func (x *UpdateArticleData) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateArticleData.ProtoReflect.Descriptor instead.
+func (*UpdateArticleData) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *UpdateArticleData) GetArticle() *Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+type DeleteArticleResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    *DeleteArticleData     `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Structured error details (google.rpc.ErrorInfo, BadRequest, RetryInfo, PreconditionFailure)
+	Details       []*anypb.Any `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteArticleResponse) Reset() {
+	*x = DeleteArticleResponse{}
+	mi := &file_article_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteArticleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteArticleResponse) ProtoMessage() {}
+
+func (x *DeleteArticleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteArticleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteArticleResponse) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DeleteArticleResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *DeleteArticleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DeleteArticleResponse) GetData() *DeleteArticleData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *DeleteArticleResponse) GetDetails() []*anypb.Any {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type DeleteArticleData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteArticleData) Reset() {
+	*x = DeleteArticleData{}
+	mi := &file_article_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteArticleData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteArticleData) ProtoMessage() {}
+
+func (x *DeleteArticleData) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteArticleData.ProtoReflect.Descriptor instead.
+func (*DeleteArticleData) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *DeleteArticleData) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListArticlesResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    *ListArticlesData      `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Structured error details (google.rpc.ErrorInfo, BadRequest, RetryInfo, PreconditionFailure)
+	Details       []*anypb.Any `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListArticlesResponse) Reset() {
+	*x = ListArticlesResponse{}
+	mi := &file_article_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListArticlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArticlesResponse) ProtoMessage() {}
+
+func (x *ListArticlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArticlesResponse.ProtoReflect.Descriptor instead.
+func (*ListArticlesResponse) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListArticlesResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ListArticlesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListArticlesResponse) GetData() *ListArticlesData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListArticlesResponse) GetDetails() []*anypb.Any {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type ListArticlesData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Articles      []*ArticleWithUser     `protobuf:"bytes,1,rep,name=articles,proto3" json:"articles,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	TotalPages    int32                  `protobuf:"varint,4,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListArticlesData) Reset() {
+	*x = ListArticlesData{}
+	mi := &file_article_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListArticlesData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArticlesData) ProtoMessage() {}
+
+func (x *ListArticlesData) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArticlesData.ProtoReflect.Descriptor instead.
+func (*ListArticlesData) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListArticlesData) GetArticles() []*ArticleWithUser {
+	if x != nil {
+		return x.Articles
+	}
+	return nil
+}
+
+func (x *ListArticlesData) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListArticlesData) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListArticlesData) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+type CreateWebhookResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    *CreateWebhookData     `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Structured error details (google.rpc.ErrorInfo, BadRequest, RetryInfo, PreconditionFailure)
+	Details       []*anypb.Any `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookResponse) Reset() {
+	*x = CreateWebhookResponse{}
+	mi := &file_article_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookResponse) ProtoMessage() {}
+
+func (x *CreateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CreateWebhookResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateWebhookResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateWebhookResponse) GetData() *CreateWebhookData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CreateWebhookResponse) GetDetails() []*anypb.Any {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type CreateWebhookData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhook       *Webhook               `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookData) Reset() {
+	*x = CreateWebhookData{}
+	mi := &file_article_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookData) ProtoMessage() {}
+
+func (x *CreateWebhookData) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookData.ProtoReflect.Descriptor instead.
+func (*CreateWebhookData) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *CreateWebhookData) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+type ListWebhooksResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    *ListWebhooksData      `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Structured error details (google.rpc.ErrorInfo, BadRequest, RetryInfo, PreconditionFailure)
+	Details       []*anypb.Any `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksResponse) Reset() {
+	*x = ListWebhooksResponse{}
+	mi := &file_article_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksResponse) ProtoMessage() {}
+
+func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ListWebhooksResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ListWebhooksResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListWebhooksResponse) GetData() *ListWebhooksData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListWebhooksResponse) GetDetails() []*anypb.Any {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type ListWebhooksData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhooks      []*Webhook             `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksData) Reset() {
+	*x = ListWebhooksData{}
+	mi := &file_article_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksData) ProtoMessage() {}
+
+func (x *ListWebhooksData) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksData.ProtoReflect.Descriptor instead.
+func (*ListWebhooksData) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ListWebhooksData) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+type DeleteWebhookResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    *DeleteWebhookData     `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Structured error details (google.rpc.ErrorInfo, BadRequest, RetryInfo, PreconditionFailure)
+	Details       []*anypb.Any `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookResponse) Reset() {
+	*x = DeleteWebhookResponse{}
+	mi := &file_article_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookResponse) ProtoMessage() {}
+
+func (x *DeleteWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *DeleteWebhookResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *DeleteWebhookResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DeleteWebhookResponse) GetData() *DeleteWebhookData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *DeleteWebhookResponse) GetDetails() []*anypb.Any {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type DeleteWebhookData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookData) Reset() {
+	*x = DeleteWebhookData{}
+	mi := &file_article_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookData) ProtoMessage() {}
+
+func (x *DeleteWebhookData) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookData.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookData) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *DeleteWebhookData) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListWebhookDeliveriesResponse struct {
+	state   protoimpl.MessageState     `protogen:"open.v1"`
+	Code    string                     `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                     `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    *ListWebhookDeliveriesData `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Structured error details (google.rpc.ErrorInfo, BadRequest, RetryInfo, PreconditionFailure)
+	Details       []*anypb.Any `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	mi := &file_article_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ListWebhookDeliveriesResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesResponse) GetData() *ListWebhookDeliveriesData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListWebhookDeliveriesResponse) GetDetails() []*anypb.Any {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type ListWebhookDeliveriesData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deliveries    []*WebhookDelivery     `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"` // Newest first
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	TotalPages    int32                  `protobuf:"varint,4,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesData) Reset() {
+	*x = ListWebhookDeliveriesData{}
+	mi := &file_article_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesData) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesData) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesData.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesData) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ListWebhookDeliveriesData) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+func (x *ListWebhookDeliveriesData) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesData) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesData) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+type RetryWebhookDeliveryResponse struct {
+	state   protoimpl.MessageState    `protogen:"open.v1"`
+	Code    string                    `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                    `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    *RetryWebhookDeliveryData `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Structured error details (google.rpc.ErrorInfo, BadRequest, RetryInfo, PreconditionFailure)
+	Details       []*anypb.Any `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryWebhookDeliveryResponse) Reset() {
+	*x = RetryWebhookDeliveryResponse{}
+	mi := &file_article_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryWebhookDeliveryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryWebhookDeliveryResponse) ProtoMessage() {}
+
+func (x *RetryWebhookDeliveryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryWebhookDeliveryResponse.ProtoReflect.Descriptor instead.
+func (*RetryWebhookDeliveryResponse) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *RetryWebhookDeliveryResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *RetryWebhookDeliveryResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RetryWebhookDeliveryResponse) GetData() *RetryWebhookDeliveryData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *RetryWebhookDeliveryResponse) GetDetails() []*anypb.Any {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type RetryWebhookDeliveryData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Delivery      *WebhookDelivery       `protobuf:"bytes,1,opt,name=delivery,proto3" json:"delivery,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryWebhookDeliveryData) Reset() {
+	*x = RetryWebhookDeliveryData{}
+	mi := &file_article_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryWebhookDeliveryData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryWebhookDeliveryData) ProtoMessage() {}
+
+func (x *RetryWebhookDeliveryData) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryWebhookDeliveryData.ProtoReflect.Descriptor instead.
+func (*RetryWebhookDeliveryData) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *RetryWebhookDeliveryData) GetDelivery() *WebhookDelivery {
+	if x != nil {
+		return x.Delivery
+	}
+	return nil
+}
+
+type CreateCommentResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    *CreateCommentData     `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Structured error details (google.rpc.ErrorInfo, BadRequest, RetryInfo, PreconditionFailure)
+	Details       []*anypb.Any `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCommentResponse) Reset() {
+	*x = CreateCommentResponse{}
+	mi := &file_article_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommentResponse) ProtoMessage() {}
+
+func (x *CreateCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommentResponse.ProtoReflect.Descriptor instead.
+func (*CreateCommentResponse) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *CreateCommentResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateCommentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateCommentResponse) GetData() *CreateCommentData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CreateCommentResponse) GetDetails() []*anypb.Any {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type CreateCommentData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comment       *Comment               `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCommentData) Reset() {
+	*x = CreateCommentData{}
+	mi := &file_article_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCommentData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommentData) ProtoMessage() {}
+
+func (x *CreateCommentData) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommentData.ProtoReflect.Descriptor instead.
+func (*CreateCommentData) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *CreateCommentData) GetComment() *Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+type ListCommentsResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    *ListCommentsData      `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Structured error details (google.rpc.ErrorInfo, BadRequest, RetryInfo, PreconditionFailure)
+	Details       []*anypb.Any `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsResponse) Reset() {
+	*x = ListCommentsResponse{}
+	mi := &file_article_service_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsResponse) ProtoMessage() {}
+
+func (x *ListCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListCommentsResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ListCommentsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListCommentsResponse) GetData() *ListCommentsData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListCommentsResponse) GetDetails() []*anypb.Any {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type ListCommentsData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comments      []*Comment             `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"` // Top-level comments, oldest first
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`      // Top-level comments of the article
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	TotalPages    int32                  `protobuf:"varint,4,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsData) Reset() {
+	*x = ListCommentsData{}
+	mi := &file_article_service_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsData) ProtoMessage() {}
+
+func (x *ListCommentsData) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsData.ProtoReflect.Descriptor instead.
+func (*ListCommentsData) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ListCommentsData) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *ListCommentsData) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListCommentsData) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCommentsData) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+type ListRepliesResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    *ListRepliesData       `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Structured error details (google.rpc.ErrorInfo, BadRequest, RetryInfo, PreconditionFailure)
+	Details       []*anypb.Any `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRepliesResponse) Reset() {
+	*x = ListRepliesResponse{}
+	mi := &file_article_service_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRepliesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRepliesResponse) ProtoMessage() {}
+
+func (x *ListRepliesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRepliesResponse.ProtoReflect.Descriptor instead.
+func (*ListRepliesResponse) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ListRepliesResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ListRepliesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListRepliesResponse) GetData() *ListRepliesData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListRepliesResponse) GetDetails() []*anypb.Any {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type ListRepliesData struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Oldest first, not nested: a reply's parent may be on an earlier page, so attach by parent_id
+	Replies       []*Comment `protobuf:"bytes,1,rep,name=replies,proto3" json:"replies,omitempty"`
+	NextPageToken string     `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRepliesData) Reset() {
+	*x = ListRepliesData{}
+	mi := &file_article_service_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRepliesData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRepliesData) ProtoMessage() {}
+
+func (x *ListRepliesData) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRepliesData.ProtoReflect.Descriptor instead.
+func (*ListRepliesData) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ListRepliesData) GetReplies() []*Comment {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+func (x *ListRepliesData) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UpdateCommentResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    *UpdateCommentData     `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Structured error details (google.rpc.ErrorInfo, BadRequest, RetryInfo, PreconditionFailure)
+	Details       []*anypb.Any `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCommentResponse) Reset() {
+	*x = UpdateCommentResponse{}
+	mi := &file_article_service_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCommentResponse) ProtoMessage() {}
+
+func (x *UpdateCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCommentResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCommentResponse) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *UpdateCommentResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *UpdateCommentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateCommentResponse) GetData() *UpdateCommentData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UpdateCommentResponse) GetDetails() []*anypb.Any {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type UpdateCommentData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comment       *Comment               `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCommentData) Reset() {
+	*x = UpdateCommentData{}
+	mi := &file_article_service_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCommentData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCommentData) ProtoMessage() {}
+
+func (x *UpdateCommentData) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCommentData.ProtoReflect.Descriptor instead.
+func (*UpdateCommentData) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *UpdateCommentData) GetComment() *Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+type DeleteCommentResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    *DeleteCommentData     `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Structured error details (google.rpc.ErrorInfo, BadRequest, RetryInfo, PreconditionFailure)
+	Details       []*anypb.Any `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentResponse) Reset() {
+	*x = DeleteCommentResponse{}
+	mi := &file_article_service_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentResponse) ProtoMessage() {}
+
+func (x *DeleteCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCommentResponse) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *DeleteCommentResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *DeleteCommentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DeleteCommentResponse) GetData() *DeleteCommentData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *DeleteCommentResponse) GetDetails() []*anypb.Any {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type DeleteCommentData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentData) Reset() {
+	*x = DeleteCommentData{}
+	mi := &file_article_service_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentData) ProtoMessage() {}
+
+func (x *DeleteCommentData) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentData.ProtoReflect.Descriptor instead.
+func (*DeleteCommentData) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *DeleteCommentData) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ModerateCommentResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    *ModerateCommentData   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Structured error details (google.rpc.ErrorInfo, BadRequest, RetryInfo, PreconditionFailure)
+	Details       []*anypb.Any `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateCommentResponse) Reset() {
+	*x = ModerateCommentResponse{}
+	mi := &file_article_service_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateCommentResponse) ProtoMessage() {}
+
+func (x *ModerateCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateCommentResponse.ProtoReflect.Descriptor instead.
+func (*ModerateCommentResponse) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ModerateCommentResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ModerateCommentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ModerateCommentResponse) GetData() *ModerateCommentData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ModerateCommentResponse) GetDetails() []*anypb.Any {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type ModerateCommentData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comment       *Comment               `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateCommentData) Reset() {
+	*x = ModerateCommentData{}
+	mi := &file_article_service_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateCommentData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateCommentData) ProtoMessage() {}
+
+func (x *ModerateCommentData) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateCommentData.ProtoReflect.Descriptor instead.
+func (*ModerateCommentData) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ModerateCommentData) GetComment() *Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+type AddReactionResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    *AddReactionData       `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Structured error details (google.rpc.ErrorInfo, BadRequest, RetryInfo, PreconditionFailure)
+	Details       []*anypb.Any `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddReactionResponse) Reset() {
+	*x = AddReactionResponse{}
+	mi := &file_article_service_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddReactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddReactionResponse) ProtoMessage() {}
+
+func (x *AddReactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddReactionResponse.ProtoReflect.Descriptor instead.
+func (*AddReactionResponse) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *AddReactionResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *AddReactionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AddReactionResponse) GetData() *AddReactionData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *AddReactionResponse) GetDetails() []*anypb.Any {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type AddReactionData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Summary       *ReactionSummary       `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+	Changed       bool                   `protobuf:"varint,2,opt,name=changed,proto3" json:"changed,omitempty"` // false when the caller had already added this reaction
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddReactionData) Reset() {
+	*x = AddReactionData{}
+	mi := &file_article_service_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddReactionData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddReactionData) ProtoMessage() {}
+
+func (x *AddReactionData) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddReactionData.ProtoReflect.Descriptor instead.
+func (*AddReactionData) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *AddReactionData) GetSummary() *ReactionSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *AddReactionData) GetChanged() bool {
+	if x != nil {
+		return x.Changed
+	}
+	return false
+}
+
+type RemoveReactionResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    *RemoveReactionData    `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Structured error details (google.rpc.ErrorInfo, BadRequest, RetryInfo, PreconditionFailure)
+	Details       []*anypb.Any `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveReactionResponse) Reset() {
+	*x = RemoveReactionResponse{}
+	mi := &file_article_service_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveReactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveReactionResponse) ProtoMessage() {}
+
+func (x *RemoveReactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveReactionResponse.ProtoReflect.Descriptor instead.
+func (*RemoveReactionResponse) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *RemoveReactionResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *RemoveReactionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RemoveReactionResponse) GetData() *RemoveReactionData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *RemoveReactionResponse) GetDetails() []*anypb.Any {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type RemoveReactionData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Summary       *ReactionSummary       `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+	Changed       bool                   `protobuf:"varint,2,opt,name=changed,proto3" json:"changed,omitempty"` // false when the caller had no such reaction
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveReactionData) Reset() {
+	*x = RemoveReactionData{}
+	mi := &file_article_service_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveReactionData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveReactionData) ProtoMessage() {}
+
+func (x *RemoveReactionData) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveReactionData.ProtoReflect.Descriptor instead.
+func (*RemoveReactionData) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *RemoveReactionData) GetSummary() *ReactionSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *RemoveReactionData) GetChanged() bool {
+	if x != nil {
+		return x.Changed
+	}
+	return false
+}
+
+type ListReactionsResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    *ListReactionsData     `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Structured error details (google.rpc.ErrorInfo, BadRequest, RetryInfo, PreconditionFailure)
+	Details       []*anypb.Any `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReactionsResponse) Reset() {
+	*x = ListReactionsResponse{}
+	mi := &file_article_service_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReactionsResponse) ProtoMessage() {}
+
+func (x *ListReactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListReactionsResponse) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ListReactionsResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ListReactionsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListReactionsResponse) GetData() *ListReactionsData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListReactionsResponse) GetDetails() []*anypb.Any {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type ListReactionsData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reactions     []*Reaction            `protobuf:"bytes,1,rep,name=reactions,proto3" json:"reactions,omitempty"` // Newest first
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	TotalPages    int32                  `protobuf:"varint,4,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	Summary       *ReactionSummary       `protobuf:"bytes,5,opt,name=summary,proto3" json:"summary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReactionsData) Reset() {
+	*x = ListReactionsData{}
+	mi := &file_article_service_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReactionsData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReactionsData) ProtoMessage() {}
+
+func (x *ListReactionsData) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReactionsData.ProtoReflect.Descriptor instead.
+func (*ListReactionsData) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ListReactionsData) GetReactions() []*Reaction {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *ListReactionsData) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListReactionsData) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListReactionsData) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *ListReactionsData) GetSummary() *ReactionSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+type BookmarkArticleResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    *BookmarkArticleData   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Structured error details (google.rpc.ErrorInfo, BadRequest, RetryInfo, PreconditionFailure)
+	Details       []*anypb.Any `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookmarkArticleResponse) Reset() {
+	*x = BookmarkArticleResponse{}
+	mi := &file_article_service_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookmarkArticleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookmarkArticleResponse) ProtoMessage() {}
+
+func (x *BookmarkArticleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookmarkArticleResponse.ProtoReflect.Descriptor instead.
+func (*BookmarkArticleResponse) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *BookmarkArticleResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *BookmarkArticleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BookmarkArticleResponse) GetData() *BookmarkArticleData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *BookmarkArticleResponse) GetDetails() []*anypb.Any {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type BookmarkArticleData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bookmark      *Bookmark              `protobuf:"bytes,1,opt,name=bookmark,proto3" json:"bookmark,omitempty"`
+	Changed       bool                   `protobuf:"varint,2,opt,name=changed,proto3" json:"changed,omitempty"` // false when the article was already in the list
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookmarkArticleData) Reset() {
+	*x = BookmarkArticleData{}
+	mi := &file_article_service_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookmarkArticleData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookmarkArticleData) ProtoMessage() {}
+
+func (x *BookmarkArticleData) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookmarkArticleData.ProtoReflect.Descriptor instead.
+func (*BookmarkArticleData) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *BookmarkArticleData) GetBookmark() *Bookmark {
+	if x != nil {
+		return x.Bookmark
+	}
+	return nil
+}
+
+func (x *BookmarkArticleData) GetChanged() bool {
+	if x != nil {
+		return x.Changed
+	}
+	return false
+}
+
+type UnbookmarkArticleResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    *UnbookmarkArticleData `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Structured error details (google.rpc.ErrorInfo, BadRequest, RetryInfo, PreconditionFailure)
+	Details       []*anypb.Any `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnbookmarkArticleResponse) Reset() {
+	*x = UnbookmarkArticleResponse{}
+	mi := &file_article_service_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnbookmarkArticleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbookmarkArticleResponse) ProtoMessage() {}
+
+func (x *UnbookmarkArticleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbookmarkArticleResponse.ProtoReflect.Descriptor instead.
+func (*UnbookmarkArticleResponse) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *UnbookmarkArticleResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *UnbookmarkArticleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UnbookmarkArticleResponse) GetData() *UnbookmarkArticleData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UnbookmarkArticleResponse) GetDetails() []*anypb.Any {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type UnbookmarkArticleData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changed       bool                   `protobuf:"varint,1,opt,name=changed,proto3" json:"changed,omitempty"` // false when the article was not in the list
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnbookmarkArticleData) Reset() {
+	*x = UnbookmarkArticleData{}
+	mi := &file_article_service_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnbookmarkArticleData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbookmarkArticleData) ProtoMessage() {}
+
+func (x *UnbookmarkArticleData) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbookmarkArticleData.ProtoReflect.Descriptor instead.
+func (*UnbookmarkArticleData) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *UnbookmarkArticleData) GetChanged() bool {
+	if x != nil {
+		return x.Changed
+	}
+	return false
+}
+
+type ListBookmarksResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    *ListBookmarksData     `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Structured error details (google.rpc.ErrorInfo, BadRequest, RetryInfo, PreconditionFailure)
+	Details       []*anypb.Any `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBookmarksResponse) Reset() {
+	*x = ListBookmarksResponse{}
+	mi := &file_article_service_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBookmarksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBookmarksResponse) ProtoMessage() {}
+
+func (x *ListBookmarksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBookmarksResponse.ProtoReflect.Descriptor instead.
+func (*ListBookmarksResponse) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *ListBookmarksResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ListBookmarksResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListBookmarksResponse) GetData() *ListBookmarksData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListBookmarksResponse) GetDetails() []*anypb.Any {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type ListBookmarksData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bookmarks     []*Bookmark            `protobuf:"bytes,1,rep,name=bookmarks,proto3" json:"bookmarks,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBookmarksData) Reset() {
+	*x = ListBookmarksData{}
+	mi := &file_article_service_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBookmarksData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBookmarksData) ProtoMessage() {}
+
+func (x *ListBookmarksData) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBookmarksData.ProtoReflect.Descriptor instead.
+func (*ListBookmarksData) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *ListBookmarksData) GetBookmarks() []*Bookmark {
+	if x != nil {
+		return x.Bookmarks
+	}
+	return nil
+}
+
+func (x *ListBookmarksData) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CreateCollectionResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    *CreateCollectionData  `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Structured error details (google.rpc.ErrorInfo, BadRequest, RetryInfo, PreconditionFailure)
+	Details       []*anypb.Any `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCollectionResponse) Reset() {
+	*x = CreateCollectionResponse{}
+	mi := &file_article_service_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCollectionResponse) ProtoMessage() {}
+
+func (x *CreateCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCollectionResponse.ProtoReflect.Descriptor instead.
+func (*CreateCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *CreateCollectionResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateCollectionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateCollectionResponse) GetData() *CreateCollectionData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CreateCollectionResponse) GetDetails() []*anypb.Any {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type CreateCollectionData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collection    *Collection            `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCollectionData) Reset() {
+	*x = CreateCollectionData{}
+	mi := &file_article_service_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCollectionData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCollectionData) ProtoMessage() {}
+
+func (x *CreateCollectionData) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCollectionData.ProtoReflect.Descriptor instead.
+func (*CreateCollectionData) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *CreateCollectionData) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+type ListCollectionsResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    *ListCollectionsData   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Structured error details (google.rpc.ErrorInfo, BadRequest, RetryInfo, PreconditionFailure)
+	Details       []*anypb.Any `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionsResponse) Reset() {
+	*x = ListCollectionsResponse{}
+	mi := &file_article_service_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionsResponse) ProtoMessage() {}
+
+func (x *ListCollectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionsResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectionsResponse) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ListCollectionsResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ListCollectionsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListCollectionsResponse) GetData() *ListCollectionsData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListCollectionsResponse) GetDetails() []*anypb.Any {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type ListCollectionsData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collections   []*Collection          `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"` // By position
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionsData) Reset() {
+	*x = ListCollectionsData{}
+	mi := &file_article_service_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionsData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionsData) ProtoMessage() {}
+
+func (x *ListCollectionsData) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionsData.ProtoReflect.Descriptor instead.
+func (*ListCollectionsData) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *ListCollectionsData) GetCollections() []*Collection {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+type RenameCollectionResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    *RenameCollectionData  `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Structured error details (google.rpc.ErrorInfo, BadRequest, RetryInfo, PreconditionFailure)
+	Details       []*anypb.Any `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameCollectionResponse) Reset() {
+	*x = RenameCollectionResponse{}
+	mi := &file_article_service_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameCollectionResponse) ProtoMessage() {}
+
+func (x *RenameCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameCollectionResponse.ProtoReflect.Descriptor instead.
+func (*RenameCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *RenameCollectionResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *RenameCollectionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RenameCollectionResponse) GetData() *RenameCollectionData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *RenameCollectionResponse) GetDetails() []*anypb.Any {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type RenameCollectionData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collection    *Collection            `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameCollectionData) Reset() {
+	*x = RenameCollectionData{}
+	mi := &file_article_service_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameCollectionData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameCollectionData) ProtoMessage() {}
+
+func (x *RenameCollectionData) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameCollectionData.ProtoReflect.Descriptor instead.
+func (*RenameCollectionData) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *RenameCollectionData) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+type ReorderCollectionsResponse struct {
+	state   protoimpl.MessageState  `protogen:"open.v1"`
+	Code    string                  `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    *ReorderCollectionsData `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Structured error details (google.rpc.ErrorInfo, BadRequest, RetryInfo, PreconditionFailure)
+	Details       []*anypb.Any `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderCollectionsResponse) Reset() {
+	*x = ReorderCollectionsResponse{}
+	mi := &file_article_service_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderCollectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderCollectionsResponse) ProtoMessage() {}
+
+func (x *ReorderCollectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderCollectionsResponse.ProtoReflect.Descriptor instead.
+func (*ReorderCollectionsResponse) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *ReorderCollectionsResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ReorderCollectionsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReorderCollectionsResponse) GetData() *ReorderCollectionsData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ReorderCollectionsResponse) GetDetails() []*anypb.Any {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type ReorderCollectionsData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collections   []*Collection          `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"` // By position
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderCollectionsData) Reset() {
+	*x = ReorderCollectionsData{}
+	mi := &file_article_service_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderCollectionsData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderCollectionsData) ProtoMessage() {}
+
+func (x *ReorderCollectionsData) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderCollectionsData.ProtoReflect.Descriptor instead.
+func (*ReorderCollectionsData) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *ReorderCollectionsData) GetCollections() []*Collection {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+type ShareCollectionResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    *ShareCollectionData   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Structured error details (google.rpc.ErrorInfo, BadRequest, RetryInfo, PreconditionFailure)
+	Details       []*anypb.Any `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareCollectionResponse) Reset() {
+	*x = ShareCollectionResponse{}
+	mi := &file_article_service_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareCollectionResponse) ProtoMessage() {}
+
+func (x *ShareCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[113]
 	if x != nil {
-		return x.Title
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Article) GetContent() string {
+// Deprecated: Use ShareCollectionResponse.ProtoReflect.Descriptor instead.
+func (*ShareCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *ShareCollectionResponse) GetCode() string {
 	if x != nil {
-		return x.Content
+		return x.Code
 	}
 	return ""
 }
 
-func (x *Article) GetUserId() int32 {
+func (x *ShareCollectionResponse) GetMessage() string {
 	if x != nil {
-		return x.UserId
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-func (x *Article) GetCreatedAt() string {
+func (x *ShareCollectionResponse) GetData() *ShareCollectionData {
 	if x != nil {
-		return x.CreatedAt
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *Article) GetUpdatedAt() string {
+func (x *ShareCollectionResponse) GetDetails() []*anypb.Any {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Details
 	}
-	return ""
+	return nil
 }
 
-type ArticleWithUser struct {
+type ShareCollectionData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Article       *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
-	User          *User                  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Collection    *Collection            `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ArticleWithUser) Reset() {
-	*x = ArticleWithUser{}
-	mi := &file_article_service_proto_msgTypes[2]
+func (x *ShareCollectionData) Reset() {
+	*x = ShareCollectionData{}
+	mi := &file_article_service_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ArticleWithUser) String() string {
+func (x *ShareCollectionData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ArticleWithUser) ProtoMessage() {}
+func (*ShareCollectionData) ProtoMessage() {}
 
-func (x *ArticleWithUser) ProtoReflect() protoreflect.Message {
-	mi := &file_article_service_proto_msgTypes[2]
+func (x *ShareCollectionData) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -215,49 +7593,44 @@ func (x *ArticleWithUser) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ArticleWithUser.ProtoReflect.Descriptor instead.
-func (*ArticleWithUser) Descriptor() ([]byte, []int) {
-	return file_article_service_proto_rawDescGZIP(), []int{2}
-}
-
-func (x *ArticleWithUser) GetArticle() *Article {
-	if x != nil {
-		return x.Article
-	}
-	return nil
+// Deprecated: Use ShareCollectionData.ProtoReflect.Descriptor instead.
+func (*ShareCollectionData) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *ArticleWithUser) GetUser() *User {
+func (x *ShareCollectionData) GetCollection() *Collection {
 	if x != nil {
-		return x.User
+		return x.Collection
 	}
 	return nil
 }
 
-type CreateArticleRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	UserId        int32                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+type DeleteCollectionResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    *DeleteCollectionData  `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Structured error details (google.rpc.ErrorInfo, BadRequest, RetryInfo, PreconditionFailure)
+	Details       []*anypb.Any `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateArticleRequest) Reset() {
-	*x = CreateArticleRequest{}
-	mi := &file_article_service_proto_msgTypes[3]
+func (x *DeleteCollectionResponse) Reset() {
+	*x = DeleteCollectionResponse{}
+	mi := &file_article_service_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateArticleRequest) String() string {
+func (x *DeleteCollectionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateArticleRequest) ProtoMessage() {}
+func (*DeleteCollectionResponse) ProtoMessage() {}
 
-func (x *CreateArticleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_article_service_proto_msgTypes[3]
+func (x *DeleteCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -268,54 +7641,61 @@ func (x *CreateArticleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateArticleRequest.ProtoReflect.Descriptor instead.
-func (*CreateArticleRequest) Descriptor() ([]byte, []int) {
-	return file_article_service_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use DeleteCollectionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *CreateArticleRequest) GetTitle() string {
+func (x *DeleteCollectionResponse) GetCode() string {
 	if x != nil {
-		return x.Title
+		return x.Code
 	}
 	return ""
 }
 
-func (x *CreateArticleRequest) GetContent() string {
+func (x *DeleteCollectionResponse) GetMessage() string {
 	if x != nil {
-		return x.Content
+		return x.Message
 	}
 	return ""
 }
 
-func (x *CreateArticleRequest) GetUserId() int32 {
+func (x *DeleteCollectionResponse) GetData() *DeleteCollectionData {
 	if x != nil {
-		return x.UserId
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-type GetArticleRequest struct {
+func (x *DeleteCollectionResponse) GetDetails() []*anypb.Any {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type DeleteCollectionData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetArticleRequest) Reset() {
-	*x = GetArticleRequest{}
-	mi := &file_article_service_proto_msgTypes[4]
+func (x *DeleteCollectionData) Reset() {
+	*x = DeleteCollectionData{}
+	mi := &file_article_service_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetArticleRequest) String() string {
+func (x *DeleteCollectionData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetArticleRequest) ProtoMessage() {}
+func (*DeleteCollectionData) ProtoMessage() {}
 
-func (x *GetArticleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_article_service_proto_msgTypes[4]
+func (x *DeleteCollectionData) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -326,42 +7706,44 @@ func (x *GetArticleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetArticleRequest.ProtoReflect.Descriptor instead.
-func (*GetArticleRequest) Descriptor() ([]byte, []int) {
-	return file_article_service_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use DeleteCollectionData.ProtoReflect.Descriptor instead.
+func (*DeleteCollectionData) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *GetArticleRequest) GetId() int32 {
+func (x *DeleteCollectionData) GetSuccess() bool {
 	if x != nil {
-		return x.Id
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-type UpdateArticleRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+type GetSharedCollectionResponse struct {
+	state   protoimpl.MessageState   `protogen:"open.v1"`
+	Code    string                   `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    *GetSharedCollectionData `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Structured error details (google.rpc.ErrorInfo, BadRequest, RetryInfo, PreconditionFailure)
+	Details       []*anypb.Any `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateArticleRequest) Reset() {
-	*x = UpdateArticleRequest{}
-	mi := &file_article_service_proto_msgTypes[5]
+func (x *GetSharedCollectionResponse) Reset() {
+	*x = GetSharedCollectionResponse{}
+	mi := &file_article_service_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateArticleRequest) String() string {
+func (x *GetSharedCollectionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateArticleRequest) ProtoMessage() {}
+func (*GetSharedCollectionResponse) ProtoMessage() {}
 
-func (x *UpdateArticleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_article_service_proto_msgTypes[5]
+func (x *GetSharedCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -372,54 +7754,63 @@ func (x *UpdateArticleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateArticleRequest.ProtoReflect.Descriptor instead.
-func (*UpdateArticleRequest) Descriptor() ([]byte, []int) {
-	return file_article_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use GetSharedCollectionResponse.ProtoReflect.Descriptor instead.
+func (*GetSharedCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *UpdateArticleRequest) GetId() int32 {
+func (x *GetSharedCollectionResponse) GetCode() string {
 	if x != nil {
-		return x.Id
+		return x.Code
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateArticleRequest) GetTitle() string {
+func (x *GetSharedCollectionResponse) GetMessage() string {
 	if x != nil {
-		return x.Title
+		return x.Message
 	}
 	return ""
 }
 
-func (x *UpdateArticleRequest) GetContent() string {
+func (x *GetSharedCollectionResponse) GetData() *GetSharedCollectionData {
 	if x != nil {
-		return x.Content
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-type DeleteArticleRequest struct {
+func (x *GetSharedCollectionResponse) GetDetails() []*anypb.Any {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type GetSharedCollectionData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Collection    *Collection            `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Bookmarks     []*Bookmark            `protobuf:"bytes,2,rep,name=bookmarks,proto3" json:"bookmarks,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteArticleRequest) Reset() {
-	*x = DeleteArticleRequest{}
-	mi := &file_article_service_proto_msgTypes[6]
+func (x *GetSharedCollectionData) Reset() {
+	*x = GetSharedCollectionData{}
+	mi := &file_article_service_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteArticleRequest) String() string {
+func (x *GetSharedCollectionData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteArticleRequest) ProtoMessage() {}
+func (*GetSharedCollectionData) ProtoMessage() {}
 
-func (x *DeleteArticleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_article_service_proto_msgTypes[6]
+func (x *GetSharedCollectionData) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -430,42 +7821,58 @@ func (x *DeleteArticleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteArticleRequest.ProtoReflect.Descriptor instead.
-func (*DeleteArticleRequest) Descriptor() ([]byte, []int) {
-	return file_article_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use GetSharedCollectionData.ProtoReflect.Descriptor instead.
+func (*GetSharedCollectionData) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *DeleteArticleRequest) GetId() int32 {
+func (x *GetSharedCollectionData) GetCollection() *Collection {
 	if x != nil {
-		return x.Id
+		return x.Collection
 	}
-	return 0
+	return nil
 }
 
-type ListArticlesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageNumber    int32                  `protobuf:"varint,2,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
-	UserId        int32                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` //Filter by user
+func (x *GetSharedCollectionData) GetBookmarks() []*Bookmark {
+	if x != nil {
+		return x.Bookmarks
+	}
+	return nil
+}
+
+func (x *GetSharedCollectionData) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListTrendingArticlesResponse struct {
+	state   protoimpl.MessageState    `protogen:"open.v1"`
+	Code    string                    `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                    `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    *ListTrendingArticlesData `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Structured error details (google.rpc.ErrorInfo, BadRequest, RetryInfo, PreconditionFailure)
+	Details       []*anypb.Any `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListArticlesRequest) Reset() {
-	*x = ListArticlesRequest{}
-	mi := &file_article_service_proto_msgTypes[7]
+func (x *ListTrendingArticlesResponse) Reset() {
+	*x = ListTrendingArticlesResponse{}
+	mi := &file_article_service_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListArticlesRequest) String() string {
+func (x *ListTrendingArticlesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListArticlesRequest) ProtoMessage() {}
+func (*ListTrendingArticlesResponse) ProtoMessage() {}
 
-func (x *ListArticlesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_article_service_proto_msgTypes[7]
+func (x *ListTrendingArticlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -476,56 +7883,61 @@ func (x *ListArticlesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListArticlesRequest.ProtoReflect.Descriptor instead.
-func (*ListArticlesRequest) Descriptor() ([]byte, []int) {
-	return file_article_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ListTrendingArticlesResponse.ProtoReflect.Descriptor instead.
+func (*ListTrendingArticlesResponse) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *ListArticlesRequest) GetPageSize() int32 {
+func (x *ListTrendingArticlesResponse) GetCode() string {
 	if x != nil {
-		return x.PageSize
+		return x.Code
 	}
-	return 0
+	return ""
 }
 
-func (x *ListArticlesRequest) GetPageNumber() int32 {
+func (x *ListTrendingArticlesResponse) GetMessage() string {
 	if x != nil {
-		return x.PageNumber
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-func (x *ListArticlesRequest) GetUserId() int32 {
+func (x *ListTrendingArticlesResponse) GetData() *ListTrendingArticlesData {
 	if x != nil {
-		return x.UserId
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-type CreateArticleResponse struct {
+func (x *ListTrendingArticlesResponse) GetDetails() []*anypb.Any {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type ListTrendingArticlesData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Data          *CreateArticleData     `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Articles      []*TrendingArticle     `protobuf:"bytes,1,rep,name=articles,proto3" json:"articles,omitempty"` // Highest score first
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateArticleResponse) Reset() {
-	*x = CreateArticleResponse{}
-	mi := &file_article_service_proto_msgTypes[8]
+func (x *ListTrendingArticlesData) Reset() {
+	*x = ListTrendingArticlesData{}
+	mi := &file_article_service_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateArticleResponse) String() string {
+func (x *ListTrendingArticlesData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateArticleResponse) ProtoMessage() {}
+func (*ListTrendingArticlesData) ProtoMessage() {}
 
-func (x *CreateArticleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_article_service_proto_msgTypes[8]
+func (x *ListTrendingArticlesData) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -536,54 +7948,109 @@ func (x *CreateArticleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateArticleResponse.ProtoReflect.Descriptor instead.
-func (*CreateArticleResponse) Descriptor() ([]byte, []int) {
-	return file_article_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ListTrendingArticlesData.ProtoReflect.Descriptor instead.
+func (*ListTrendingArticlesData) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *CreateArticleResponse) GetCode() string {
+func (x *ListTrendingArticlesData) GetArticles() []*TrendingArticle {
+	if x != nil {
+		return x.Articles
+	}
+	return nil
+}
+
+type GetRelatedArticlesResponse struct {
+	state   protoimpl.MessageState  `protogen:"open.v1"`
+	Code    string                  `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    *GetRelatedArticlesData `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Structured error details (google.rpc.ErrorInfo, BadRequest, RetryInfo, PreconditionFailure)
+	Details       []*anypb.Any `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRelatedArticlesResponse) Reset() {
+	*x = GetRelatedArticlesResponse{}
+	mi := &file_article_service_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRelatedArticlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelatedArticlesResponse) ProtoMessage() {}
+
+func (x *GetRelatedArticlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelatedArticlesResponse.ProtoReflect.Descriptor instead.
+func (*GetRelatedArticlesResponse) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *GetRelatedArticlesResponse) GetCode() string {
 	if x != nil {
 		return x.Code
 	}
 	return ""
 }
 
-func (x *CreateArticleResponse) GetMessage() string {
+func (x *GetRelatedArticlesResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *CreateArticleResponse) GetData() *CreateArticleData {
+func (x *GetRelatedArticlesResponse) GetData() *GetRelatedArticlesData {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type CreateArticleData struct {
+func (x *GetRelatedArticlesResponse) GetDetails() []*anypb.Any {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type GetRelatedArticlesData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Article       *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	Articles      []*ArticleWithUser     `protobuf:"bytes,1,rep,name=articles,proto3" json:"articles,omitempty"` // Most related first
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateArticleData) Reset() {
-	*x = CreateArticleData{}
-	mi := &file_article_service_proto_msgTypes[9]
+func (x *GetRelatedArticlesData) Reset() {
+	*x = GetRelatedArticlesData{}
+	mi := &file_article_service_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateArticleData) String() string {
+func (x *GetRelatedArticlesData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateArticleData) ProtoMessage() {}
+func (*GetRelatedArticlesData) ProtoMessage() {}
 
-func (x *CreateArticleData) ProtoReflect() protoreflect.Message {
-	mi := &file_article_service_proto_msgTypes[9]
+func (x *GetRelatedArticlesData) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -594,42 +8061,44 @@ func (x *CreateArticleData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateArticleData.ProtoReflect.Descriptor instead.
-func (*CreateArticleData) Descriptor() ([]byte, []int) {
-	return file_article_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use GetRelatedArticlesData.ProtoReflect.Descriptor instead.
+func (*GetRelatedArticlesData) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *CreateArticleData) GetArticle() *Article {
+func (x *GetRelatedArticlesData) GetArticles() []*ArticleWithUser {
 	if x != nil {
-		return x.Article
+		return x.Articles
 	}
 	return nil
 }
 
-type GetArticleResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Data          *GetArticleData        `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+type FollowAuthorResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    *FollowAuthorData      `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Structured error details (google.rpc.ErrorInfo, BadRequest, RetryInfo, PreconditionFailure)
+	Details       []*anypb.Any `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetArticleResponse) Reset() {
-	*x = GetArticleResponse{}
-	mi := &file_article_service_proto_msgTypes[10]
+func (x *FollowAuthorResponse) Reset() {
+	*x = FollowAuthorResponse{}
+	mi := &file_article_service_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetArticleResponse) String() string {
+func (x *FollowAuthorResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetArticleResponse) ProtoMessage() {}
+func (*FollowAuthorResponse) ProtoMessage() {}
 
-func (x *GetArticleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_article_service_proto_msgTypes[10]
+func (x *FollowAuthorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -640,54 +8109,62 @@ func (x *GetArticleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetArticleResponse.ProtoReflect.Descriptor instead.
-func (*GetArticleResponse) Descriptor() ([]byte, []int) {
-	return file_article_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use FollowAuthorResponse.ProtoReflect.Descriptor instead.
+func (*FollowAuthorResponse) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *GetArticleResponse) GetCode() string {
+func (x *FollowAuthorResponse) GetCode() string {
 	if x != nil {
 		return x.Code
 	}
 	return ""
 }
 
-func (x *GetArticleResponse) GetMessage() string {
+func (x *FollowAuthorResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *GetArticleResponse) GetData() *GetArticleData {
+func (x *FollowAuthorResponse) GetData() *FollowAuthorData {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type GetArticleData struct {
+func (x *FollowAuthorResponse) GetDetails() []*anypb.Any {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type FollowAuthorData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Article       *ArticleWithUser       `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	Follow        *Follow                `protobuf:"bytes,1,opt,name=follow,proto3" json:"follow,omitempty"`
+	Changed       bool                   `protobuf:"varint,2,opt,name=changed,proto3" json:"changed,omitempty"` // false when the author was already followed
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetArticleData) Reset() {
-	*x = GetArticleData{}
-	mi := &file_article_service_proto_msgTypes[11]
+func (x *FollowAuthorData) Reset() {
+	*x = FollowAuthorData{}
+	mi := &file_article_service_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetArticleData) String() string {
+func (x *FollowAuthorData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetArticleData) ProtoMessage() {}
+func (*FollowAuthorData) ProtoMessage() {}
 
-func (x *GetArticleData) ProtoReflect() protoreflect.Message {
-	mi := &file_article_service_proto_msgTypes[11]
+func (x *FollowAuthorData) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -698,42 +8175,51 @@ func (x *GetArticleData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetArticleData.ProtoReflect.Descriptor instead.
-func (*GetArticleData) Descriptor() ([]byte, []int) {
-	return file_article_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use FollowAuthorData.ProtoReflect.Descriptor instead.
+func (*FollowAuthorData) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{124}
 }
 
-func (x *GetArticleData) GetArticle() *ArticleWithUser {
+func (x *FollowAuthorData) GetFollow() *Follow {
 	if x != nil {
-		return x.Article
+		return x.Follow
 	}
 	return nil
 }
 
-type UpdateArticleResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Data          *UpdateArticleData     `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+func (x *FollowAuthorData) GetChanged() bool {
+	if x != nil {
+		return x.Changed
+	}
+	return false
+}
+
+type UnfollowAuthorResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    *UnfollowAuthorData    `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Structured error details (google.rpc.ErrorInfo, BadRequest, RetryInfo, PreconditionFailure)
+	Details       []*anypb.Any `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateArticleResponse) Reset() {
-	*x = UpdateArticleResponse{}
-	mi := &file_article_service_proto_msgTypes[12]
+func (x *UnfollowAuthorResponse) Reset() {
+	*x = UnfollowAuthorResponse{}
+	mi := &file_article_service_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateArticleResponse) String() string {
+func (x *UnfollowAuthorResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateArticleResponse) ProtoMessage() {}
+func (*UnfollowAuthorResponse) ProtoMessage() {}
 
-func (x *UpdateArticleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_article_service_proto_msgTypes[12]
+func (x *UnfollowAuthorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -744,54 +8230,61 @@ func (x *UpdateArticleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateArticleResponse.ProtoReflect.Descriptor instead.
-func (*UpdateArticleResponse) Descriptor() ([]byte, []int) {
-	return file_article_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use UnfollowAuthorResponse.ProtoReflect.Descriptor instead.
+func (*UnfollowAuthorResponse) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *UpdateArticleResponse) GetCode() string {
+func (x *UnfollowAuthorResponse) GetCode() string {
 	if x != nil {
 		return x.Code
 	}
 	return ""
 }
 
-func (x *UpdateArticleResponse) GetMessage() string {
+func (x *UnfollowAuthorResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *UpdateArticleResponse) GetData() *UpdateArticleData {
+func (x *UnfollowAuthorResponse) GetData() *UnfollowAuthorData {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type UpdateArticleData struct {
+func (x *UnfollowAuthorResponse) GetDetails() []*anypb.Any {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type UnfollowAuthorData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Article       *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	Changed       bool                   `protobuf:"varint,1,opt,name=changed,proto3" json:"changed,omitempty"` // false when the author was not followed
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateArticleData) Reset() {
-	*x = UpdateArticleData{}
-	mi := &file_article_service_proto_msgTypes[13]
+func (x *UnfollowAuthorData) Reset() {
+	*x = UnfollowAuthorData{}
+	mi := &file_article_service_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateArticleData) String() string {
+func (x *UnfollowAuthorData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateArticleData) ProtoMessage() {}
+func (*UnfollowAuthorData) ProtoMessage() {}
 
-func (x *UpdateArticleData) ProtoReflect() protoreflect.Message {
-	mi := &file_article_service_proto_msgTypes[13]
+func (x *UnfollowAuthorData) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -802,42 +8295,44 @@ func (x *UpdateArticleData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateArticleData.ProtoReflect.Descriptor instead.
-func (*UpdateArticleData) Descriptor() ([]byte, []int) {
-	return file_article_service_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use UnfollowAuthorData.ProtoReflect.Descriptor instead.
+func (*UnfollowAuthorData) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{126}
 }
 
-func (x *UpdateArticleData) GetArticle() *Article {
+func (x *UnfollowAuthorData) GetChanged() bool {
 	if x != nil {
-		return x.Article
+		return x.Changed
 	}
-	return nil
+	return false
 }
 
-type DeleteArticleResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Data          *DeleteArticleData     `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+type ListFollowingResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    *ListFollowingData     `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Structured error details (google.rpc.ErrorInfo, BadRequest, RetryInfo, PreconditionFailure)
+	Details       []*anypb.Any `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteArticleResponse) Reset() {
-	*x = DeleteArticleResponse{}
-	mi := &file_article_service_proto_msgTypes[14]
+func (x *ListFollowingResponse) Reset() {
+	*x = ListFollowingResponse{}
+	mi := &file_article_service_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteArticleResponse) String() string {
+func (x *ListFollowingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteArticleResponse) ProtoMessage() {}
+func (*ListFollowingResponse) ProtoMessage() {}
 
-func (x *DeleteArticleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_article_service_proto_msgTypes[14]
+func (x *ListFollowingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -848,54 +8343,62 @@ func (x *DeleteArticleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteArticleResponse.ProtoReflect.Descriptor instead.
-func (*DeleteArticleResponse) Descriptor() ([]byte, []int) {
-	return file_article_service_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ListFollowingResponse.ProtoReflect.Descriptor instead.
+func (*ListFollowingResponse) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{127}
 }
 
-func (x *DeleteArticleResponse) GetCode() string {
+func (x *ListFollowingResponse) GetCode() string {
 	if x != nil {
 		return x.Code
 	}
 	return ""
 }
 
-func (x *DeleteArticleResponse) GetMessage() string {
+func (x *ListFollowingResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *DeleteArticleResponse) GetData() *DeleteArticleData {
+func (x *ListFollowingResponse) GetData() *ListFollowingData {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type DeleteArticleData struct {
+func (x *ListFollowingResponse) GetDetails() []*anypb.Any {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type ListFollowingData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Follows       []*Follow              `protobuf:"bytes,1,rep,name=follows,proto3" json:"follows,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteArticleData) Reset() {
-	*x = DeleteArticleData{}
-	mi := &file_article_service_proto_msgTypes[15]
+func (x *ListFollowingData) Reset() {
+	*x = ListFollowingData{}
+	mi := &file_article_service_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteArticleData) String() string {
+func (x *ListFollowingData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteArticleData) ProtoMessage() {}
+func (*ListFollowingData) ProtoMessage() {}
 
-func (x *DeleteArticleData) ProtoReflect() protoreflect.Message {
-	mi := &file_article_service_proto_msgTypes[15]
+func (x *ListFollowingData) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -906,42 +8409,51 @@ func (x *DeleteArticleData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteArticleData.ProtoReflect.Descriptor instead.
-func (*DeleteArticleData) Descriptor() ([]byte, []int) {
-	return file_article_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ListFollowingData.ProtoReflect.Descriptor instead.
+func (*ListFollowingData) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *DeleteArticleData) GetSuccess() bool {
+func (x *ListFollowingData) GetFollows() []*Follow {
 	if x != nil {
-		return x.Success
+		return x.Follows
 	}
-	return false
+	return nil
 }
 
-type ListArticlesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Data          *ListArticlesData      `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+func (x *ListFollowingData) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetFeedResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    *GetFeedData           `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Structured error details (google.rpc.ErrorInfo, BadRequest, RetryInfo, PreconditionFailure)
+	Details       []*anypb.Any `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListArticlesResponse) Reset() {
-	*x = ListArticlesResponse{}
-	mi := &file_article_service_proto_msgTypes[16]
+func (x *GetFeedResponse) Reset() {
+	*x = GetFeedResponse{}
+	mi := &file_article_service_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListArticlesResponse) String() string {
+func (x *GetFeedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListArticlesResponse) ProtoMessage() {}
+func (*GetFeedResponse) ProtoMessage() {}
 
-func (x *ListArticlesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_article_service_proto_msgTypes[16]
+func (x *GetFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -952,57 +8464,62 @@ func (x *ListArticlesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListArticlesResponse.ProtoReflect.Descriptor instead.
-func (*ListArticlesResponse) Descriptor() ([]byte, []int) {
-	return file_article_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use GetFeedResponse.ProtoReflect.Descriptor instead.
+func (*GetFeedResponse) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{129}
 }
 
-func (x *ListArticlesResponse) GetCode() string {
+func (x *GetFeedResponse) GetCode() string {
 	if x != nil {
 		return x.Code
 	}
 	return ""
 }
 
-func (x *ListArticlesResponse) GetMessage() string {
+func (x *GetFeedResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *ListArticlesResponse) GetData() *ListArticlesData {
+func (x *GetFeedResponse) GetData() *GetFeedData {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type ListArticlesData struct {
+func (x *GetFeedResponse) GetDetails() []*anypb.Any {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type GetFeedData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Articles      []*ArticleWithUser     `protobuf:"bytes,1,rep,name=articles,proto3" json:"articles,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	TotalPages    int32                  `protobuf:"varint,4,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	Articles      []*ArticleWithUser     `protobuf:"bytes,1,rep,name=articles,proto3" json:"articles,omitempty"`                                  // Newest first
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListArticlesData) Reset() {
-	*x = ListArticlesData{}
-	mi := &file_article_service_proto_msgTypes[17]
+func (x *GetFeedData) Reset() {
+	*x = GetFeedData{}
+	mi := &file_article_service_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListArticlesData) String() string {
+func (x *GetFeedData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListArticlesData) ProtoMessage() {}
+func (*GetFeedData) ProtoMessage() {}
 
-func (x *ListArticlesData) ProtoReflect() protoreflect.Message {
-	mi := &file_article_service_proto_msgTypes[17]
+func (x *GetFeedData) ProtoReflect() protoreflect.Message {
+	mi := &file_article_service_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1013,122 +8530,726 @@ func (x *ListArticlesData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListArticlesData.ProtoReflect.Descriptor instead.
-func (*ListArticlesData) Descriptor() ([]byte, []int) {
-	return file_article_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use GetFeedData.ProtoReflect.Descriptor instead.
+func (*GetFeedData) Descriptor() ([]byte, []int) {
+	return file_article_service_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *ListArticlesData) GetArticles() []*ArticleWithUser {
+func (x *GetFeedData) GetArticles() []*ArticleWithUser {
 	if x != nil {
 		return x.Articles
 	}
 	return nil
 }
 
-func (x *ListArticlesData) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *ListArticlesData) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *ListArticlesData) GetTotalPages() int32 {
+func (x *GetFeedData) GetNextPageToken() string {
 	if x != nil {
-		return x.TotalPages
+		return x.NextPageToken
 	}
-	return 0
+	return ""
 }
 
 var File_article_service_proto protoreflect.FileDescriptor
 
 const file_article_service_proto_rawDesc = "" +
 	"\n" +
-	"\x15article_service.proto\x12\aarticle\"~\n" +
+	"\x15article_service.proto\x12\aarticle\x1a\x19google/protobuf/any.proto\x1a\x1egoogle/protobuf/duration.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x80\x02\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05email\x18\x03 \x01(\tR\x05email\x12\x1d\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12!\n" +
 	"\n" +
-	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"created_at\x18\x04 \x01(\tB\x02\x18\x01R\tcreatedAt\x12!\n" +
 	"\n" +
-	"updated_at\x18\x05 \x01(\tR\tupdatedAt\"\xa0\x01\n" +
+	"updated_at\x18\x05 \x01(\tB\x02\x18\x01R\tupdatedAt\x12;\n" +
+	"\vcreate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\"\x9b\x05\n" +
 	"\aArticle\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
 	"\acontent\x18\x03 \x01(\tR\acontent\x12\x17\n" +
-	"\auser_id\x18\x04 \x01(\x05R\x06userId\x12\x1d\n" +
+	"\auser_id\x18\x04 \x01(\x05R\x06userId\x12!\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tB\x02\x18\x01R\tcreatedAt\x12!\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\tB\x02\x18\x01R\tupdatedAt\x12=\n" +
+	"\x0econtent_format\x18\a \x01(\x0e2\x16.article.ContentFormatR\rcontentFormat\x12!\n" +
+	"\fcontent_html\x18\b \x01(\tR\vcontentHtml\x12\x18\n" +
+	"\aexcerpt\x18\t \x01(\tR\aexcerpt\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"word_count\x18\n" +
+	" \x01(\x05R\twordCount\x120\n" +
+	"\x14reading_time_minutes\x18\v \x01(\x05R\x12readingTimeMinutes\x12;\n" +
+	"\vcreate_time\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\x12#\n" +
+	"\rcomment_count\x18\x0e \x01(\x05R\fcommentCount\x12%\n" +
+	"\x0ereaction_count\x18\x0f \x01(\x05R\rreactionCount\x12?\n" +
+	"\x0freaction_counts\x18\x10 \x03(\v2\x16.article.ReactionCountR\x0ereactionCounts\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\tR\tupdatedAt\"`\n" +
+	"view_count\x18\x11 \x01(\x03R\tviewCount\"P\n" +
+	"\rReactionCount\x12)\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x15.article.ReactionTypeR\x04type\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"`\n" +
 	"\x0fArticleWithUser\x12*\n" +
 	"\aarticle\x18\x01 \x01(\v2\x10.article.ArticleR\aarticle\x12!\n" +
-	"\x04user\x18\x02 \x01(\v2\r.article.UserR\x04user\"_\n" +
+	"\x04user\x18\x02 \x01(\v2\r.article.UserR\x04user\"\x9e\x01\n" +
 	"\x14CreateArticleRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x18\n" +
 	"\acontent\x18\x02 \x01(\tR\acontent\x12\x17\n" +
-	"\auser_id\x18\x03 \x01(\x05R\x06userId\"#\n" +
+	"\auser_id\x18\x03 \x01(\x05R\x06userId\x12=\n" +
+	"\x0econtent_format\x18\x04 \x01(\x0e2\x16.article.ContentFormatR\rcontentFormat\"\x86\x01\n" +
 	"\x11GetArticleRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"V\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12(\n" +
+	"\x04view\x18\x02 \x01(\x0e2\x14.article.ArticleViewR\x04view\x127\n" +
+	"\tread_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\"\xea\x01\n" +
 	"\x14UpdateArticleRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
-	"\acontent\x18\x03 \x01(\tR\acontent\"&\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12B\n" +
+	"\x0econtent_format\x18\x04 \x01(\x0e2\x16.article.ContentFormatH\x00R\rcontentFormat\x88\x01\x01\x12;\n" +
+	"\vupdate_mask\x18\x05 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMaskB\x11\n" +
+	"\x0f_content_format\"&\n" +
 	"\x14DeleteArticleRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"l\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x81\x02\n" +
 	"\x13ListArticlesRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1f\n" +
 	"\vpage_number\x18\x02 \x01(\x05R\n" +
 	"pageNumber\x12\x17\n" +
-	"\auser_id\x18\x03 \x01(\x05R\x06userId\"u\n" +
+	"\auser_id\x18\x03 \x01(\x05R\x06userId\x12(\n" +
+	"\x04view\x18\x04 \x01(\x0e2\x14.article.ArticleViewR\x04view\x127\n" +
+	"\tread_mask\x18\x05 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\x120\n" +
+	"\border_by\x18\x06 \x01(\x0e2\x15.article.ArticleOrderR\aorderBy\"\xbe\x02\n" +
+	"\x15ExportArticlesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12?\n" +
+	"\rcreated_after\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12A\n" +
+	"\x0ecreated_before\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBefore\x12%\n" +
+	"\x0einclude_author\x18\x04 \x01(\bR\rincludeAuthor\x12(\n" +
+	"\x04view\x18\x05 \x01(\x0e2\x14.article.ArticleViewR\x04view\x127\n" +
+	"\tread_mask\x18\x06 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\"\xab\x02\n" +
+	"\x0fImportedArticle\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12=\n" +
+	"\x0econtent_format\x18\x03 \x01(\x0e2\x16.article.ContentFormatR\rcontentFormat\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\x05R\x06userId\x12;\n" +
+	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\x12\x16\n" +
+	"\x06source\x18\a \x01(\tR\x06source\"d\n" +
+	"\x15ImportArticlesRequest\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\x122\n" +
+	"\aarticle\x18\x02 \x01(\v2\x18.article.ImportedArticleR\aarticle\"Q\n" +
+	"\vImportError\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xda\x01\n" +
+	"\x16ImportArticlesResponse\x12\x1a\n" +
+	"\breceived\x18\x01 \x01(\x05R\breceived\x12\x1a\n" +
+	"\bimported\x18\x02 \x01(\x05R\bimported\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\x12,\n" +
+	"\x06errors\x18\x05 \x03(\v2\x14.article.ImportErrorR\x06errors\x12)\n" +
+	"\x10errors_truncated\x18\x06 \x01(\bR\x0ferrorsTruncated\"\x95\x02\n" +
+	"\fArticleEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x02 \x01(\x05R\tarticleId\x12.\n" +
+	"\x04time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x123\n" +
+	"\acreated\x18\x04 \x01(\v2\x17.article.ArticleCreatedH\x00R\acreated\x123\n" +
+	"\aupdated\x18\x05 \x01(\v2\x17.article.ArticleUpdatedH\x00R\aupdated\x123\n" +
+	"\adeleted\x18\x06 \x01(\v2\x17.article.ArticleDeletedH\x00R\adeletedB\a\n" +
+	"\x05event\"<\n" +
+	"\x0eArticleCreated\x12*\n" +
+	"\aarticle\x18\x01 \x01(\v2\x10.article.ArticleR\aarticle\"<\n" +
+	"\x0eArticleUpdated\x12*\n" +
+	"\aarticle\x18\x01 \x01(\v2\x10.article.ArticleR\aarticle\"<\n" +
+	"\x0eArticleDeleted\x12*\n" +
+	"\aarticle\x18\x01 \x01(\v2\x10.article.ArticleR\aarticle\"\xbd\x01\n" +
+	"\x14WatchArticlesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1f\n" +
+	"\vevent_types\x18\x02 \x03(\tR\n" +
+	"eventTypes\x12!\n" +
+	"\fresume_token\x18\x03 \x01(\tR\vresumeToken\x12H\n" +
+	"\x12heartbeat_interval\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x11heartbeatInterval\"\xa5\x01\n" +
+	"\x15WatchArticlesResponse\x12!\n" +
+	"\fresume_token\x18\x01 \x01(\tR\vresumeToken\x12-\n" +
+	"\x05event\x18\x02 \x01(\v2\x15.article.ArticleEventH\x00R\x05event\x122\n" +
+	"\theartbeat\x18\x03 \x01(\v2\x12.article.HeartbeatH\x00R\theartbeatB\x06\n" +
+	"\x04item\";\n" +
+	"\tHeartbeat\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\"\xbf\x01\n" +
+	"\aWebhook\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x1f\n" +
+	"\vevent_types\x18\x04 \x03(\tR\n" +
+	"eventTypes\x12\x1b\n" +
+	"\tauthor_id\x18\x05 \x01(\x05R\bauthorId\x12;\n" +
+	"\vcreate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\"~\n" +
+	"\x14CreateWebhookRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\x12\x1f\n" +
+	"\vevent_types\x18\x03 \x03(\tR\n" +
+	"eventTypes\x12\x1b\n" +
+	"\tauthor_id\x18\x04 \x01(\x05R\bauthorId\"\x15\n" +
+	"\x13ListWebhooksRequest\"&\n" +
+	"\x14DeleteWebhookRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x98\x01\n" +
+	"\x0eWebhookAttempt\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x1f\n" +
+	"\vstatus_code\x18\x02 \x01(\x05R\n" +
+	"statusCode\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12\x1f\n" +
+	"\vduration_ms\x18\x04 \x01(\x05R\n" +
+	"durationMs\"\x8e\x04\n" +
+	"\x0fWebhookDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x02 \x01(\x05R\twebhookId\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\x03R\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x04 \x01(\tR\teventType\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x05 \x01(\x05R\tarticleId\x126\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x1e.article.WebhookDeliveryStatusR\x06status\x12\x1a\n" +
+	"\battempts\x18\a \x01(\x05R\battempts\x12F\n" +
+	"\x11next_attempt_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x0fnextAttemptTime\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\t \x01(\tR\tlastError\x12;\n" +
+	"\vcreate_time\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12A\n" +
+	"\x0edelivered_time\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\rdeliveredTime\x128\n" +
+	"\vattempt_log\x18\f \x03(\v2\x17.article.WebhookAttemptR\n" +
+	"attemptLog\"\xb3\x01\n" +
+	"\x1cListWebhookDeliveriesRequest\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\x05R\twebhookId\x126\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1e.article.WebhookDeliveryStatusR\x06status\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1f\n" +
+	"\vpage_number\x18\x04 \x01(\x05R\n" +
+	"pageNumber\"-\n" +
+	"\x1bRetryWebhookDeliveryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xce\x03\n" +
+	"\aComment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x02 \x01(\x05R\tarticleId\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\x05R\bparentId\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\x05R\x06userId\x12\x18\n" +
+	"\acontent\x18\x05 \x01(\tR\acontent\x12\x16\n" +
+	"\x06hidden\x18\x06 \x01(\bR\x06hidden\x12;\n" +
+	"\vcreate_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\x12!\n" +
+	"\x04user\x18\t \x01(\v2\r.article.UserR\x04user\x12*\n" +
+	"\areplies\x18\n" +
+	" \x03(\v2\x10.article.CommentR\areplies\x12\x14\n" +
+	"\x05depth\x18\v \x01(\x05R\x05depth\x12\x1f\n" +
+	"\vreply_count\x18\f \x01(\x05R\n" +
+	"replyCount\x12,\n" +
+	"\x12replies_page_token\x18\r \x01(\tR\x10repliesPageToken\"l\n" +
+	"\x14CreateCommentRequest\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x01 \x01(\x05R\tarticleId\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\x05R\bparentId\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\"\x9a\x01\n" +
+	"\x13ListCommentsRequest\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x01 \x01(\x05R\tarticleId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1f\n" +
+	"\vpage_number\x18\x03 \x01(\x05R\n" +
+	"pageNumber\x12&\n" +
+	"\x0freply_page_size\x18\x04 \x01(\x05R\rreplyPageSize\"o\n" +
+	"\x12ListRepliesRequest\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\x01 \x01(\x05R\tcommentId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"@\n" +
+	"\x14UpdateCommentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\"&\n" +
+	"\x14DeleteCommentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"@\n" +
+	"\x16ModerateCommentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x16\n" +
+	"\x06hidden\x18\x02 \x01(\bR\x06hidden\"\xcd\x01\n" +
+	"\bReaction\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x01 \x01(\x05R\tarticleId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12)\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x15.article.ReactionTypeR\x04type\x12;\n" +
+	"\vcreate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12!\n" +
+	"\x04user\x18\x05 \x01(\v2\r.article.UserR\x04user\"\xa1\x01\n" +
+	"\x0fReactionSummary\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x01 \x01(\x05R\tarticleId\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12.\n" +
+	"\x06counts\x18\x03 \x03(\v2\x16.article.ReactionCountR\x06counts\x12)\n" +
+	"\x04mine\x18\x04 \x03(\x0e2\x15.article.ReactionTypeR\x04mine\"^\n" +
+	"\x12AddReactionRequest\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x01 \x01(\x05R\tarticleId\x12)\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x15.article.ReactionTypeR\x04type\"a\n" +
+	"\x15RemoveReactionRequest\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x01 \x01(\x05R\tarticleId\x12)\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x15.article.ReactionTypeR\x04type\"\x9e\x01\n" +
+	"\x14ListReactionsRequest\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x01 \x01(\x05R\tarticleId\x12)\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x15.article.ReactionTypeR\x04type\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1f\n" +
+	"\vpage_number\x18\x04 \x01(\x05R\n" +
+	"pageNumber\"\xa7\x02\n" +
+	"\n" +
+	"Collection\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\x05R\bposition\x12\x1f\n" +
+	"\vshare_token\x18\x05 \x01(\tR\n" +
+	"shareToken\x12%\n" +
+	"\x0ebookmark_count\x18\x06 \x01(\x05R\rbookmarkCount\x12;\n" +
+	"\vcreate_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\"\xe8\x01\n" +
+	"\bBookmark\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x03 \x01(\x05R\tarticleId\x12#\n" +
+	"\rcollection_id\x18\x04 \x01(\x05R\fcollectionId\x12;\n" +
+	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x122\n" +
+	"\aarticle\x18\x06 \x01(\v2\x18.article.ArticleWithUserR\aarticle\"\\\n" +
+	"\x16BookmarkArticleRequest\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x01 \x01(\x05R\tarticleId\x12#\n" +
+	"\rcollection_id\x18\x02 \x01(\x05R\fcollectionId\"^\n" +
+	"\x18UnbookmarkArticleRequest\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x01 \x01(\x05R\tarticleId\x12#\n" +
+	"\rcollection_id\x18\x02 \x01(\x05R\fcollectionId\"w\n" +
+	"\x14ListBookmarksRequest\x12#\n" +
+	"\rcollection_id\x18\x01 \x01(\x05R\fcollectionId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"-\n" +
+	"\x17CreateCollectionRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\x18\n" +
+	"\x16ListCollectionsRequest\"=\n" +
+	"\x17RenameCollectionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"-\n" +
+	"\x19ReorderCollectionsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x05R\x03ids\"@\n" +
+	"\x16ShareCollectionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x16\n" +
+	"\x06shared\x18\x02 \x01(\bR\x06shared\")\n" +
+	"\x17DeleteCollectionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"y\n" +
+	"\x1aGetSharedCollectionRequest\x12\x1f\n" +
+	"\vshare_token\x18\x01 \x01(\tR\n" +
+	"shareToken\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\xc7\x01\n" +
+	"\x1bListTrendingArticlesRequest\x12/\n" +
+	"\x06window\x18\x01 \x01(\x0e2\x17.article.TrendingWindowR\x06window\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12(\n" +
+	"\x04view\x18\x03 \x01(\x0e2\x14.article.ArticleViewR\x04view\x127\n" +
+	"\tread_mask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\"\xb3\x01\n" +
+	"\x19GetRelatedArticlesRequest\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x01 \x01(\x05R\tarticleId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12(\n" +
+	"\x04view\x18\x03 \x01(\x0e2\x14.article.ArticleViewR\x04view\x127\n" +
+	"\tread_mask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\"\x8b\x01\n" +
+	"\x06Follow\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\x05R\bauthorId\x12;\n" +
+	"\vcreate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\"2\n" +
+	"\x13FollowAuthorRequest\x12\x1b\n" +
+	"\tauthor_id\x18\x01 \x01(\x05R\bauthorId\"4\n" +
+	"\x15UnfollowAuthorRequest\x12\x1b\n" +
+	"\tauthor_id\x18\x01 \x01(\x05R\bauthorId\"R\n" +
+	"\x14ListFollowingRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"\xaf\x01\n" +
+	"\x0eGetFeedRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12(\n" +
+	"\x04view\x18\x03 \x01(\x0e2\x14.article.ArticleViewR\x04view\x127\n" +
+	"\tread_mask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\"~\n" +
+	"\x0fTrendingArticle\x122\n" +
+	"\aarticle\x18\x01 \x01(\v2\x18.article.ArticleWithUserR\aarticle\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12!\n" +
+	"\fwindow_views\x18\x03 \x01(\x03R\vwindowViews\"\xa5\x01\n" +
 	"\x15CreateArticleResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12.\n" +
-	"\x04data\x18\x03 \x01(\v2\x1a.article.CreateArticleDataR\x04data\"?\n" +
+	"\x04data\x18\x03 \x01(\v2\x1a.article.CreateArticleDataR\x04data\x12.\n" +
+	"\adetails\x18\x04 \x03(\v2\x14.google.protobuf.AnyR\adetails\"?\n" +
 	"\x11CreateArticleData\x12*\n" +
-	"\aarticle\x18\x01 \x01(\v2\x10.article.ArticleR\aarticle\"o\n" +
+	"\aarticle\x18\x01 \x01(\v2\x10.article.ArticleR\aarticle\"\x9f\x01\n" +
 	"\x12GetArticleResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12+\n" +
-	"\x04data\x18\x03 \x01(\v2\x17.article.GetArticleDataR\x04data\"D\n" +
+	"\x04data\x18\x03 \x01(\v2\x17.article.GetArticleDataR\x04data\x12.\n" +
+	"\adetails\x18\x04 \x03(\v2\x14.google.protobuf.AnyR\adetails\"D\n" +
 	"\x0eGetArticleData\x122\n" +
-	"\aarticle\x18\x01 \x01(\v2\x18.article.ArticleWithUserR\aarticle\"u\n" +
+	"\aarticle\x18\x01 \x01(\v2\x18.article.ArticleWithUserR\aarticle\"\xa5\x01\n" +
 	"\x15UpdateArticleResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12.\n" +
-	"\x04data\x18\x03 \x01(\v2\x1a.article.UpdateArticleDataR\x04data\"?\n" +
+	"\x04data\x18\x03 \x01(\v2\x1a.article.UpdateArticleDataR\x04data\x12.\n" +
+	"\adetails\x18\x04 \x03(\v2\x14.google.protobuf.AnyR\adetails\"?\n" +
 	"\x11UpdateArticleData\x12*\n" +
-	"\aarticle\x18\x01 \x01(\v2\x10.article.ArticleR\aarticle\"u\n" +
+	"\aarticle\x18\x01 \x01(\v2\x10.article.ArticleR\aarticle\"\xa5\x01\n" +
 	"\x15DeleteArticleResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12.\n" +
-	"\x04data\x18\x03 \x01(\v2\x1a.article.DeleteArticleDataR\x04data\"-\n" +
+	"\x04data\x18\x03 \x01(\v2\x1a.article.DeleteArticleDataR\x04data\x12.\n" +
+	"\adetails\x18\x04 \x03(\v2\x14.google.protobuf.AnyR\adetails\"-\n" +
 	"\x11DeleteArticleData\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"s\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xa3\x01\n" +
 	"\x14ListArticlesResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12-\n" +
-	"\x04data\x18\x03 \x01(\v2\x19.article.ListArticlesDataR\x04data\"\x93\x01\n" +
+	"\x04data\x18\x03 \x01(\v2\x19.article.ListArticlesDataR\x04data\x12.\n" +
+	"\adetails\x18\x04 \x03(\v2\x14.google.protobuf.AnyR\adetails\"\x93\x01\n" +
 	"\x10ListArticlesData\x124\n" +
 	"\barticles\x18\x01 \x03(\v2\x18.article.ArticleWithUserR\barticles\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1f\n" +
 	"\vtotal_pages\x18\x04 \x01(\x05R\n" +
-	"totalPages2\x94\x03\n" +
+	"totalPages\"\xa5\x01\n" +
+	"\x15CreateWebhookResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12.\n" +
+	"\x04data\x18\x03 \x01(\v2\x1a.article.CreateWebhookDataR\x04data\x12.\n" +
+	"\adetails\x18\x04 \x03(\v2\x14.google.protobuf.AnyR\adetails\"?\n" +
+	"\x11CreateWebhookData\x12*\n" +
+	"\awebhook\x18\x01 \x01(\v2\x10.article.WebhookR\awebhook\"\xa3\x01\n" +
+	"\x14ListWebhooksResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12-\n" +
+	"\x04data\x18\x03 \x01(\v2\x19.article.ListWebhooksDataR\x04data\x12.\n" +
+	"\adetails\x18\x04 \x03(\v2\x14.google.protobuf.AnyR\adetails\"@\n" +
+	"\x10ListWebhooksData\x12,\n" +
+	"\bwebhooks\x18\x01 \x03(\v2\x10.article.WebhookR\bwebhooks\"\xa5\x01\n" +
+	"\x15DeleteWebhookResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12.\n" +
+	"\x04data\x18\x03 \x01(\v2\x1a.article.DeleteWebhookDataR\x04data\x12.\n" +
+	"\adetails\x18\x04 \x03(\v2\x14.google.protobuf.AnyR\adetails\"-\n" +
+	"\x11DeleteWebhookData\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xb5\x01\n" +
+	"\x1dListWebhookDeliveriesResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x126\n" +
+	"\x04data\x18\x03 \x01(\v2\".article.ListWebhookDeliveriesDataR\x04data\x12.\n" +
+	"\adetails\x18\x04 \x03(\v2\x14.google.protobuf.AnyR\adetails\"\xa0\x01\n" +
+	"\x19ListWebhookDeliveriesData\x128\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x18.article.WebhookDeliveryR\n" +
+	"deliveries\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1f\n" +
+	"\vtotal_pages\x18\x04 \x01(\x05R\n" +
+	"totalPages\"\xb3\x01\n" +
+	"\x1cRetryWebhookDeliveryResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x125\n" +
+	"\x04data\x18\x03 \x01(\v2!.article.RetryWebhookDeliveryDataR\x04data\x12.\n" +
+	"\adetails\x18\x04 \x03(\v2\x14.google.protobuf.AnyR\adetails\"P\n" +
+	"\x18RetryWebhookDeliveryData\x124\n" +
+	"\bdelivery\x18\x01 \x01(\v2\x18.article.WebhookDeliveryR\bdelivery\"\xa5\x01\n" +
+	"\x15CreateCommentResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12.\n" +
+	"\x04data\x18\x03 \x01(\v2\x1a.article.CreateCommentDataR\x04data\x12.\n" +
+	"\adetails\x18\x04 \x03(\v2\x14.google.protobuf.AnyR\adetails\"?\n" +
+	"\x11CreateCommentData\x12*\n" +
+	"\acomment\x18\x01 \x01(\v2\x10.article.CommentR\acomment\"\xa3\x01\n" +
+	"\x14ListCommentsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12-\n" +
+	"\x04data\x18\x03 \x01(\v2\x19.article.ListCommentsDataR\x04data\x12.\n" +
+	"\adetails\x18\x04 \x03(\v2\x14.google.protobuf.AnyR\adetails\"\x8b\x01\n" +
+	"\x10ListCommentsData\x12,\n" +
+	"\bcomments\x18\x01 \x03(\v2\x10.article.CommentR\bcomments\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1f\n" +
+	"\vtotal_pages\x18\x04 \x01(\x05R\n" +
+	"totalPages\"\xa1\x01\n" +
+	"\x13ListRepliesResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12,\n" +
+	"\x04data\x18\x03 \x01(\v2\x18.article.ListRepliesDataR\x04data\x12.\n" +
+	"\adetails\x18\x04 \x03(\v2\x14.google.protobuf.AnyR\adetails\"e\n" +
+	"\x0fListRepliesData\x12*\n" +
+	"\areplies\x18\x01 \x03(\v2\x10.article.CommentR\areplies\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xa5\x01\n" +
+	"\x15UpdateCommentResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12.\n" +
+	"\x04data\x18\x03 \x01(\v2\x1a.article.UpdateCommentDataR\x04data\x12.\n" +
+	"\adetails\x18\x04 \x03(\v2\x14.google.protobuf.AnyR\adetails\"?\n" +
+	"\x11UpdateCommentData\x12*\n" +
+	"\acomment\x18\x01 \x01(\v2\x10.article.CommentR\acomment\"\xa5\x01\n" +
+	"\x15DeleteCommentResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12.\n" +
+	"\x04data\x18\x03 \x01(\v2\x1a.article.DeleteCommentDataR\x04data\x12.\n" +
+	"\adetails\x18\x04 \x03(\v2\x14.google.protobuf.AnyR\adetails\"-\n" +
+	"\x11DeleteCommentData\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xa9\x01\n" +
+	"\x17ModerateCommentResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x120\n" +
+	"\x04data\x18\x03 \x01(\v2\x1c.article.ModerateCommentDataR\x04data\x12.\n" +
+	"\adetails\x18\x04 \x03(\v2\x14.google.protobuf.AnyR\adetails\"A\n" +
+	"\x13ModerateCommentData\x12*\n" +
+	"\acomment\x18\x01 \x01(\v2\x10.article.CommentR\acomment\"\xa1\x01\n" +
+	"\x13AddReactionResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12,\n" +
+	"\x04data\x18\x03 \x01(\v2\x18.article.AddReactionDataR\x04data\x12.\n" +
+	"\adetails\x18\x04 \x03(\v2\x14.google.protobuf.AnyR\adetails\"_\n" +
+	"\x0fAddReactionData\x122\n" +
+	"\asummary\x18\x01 \x01(\v2\x18.article.ReactionSummaryR\asummary\x12\x18\n" +
+	"\achanged\x18\x02 \x01(\bR\achanged\"\xa7\x01\n" +
+	"\x16RemoveReactionResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12/\n" +
+	"\x04data\x18\x03 \x01(\v2\x1b.article.RemoveReactionDataR\x04data\x12.\n" +
+	"\adetails\x18\x04 \x03(\v2\x14.google.protobuf.AnyR\adetails\"b\n" +
+	"\x12RemoveReactionData\x122\n" +
+	"\asummary\x18\x01 \x01(\v2\x18.article.ReactionSummaryR\asummary\x12\x18\n" +
+	"\achanged\x18\x02 \x01(\bR\achanged\"\xa5\x01\n" +
+	"\x15ListReactionsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12.\n" +
+	"\x04data\x18\x03 \x01(\v2\x1a.article.ListReactionsDataR\x04data\x12.\n" +
+	"\adetails\x18\x04 \x03(\v2\x14.google.protobuf.AnyR\adetails\"\xc3\x01\n" +
+	"\x11ListReactionsData\x12/\n" +
+	"\treactions\x18\x01 \x03(\v2\x11.article.ReactionR\treactions\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1f\n" +
+	"\vtotal_pages\x18\x04 \x01(\x05R\n" +
+	"totalPages\x122\n" +
+	"\asummary\x18\x05 \x01(\v2\x18.article.ReactionSummaryR\asummary\"\xa9\x01\n" +
+	"\x17BookmarkArticleResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x120\n" +
+	"\x04data\x18\x03 \x01(\v2\x1c.article.BookmarkArticleDataR\x04data\x12.\n" +
+	"\adetails\x18\x04 \x03(\v2\x14.google.protobuf.AnyR\adetails\"^\n" +
+	"\x13BookmarkArticleData\x12-\n" +
+	"\bbookmark\x18\x01 \x01(\v2\x11.article.BookmarkR\bbookmark\x12\x18\n" +
+	"\achanged\x18\x02 \x01(\bR\achanged\"\xad\x01\n" +
+	"\x19UnbookmarkArticleResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x122\n" +
+	"\x04data\x18\x03 \x01(\v2\x1e.article.UnbookmarkArticleDataR\x04data\x12.\n" +
+	"\adetails\x18\x04 \x03(\v2\x14.google.protobuf.AnyR\adetails\"1\n" +
+	"\x15UnbookmarkArticleData\x12\x18\n" +
+	"\achanged\x18\x01 \x01(\bR\achanged\"\xa5\x01\n" +
+	"\x15ListBookmarksResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12.\n" +
+	"\x04data\x18\x03 \x01(\v2\x1a.article.ListBookmarksDataR\x04data\x12.\n" +
+	"\adetails\x18\x04 \x03(\v2\x14.google.protobuf.AnyR\adetails\"l\n" +
+	"\x11ListBookmarksData\x12/\n" +
+	"\tbookmarks\x18\x01 \x03(\v2\x11.article.BookmarkR\tbookmarks\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xab\x01\n" +
+	"\x18CreateCollectionResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x121\n" +
+	"\x04data\x18\x03 \x01(\v2\x1d.article.CreateCollectionDataR\x04data\x12.\n" +
+	"\adetails\x18\x04 \x03(\v2\x14.google.protobuf.AnyR\adetails\"K\n" +
+	"\x14CreateCollectionData\x123\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\v2\x13.article.CollectionR\n" +
+	"collection\"\xa9\x01\n" +
+	"\x17ListCollectionsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x120\n" +
+	"\x04data\x18\x03 \x01(\v2\x1c.article.ListCollectionsDataR\x04data\x12.\n" +
+	"\adetails\x18\x04 \x03(\v2\x14.google.protobuf.AnyR\adetails\"L\n" +
+	"\x13ListCollectionsData\x125\n" +
+	"\vcollections\x18\x01 \x03(\v2\x13.article.CollectionR\vcollections\"\xab\x01\n" +
+	"\x18RenameCollectionResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x121\n" +
+	"\x04data\x18\x03 \x01(\v2\x1d.article.RenameCollectionDataR\x04data\x12.\n" +
+	"\adetails\x18\x04 \x03(\v2\x14.google.protobuf.AnyR\adetails\"K\n" +
+	"\x14RenameCollectionData\x123\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\v2\x13.article.CollectionR\n" +
+	"collection\"\xaf\x01\n" +
+	"\x1aReorderCollectionsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x123\n" +
+	"\x04data\x18\x03 \x01(\v2\x1f.article.ReorderCollectionsDataR\x04data\x12.\n" +
+	"\adetails\x18\x04 \x03(\v2\x14.google.protobuf.AnyR\adetails\"O\n" +
+	"\x16ReorderCollectionsData\x125\n" +
+	"\vcollections\x18\x01 \x03(\v2\x13.article.CollectionR\vcollections\"\xa9\x01\n" +
+	"\x17ShareCollectionResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x120\n" +
+	"\x04data\x18\x03 \x01(\v2\x1c.article.ShareCollectionDataR\x04data\x12.\n" +
+	"\adetails\x18\x04 \x03(\v2\x14.google.protobuf.AnyR\adetails\"J\n" +
+	"\x13ShareCollectionData\x123\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\v2\x13.article.CollectionR\n" +
+	"collection\"\xab\x01\n" +
+	"\x18DeleteCollectionResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x121\n" +
+	"\x04data\x18\x03 \x01(\v2\x1d.article.DeleteCollectionDataR\x04data\x12.\n" +
+	"\adetails\x18\x04 \x03(\v2\x14.google.protobuf.AnyR\adetails\"0\n" +
+	"\x14DeleteCollectionData\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xb1\x01\n" +
+	"\x1bGetSharedCollectionResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x124\n" +
+	"\x04data\x18\x03 \x01(\v2 .article.GetSharedCollectionDataR\x04data\x12.\n" +
+	"\adetails\x18\x04 \x03(\v2\x14.google.protobuf.AnyR\adetails\"\xa7\x01\n" +
+	"\x17GetSharedCollectionData\x123\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\v2\x13.article.CollectionR\n" +
+	"collection\x12/\n" +
+	"\tbookmarks\x18\x02 \x03(\v2\x11.article.BookmarkR\tbookmarks\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\xb3\x01\n" +
+	"\x1cListTrendingArticlesResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x125\n" +
+	"\x04data\x18\x03 \x01(\v2!.article.ListTrendingArticlesDataR\x04data\x12.\n" +
+	"\adetails\x18\x04 \x03(\v2\x14.google.protobuf.AnyR\adetails\"P\n" +
+	"\x18ListTrendingArticlesData\x124\n" +
+	"\barticles\x18\x01 \x03(\v2\x18.article.TrendingArticleR\barticles\"\xaf\x01\n" +
+	"\x1aGetRelatedArticlesResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x123\n" +
+	"\x04data\x18\x03 \x01(\v2\x1f.article.GetRelatedArticlesDataR\x04data\x12.\n" +
+	"\adetails\x18\x04 \x03(\v2\x14.google.protobuf.AnyR\adetails\"N\n" +
+	"\x16GetRelatedArticlesData\x124\n" +
+	"\barticles\x18\x01 \x03(\v2\x18.article.ArticleWithUserR\barticles\"\xa3\x01\n" +
+	"\x14FollowAuthorResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12-\n" +
+	"\x04data\x18\x03 \x01(\v2\x19.article.FollowAuthorDataR\x04data\x12.\n" +
+	"\adetails\x18\x04 \x03(\v2\x14.google.protobuf.AnyR\adetails\"U\n" +
+	"\x10FollowAuthorData\x12'\n" +
+	"\x06follow\x18\x01 \x01(\v2\x0f.article.FollowR\x06follow\x12\x18\n" +
+	"\achanged\x18\x02 \x01(\bR\achanged\"\xa7\x01\n" +
+	"\x16UnfollowAuthorResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12/\n" +
+	"\x04data\x18\x03 \x01(\v2\x1b.article.UnfollowAuthorDataR\x04data\x12.\n" +
+	"\adetails\x18\x04 \x03(\v2\x14.google.protobuf.AnyR\adetails\".\n" +
+	"\x12UnfollowAuthorData\x12\x18\n" +
+	"\achanged\x18\x01 \x01(\bR\achanged\"\xa5\x01\n" +
+	"\x15ListFollowingResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12.\n" +
+	"\x04data\x18\x03 \x01(\v2\x1a.article.ListFollowingDataR\x04data\x12.\n" +
+	"\adetails\x18\x04 \x03(\v2\x14.google.protobuf.AnyR\adetails\"f\n" +
+	"\x11ListFollowingData\x12)\n" +
+	"\afollows\x18\x01 \x03(\v2\x0f.article.FollowR\afollows\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x99\x01\n" +
+	"\x0fGetFeedResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12(\n" +
+	"\x04data\x18\x03 \x01(\v2\x14.article.GetFeedDataR\x04data\x12.\n" +
+	"\adetails\x18\x04 \x03(\v2\x14.google.protobuf.AnyR\adetails\"k\n" +
+	"\vGetFeedData\x124\n" +
+	"\barticles\x18\x01 \x03(\v2\x18.article.ArticleWithUserR\barticles\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken*_\n" +
+	"\rContentFormat\x12\x18\n" +
+	"\x14CONTENT_FORMAT_PLAIN\x10\x00\x12\x1b\n" +
+	"\x17CONTENT_FORMAT_MARKDOWN\x10\x01\x12\x17\n" +
+	"\x13CONTENT_FORMAT_HTML\x10\x02*Z\n" +
+	"\vArticleView\x12\x1c\n" +
+	"\x18ARTICLE_VIEW_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12ARTICLE_VIEW_BASIC\x10\x01\x12\x15\n" +
+	"\x11ARTICLE_VIEW_FULL\x10\x02*\xb1\x01\n" +
+	"\fReactionType\x12\x1d\n" +
+	"\x19REACTION_TYPE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12REACTION_TYPE_LIKE\x10\x01\x12\x16\n" +
+	"\x12REACTION_TYPE_LOVE\x10\x02\x12\x1c\n" +
+	"\x18REACTION_TYPE_INSIGHTFUL\x10\x03\x12\x17\n" +
+	"\x13REACTION_TYPE_FUNNY\x10\x04\x12\x1b\n" +
+	"\x17REACTION_TYPE_CELEBRATE\x10\x05*b\n" +
+	"\fArticleOrder\x12\x1d\n" +
+	"\x19ARTICLE_ORDER_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14ARTICLE_ORDER_NEWEST\x10\x01\x12\x19\n" +
+	"\x15ARTICLE_ORDER_POPULAR\x10\x02*d\n" +
+	"\x0eTrendingWindow\x12\x1f\n" +
+	"\x1bTRENDING_WINDOW_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13TRENDING_WINDOW_DAY\x10\x01\x12\x18\n" +
+	"\x14TRENDING_WINDOW_WEEK\x10\x02*\xae\x01\n" +
+	"\x15WebhookDeliveryStatus\x12'\n" +
+	"#WEBHOOK_DELIVERY_STATUS_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fWEBHOOK_DELIVERY_STATUS_PENDING\x10\x01\x12%\n" +
+	"!WEBHOOK_DELIVERY_STATUS_DELIVERED\x10\x02\x12 \n" +
+	"\x1cWEBHOOK_DELIVERY_STATUS_DEAD\x10\x032\xf9\x18\n" +
 	"\x0eArticleService\x12N\n" +
 	"\rCreateArticle\x12\x1d.article.CreateArticleRequest\x1a\x1e.article.CreateArticleResponse\x12E\n" +
 	"\n" +
 	"GetArticle\x12\x1a.article.GetArticleRequest\x1a\x1b.article.GetArticleResponse\x12N\n" +
 	"\rUpdateArticle\x12\x1d.article.UpdateArticleRequest\x1a\x1e.article.UpdateArticleResponse\x12N\n" +
 	"\rDeleteArticle\x12\x1d.article.DeleteArticleRequest\x1a\x1e.article.DeleteArticleResponse\x12K\n" +
-	"\fListArticles\x12\x1c.article.ListArticlesRequest\x1a\x1d.article.ListArticlesResponseB\x17Z\x15article-service/protob\x06proto3"
+	"\fListArticles\x12\x1c.article.ListArticlesRequest\x1a\x1d.article.ListArticlesResponse\x12L\n" +
+	"\x0eExportArticles\x12\x1e.article.ExportArticlesRequest\x1a\x18.article.ArticleWithUser0\x01\x12S\n" +
+	"\x0eImportArticles\x12\x1e.article.ImportArticlesRequest\x1a\x1f.article.ImportArticlesResponse(\x01\x12P\n" +
+	"\rWatchArticles\x12\x1d.article.WatchArticlesRequest\x1a\x1e.article.WatchArticlesResponse0\x01\x12N\n" +
+	"\rCreateWebhook\x12\x1d.article.CreateWebhookRequest\x1a\x1e.article.CreateWebhookResponse\x12K\n" +
+	"\fListWebhooks\x12\x1c.article.ListWebhooksRequest\x1a\x1d.article.ListWebhooksResponse\x12N\n" +
+	"\rDeleteWebhook\x12\x1d.article.DeleteWebhookRequest\x1a\x1e.article.DeleteWebhookResponse\x12f\n" +
+	"\x15ListWebhookDeliveries\x12%.article.ListWebhookDeliveriesRequest\x1a&.article.ListWebhookDeliveriesResponse\x12c\n" +
+	"\x14RetryWebhookDelivery\x12$.article.RetryWebhookDeliveryRequest\x1a%.article.RetryWebhookDeliveryResponse\x12N\n" +
+	"\rCreateComment\x12\x1d.article.CreateCommentRequest\x1a\x1e.article.CreateCommentResponse\x12K\n" +
+	"\fListComments\x12\x1c.article.ListCommentsRequest\x1a\x1d.article.ListCommentsResponse\x12H\n" +
+	"\vListReplies\x12\x1b.article.ListRepliesRequest\x1a\x1c.article.ListRepliesResponse\x12N\n" +
+	"\rUpdateComment\x12\x1d.article.UpdateCommentRequest\x1a\x1e.article.UpdateCommentResponse\x12N\n" +
+	"\rDeleteComment\x12\x1d.article.DeleteCommentRequest\x1a\x1e.article.DeleteCommentResponse\x12T\n" +
+	"\x0fModerateComment\x12\x1f.article.ModerateCommentRequest\x1a .article.ModerateCommentResponse\x12H\n" +
+	"\vAddReaction\x12\x1b.article.AddReactionRequest\x1a\x1c.article.AddReactionResponse\x12Q\n" +
+	"\x0eRemoveReaction\x12\x1e.article.RemoveReactionRequest\x1a\x1f.article.RemoveReactionResponse\x12N\n" +
+	"\rListReactions\x12\x1d.article.ListReactionsRequest\x1a\x1e.article.ListReactionsResponse\x12T\n" +
+	"\x0fBookmarkArticle\x12\x1f.article.BookmarkArticleRequest\x1a .article.BookmarkArticleResponse\x12Z\n" +
+	"\x11UnbookmarkArticle\x12!.article.UnbookmarkArticleRequest\x1a\".article.UnbookmarkArticleResponse\x12N\n" +
+	"\rListBookmarks\x12\x1d.article.ListBookmarksRequest\x1a\x1e.article.ListBookmarksResponse\x12W\n" +
+	"\x10CreateCollection\x12 .article.CreateCollectionRequest\x1a!.article.CreateCollectionResponse\x12T\n" +
+	"\x0fListCollections\x12\x1f.article.ListCollectionsRequest\x1a .article.ListCollectionsResponse\x12W\n" +
+	"\x10RenameCollection\x12 .article.RenameCollectionRequest\x1a!.article.RenameCollectionResponse\x12]\n" +
+	"\x12ReorderCollections\x12\".article.ReorderCollectionsRequest\x1a#.article.ReorderCollectionsResponse\x12T\n" +
+	"\x0fShareCollection\x12\x1f.article.ShareCollectionRequest\x1a .article.ShareCollectionResponse\x12W\n" +
+	"\x10DeleteCollection\x12 .article.DeleteCollectionRequest\x1a!.article.DeleteCollectionResponse\x12`\n" +
+	"\x13GetSharedCollection\x12#.article.GetSharedCollectionRequest\x1a$.article.GetSharedCollectionResponse\x12c\n" +
+	"\x14ListTrendingArticles\x12$.article.ListTrendingArticlesRequest\x1a%.article.ListTrendingArticlesResponse\x12]\n" +
+	"\x12GetRelatedArticles\x12\".article.GetRelatedArticlesRequest\x1a#.article.GetRelatedArticlesResponse\x12K\n" +
+	"\fFollowAuthor\x12\x1c.article.FollowAuthorRequest\x1a\x1d.article.FollowAuthorResponse\x12Q\n" +
+	"\x0eUnfollowAuthor\x12\x1e.article.UnfollowAuthorRequest\x1a\x1f.article.UnfollowAuthorResponse\x12N\n" +
+	"\rListFollowing\x12\x1d.article.ListFollowingRequest\x1a\x1e.article.ListFollowingResponse\x12<\n" +
+	"\aGetFeed\x12\x17.article.GetFeedRequest\x1a\x18.article.GetFeedResponseB\x17Z\x15article-service/protob\x06proto3"
 
 var (
 	file_article_service_proto_rawDescOnce sync.Once
@@ -1142,54 +9263,404 @@ func file_article_service_proto_rawDescGZIP() []byte {
 	return file_article_service_proto_rawDescData
 }
 
-var file_article_service_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_article_service_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_article_service_proto_msgTypes = make([]protoimpl.MessageInfo, 131)
 var file_article_service_proto_goTypes = []any{
-	(*User)(nil),                  // 0: article.User
-	(*Article)(nil),               // 1: article.Article
-	(*ArticleWithUser)(nil),       // 2: article.ArticleWithUser
-	(*CreateArticleRequest)(nil),  // 3: article.CreateArticleRequest
-	(*GetArticleRequest)(nil),     // 4: article.GetArticleRequest
-	(*UpdateArticleRequest)(nil),  // 5: article.UpdateArticleRequest
-	(*DeleteArticleRequest)(nil),  // 6: article.DeleteArticleRequest
-	(*ListArticlesRequest)(nil),   // 7: article.ListArticlesRequest
-	(*CreateArticleResponse)(nil), // 8: article.CreateArticleResponse
-	(*CreateArticleData)(nil),     // 9: article.CreateArticleData
-	(*GetArticleResponse)(nil),    // 10: article.GetArticleResponse
-	(*GetArticleData)(nil),        // 11: article.GetArticleData
-	(*UpdateArticleResponse)(nil), // 12: article.UpdateArticleResponse
-	(*UpdateArticleData)(nil),     // 13: article.UpdateArticleData
-	(*DeleteArticleResponse)(nil), // 14: article.DeleteArticleResponse
-	(*DeleteArticleData)(nil),     // 15: article.DeleteArticleData
-	(*ListArticlesResponse)(nil),  // 16: article.ListArticlesResponse
-	(*ListArticlesData)(nil),      // 17: article.ListArticlesData
+	(ContentFormat)(0),                    // 0: article.ContentFormat
+	(ArticleView)(0),                      // 1: article.ArticleView
+	(ReactionType)(0),                     // 2: article.ReactionType
+	(ArticleOrder)(0),                     // 3: article.ArticleOrder
+	(TrendingWindow)(0),                   // 4: article.TrendingWindow
+	(WebhookDeliveryStatus)(0),            // 5: article.WebhookDeliveryStatus
+	(*User)(nil),                          // 6: article.User
+	(*Article)(nil),                       // 7: article.Article
+	(*ReactionCount)(nil),                 // 8: article.ReactionCount
+	(*ArticleWithUser)(nil),               // 9: article.ArticleWithUser
+	(*CreateArticleRequest)(nil),          // 10: article.CreateArticleRequest
+	(*GetArticleRequest)(nil),             // 11: article.GetArticleRequest
+	(*UpdateArticleRequest)(nil),          // 12: article.UpdateArticleRequest
+	(*DeleteArticleRequest)(nil),          // 13: article.DeleteArticleRequest
+	(*ListArticlesRequest)(nil),           // 14: article.ListArticlesRequest
+	(*ExportArticlesRequest)(nil),         // 15: article.ExportArticlesRequest
+	(*ImportedArticle)(nil),               // 16: article.ImportedArticle
+	(*ImportArticlesRequest)(nil),         // 17: article.ImportArticlesRequest
+	(*ImportError)(nil),                   // 18: article.ImportError
+	(*ImportArticlesResponse)(nil),        // 19: article.ImportArticlesResponse
+	(*ArticleEvent)(nil),                  // 20: article.ArticleEvent
+	(*ArticleCreated)(nil),                // 21: article.ArticleCreated
+	(*ArticleUpdated)(nil),                // 22: article.ArticleUpdated
+	(*ArticleDeleted)(nil),                // 23: article.ArticleDeleted
+	(*WatchArticlesRequest)(nil),          // 24: article.WatchArticlesRequest
+	(*WatchArticlesResponse)(nil),         // 25: article.WatchArticlesResponse
+	(*Heartbeat)(nil),                     // 26: article.Heartbeat
+	(*Webhook)(nil),                       // 27: article.Webhook
+	(*CreateWebhookRequest)(nil),          // 28: article.CreateWebhookRequest
+	(*ListWebhooksRequest)(nil),           // 29: article.ListWebhooksRequest
+	(*DeleteWebhookRequest)(nil),          // 30: article.DeleteWebhookRequest
+	(*WebhookAttempt)(nil),                // 31: article.WebhookAttempt
+	(*WebhookDelivery)(nil),               // 32: article.WebhookDelivery
+	(*ListWebhookDeliveriesRequest)(nil),  // 33: article.ListWebhookDeliveriesRequest
+	(*RetryWebhookDeliveryRequest)(nil),   // 34: article.RetryWebhookDeliveryRequest
+	(*Comment)(nil),                       // 35: article.Comment
+	(*CreateCommentRequest)(nil),          // 36: article.CreateCommentRequest
+	(*ListCommentsRequest)(nil),           // 37: article.ListCommentsRequest
+	(*ListRepliesRequest)(nil),            // 38: article.ListRepliesRequest
+	(*UpdateCommentRequest)(nil),          // 39: article.UpdateCommentRequest
+	(*DeleteCommentRequest)(nil),          // 40: article.DeleteCommentRequest
+	(*ModerateCommentRequest)(nil),        // 41: article.ModerateCommentRequest
+	(*Reaction)(nil),                      // 42: article.Reaction
+	(*ReactionSummary)(nil),               // 43: article.ReactionSummary
+	(*AddReactionRequest)(nil),            // 44: article.AddReactionRequest
+	(*RemoveReactionRequest)(nil),         // 45: article.RemoveReactionRequest
+	(*ListReactionsRequest)(nil),          // 46: article.ListReactionsRequest
+	(*Collection)(nil),                    // 47: article.Collection
+	(*Bookmark)(nil),                      // 48: article.Bookmark
+	(*BookmarkArticleRequest)(nil),        // 49: article.BookmarkArticleRequest
+	(*UnbookmarkArticleRequest)(nil),      // 50: article.UnbookmarkArticleRequest
+	(*ListBookmarksRequest)(nil),          // 51: article.ListBookmarksRequest
+	(*CreateCollectionRequest)(nil),       // 52: article.CreateCollectionRequest
+	(*ListCollectionsRequest)(nil),        // 53: article.ListCollectionsRequest
+	(*RenameCollectionRequest)(nil),       // 54: article.RenameCollectionRequest
+	(*ReorderCollectionsRequest)(nil),     // 55: article.ReorderCollectionsRequest
+	(*ShareCollectionRequest)(nil),        // 56: article.ShareCollectionRequest
+	(*DeleteCollectionRequest)(nil),       // 57: article.DeleteCollectionRequest
+	(*GetSharedCollectionRequest)(nil),    // 58: article.GetSharedCollectionRequest
+	(*ListTrendingArticlesRequest)(nil),   // 59: article.ListTrendingArticlesRequest
+	(*GetRelatedArticlesRequest)(nil),     // 60: article.GetRelatedArticlesRequest
+	(*Follow)(nil),                        // 61: article.Follow
+	(*FollowAuthorRequest)(nil),           // 62: article.FollowAuthorRequest
+	(*UnfollowAuthorRequest)(nil),         // 63: article.UnfollowAuthorRequest
+	(*ListFollowingRequest)(nil),          // 64: article.ListFollowingRequest
+	(*GetFeedRequest)(nil),                // 65: article.GetFeedRequest
+	(*TrendingArticle)(nil),               // 66: article.TrendingArticle
+	(*CreateArticleResponse)(nil),         // 67: article.CreateArticleResponse
+	(*CreateArticleData)(nil),             // 68: article.CreateArticleData
+	(*GetArticleResponse)(nil),            // 69: article.GetArticleResponse
+	(*GetArticleData)(nil),                // 70: article.GetArticleData
+	(*UpdateArticleResponse)(nil),         // 71: article.UpdateArticleResponse
+	(*UpdateArticleData)(nil),             // 72: article.UpdateArticleData
+	(*DeleteArticleResponse)(nil),         // 73: article.DeleteArticleResponse
+	(*DeleteArticleData)(nil),             // 74: article.DeleteArticleData
+	(*ListArticlesResponse)(nil),          // 75: article.ListArticlesResponse
+	(*ListArticlesData)(nil),              // 76: article.ListArticlesData
+	(*CreateWebhookResponse)(nil),         // 77: article.CreateWebhookResponse
+	(*CreateWebhookData)(nil),             // 78: article.CreateWebhookData
+	(*ListWebhooksResponse)(nil),          // 79: article.ListWebhooksResponse
+	(*ListWebhooksData)(nil),              // 80: article.ListWebhooksData
+	(*DeleteWebhookResponse)(nil),         // 81: article.DeleteWebhookResponse
+	(*DeleteWebhookData)(nil),             // 82: article.DeleteWebhookData
+	(*ListWebhookDeliveriesResponse)(nil), // 83: article.ListWebhookDeliveriesResponse
+	(*ListWebhookDeliveriesData)(nil),     // 84: article.ListWebhookDeliveriesData
+	(*RetryWebhookDeliveryResponse)(nil),  // 85: article.RetryWebhookDeliveryResponse
+	(*RetryWebhookDeliveryData)(nil),      // 86: article.RetryWebhookDeliveryData
+	(*CreateCommentResponse)(nil),         // 87: article.CreateCommentResponse
+	(*CreateCommentData)(nil),             // 88: article.CreateCommentData
+	(*ListCommentsResponse)(nil),          // 89: article.ListCommentsResponse
+	(*ListCommentsData)(nil),              // 90: article.ListCommentsData
+	(*ListRepliesResponse)(nil),           // 91: article.ListRepliesResponse
+	(*ListRepliesData)(nil),               // 92: article.ListRepliesData
+	(*UpdateCommentResponse)(nil),         // 93: article.UpdateCommentResponse
+	(*UpdateCommentData)(nil),             // 94: article.UpdateCommentData
+	(*DeleteCommentResponse)(nil),         // 95: article.DeleteCommentResponse
+	(*DeleteCommentData)(nil),             // 96: article.DeleteCommentData
+	(*ModerateCommentResponse)(nil),       // 97: article.ModerateCommentResponse
+	(*ModerateCommentData)(nil),           // 98: article.ModerateCommentData
+	(*AddReactionResponse)(nil),           // 99: article.AddReactionResponse
+	(*AddReactionData)(nil),               // 100: article.AddReactionData
+	(*RemoveReactionResponse)(nil),        // 101: article.RemoveReactionResponse
+	(*RemoveReactionData)(nil),            // 102: article.RemoveReactionData
+	(*ListReactionsResponse)(nil),         // 103: article.ListReactionsResponse
+	(*ListReactionsData)(nil),             // 104: article.ListReactionsData
+	(*BookmarkArticleResponse)(nil),       // 105: article.BookmarkArticleResponse
+	(*BookmarkArticleData)(nil),           // 106: article.BookmarkArticleData
+	(*UnbookmarkArticleResponse)(nil),     // 107: article.UnbookmarkArticleResponse
+	(*UnbookmarkArticleData)(nil),         // 108: article.UnbookmarkArticleData
+	(*ListBookmarksResponse)(nil),         // 109: article.ListBookmarksResponse
+	(*ListBookmarksData)(nil),             // 110: article.ListBookmarksData
+	(*CreateCollectionResponse)(nil),      // 111: article.CreateCollectionResponse
+	(*CreateCollectionData)(nil),          // 112: article.CreateCollectionData
+	(*ListCollectionsResponse)(nil),       // 113: article.ListCollectionsResponse
+	(*ListCollectionsData)(nil),           // 114: article.ListCollectionsData
+	(*RenameCollectionResponse)(nil),      // 115: article.RenameCollectionResponse
+	(*RenameCollectionData)(nil),          // 116: article.RenameCollectionData
+	(*ReorderCollectionsResponse)(nil),    // 117: article.ReorderCollectionsResponse
+	(*ReorderCollectionsData)(nil),        // 118: article.ReorderCollectionsData
+	(*ShareCollectionResponse)(nil),       // 119: article.ShareCollectionResponse
+	(*ShareCollectionData)(nil),           // 120: article.ShareCollectionData
+	(*DeleteCollectionResponse)(nil),      // 121: article.DeleteCollectionResponse
+	(*DeleteCollectionData)(nil),          // 122: article.DeleteCollectionData
+	(*GetSharedCollectionResponse)(nil),   // 123: article.GetSharedCollectionResponse
+	(*GetSharedCollectionData)(nil),       // 124: article.GetSharedCollectionData
+	(*ListTrendingArticlesResponse)(nil),  // 125: article.ListTrendingArticlesResponse
+	(*ListTrendingArticlesData)(nil),      // 126: article.ListTrendingArticlesData
+	(*GetRelatedArticlesResponse)(nil),    // 127: article.GetRelatedArticlesResponse
+	(*GetRelatedArticlesData)(nil),        // 128: article.GetRelatedArticlesData
+	(*FollowAuthorResponse)(nil),          // 129: article.FollowAuthorResponse
+	(*FollowAuthorData)(nil),              // 130: article.FollowAuthorData
+	(*UnfollowAuthorResponse)(nil),        // 131: article.UnfollowAuthorResponse
+	(*UnfollowAuthorData)(nil),            // 132: article.UnfollowAuthorData
+	(*ListFollowingResponse)(nil),         // 133: article.ListFollowingResponse
+	(*ListFollowingData)(nil),             // 134: article.ListFollowingData
+	(*GetFeedResponse)(nil),               // 135: article.GetFeedResponse
+	(*GetFeedData)(nil),                   // 136: article.GetFeedData
+	(*timestamppb.Timestamp)(nil),         // 137: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),         // 138: google.protobuf.FieldMask
+	(*durationpb.Duration)(nil),           // 139: google.protobuf.Duration
+	(*anypb.Any)(nil),                     // 140: google.protobuf.Any
 }
 var file_article_service_proto_depIdxs = []int32{
-	1,  // 0: article.ArticleWithUser.article:type_name -> article.Article
-	0,  // 1: article.ArticleWithUser.user:type_name -> article.User
-	9,  // 2: article.CreateArticleResponse.data:type_name -> article.CreateArticleData
-	1,  // 3: article.CreateArticleData.article:type_name -> article.Article
-	11, // 4: article.GetArticleResponse.data:type_name -> article.GetArticleData
-	2,  // 5: article.GetArticleData.article:type_name -> article.ArticleWithUser
-	13, // 6: article.UpdateArticleResponse.data:type_name -> article.UpdateArticleData
-	1,  // 7: article.UpdateArticleData.article:type_name -> article.Article
-	15, // 8: article.DeleteArticleResponse.data:type_name -> article.DeleteArticleData
-	17, // 9: article.ListArticlesResponse.data:type_name -> article.ListArticlesData
-	2,  // 10: article.ListArticlesData.articles:type_name -> article.ArticleWithUser
-	3,  // 11: article.ArticleService.CreateArticle:input_type -> article.CreateArticleRequest
-	4,  // 12: article.ArticleService.GetArticle:input_type -> article.GetArticleRequest
-	5,  // 13: article.ArticleService.UpdateArticle:input_type -> article.UpdateArticleRequest
-	6,  // 14: article.ArticleService.DeleteArticle:input_type -> article.DeleteArticleRequest
-	7,  // 15: article.ArticleService.ListArticles:input_type -> article.ListArticlesRequest
-	8,  // 16: article.ArticleService.CreateArticle:output_type -> article.CreateArticleResponse
-	10, // 17: article.ArticleService.GetArticle:output_type -> article.GetArticleResponse
-	12, // 18: article.ArticleService.UpdateArticle:output_type -> article.UpdateArticleResponse
-	14, // 19: article.ArticleService.DeleteArticle:output_type -> article.DeleteArticleResponse
-	16, // 20: article.ArticleService.ListArticles:output_type -> article.ListArticlesResponse
-	16, // [16:21] is the sub-list for method output_type
-	11, // [11:16] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	137, // 0: article.User.create_time:type_name -> google.protobuf.Timestamp
+	137, // 1: article.User.update_time:type_name -> google.protobuf.Timestamp
+	0,   // 2: article.Article.content_format:type_name -> article.ContentFormat
+	137, // 3: article.Article.create_time:type_name -> google.protobuf.Timestamp
+	137, // 4: article.Article.update_time:type_name -> google.protobuf.Timestamp
+	8,   // 5: article.Article.reaction_counts:type_name -> article.ReactionCount
+	2,   // 6: article.ReactionCount.type:type_name -> article.ReactionType
+	7,   // 7: article.ArticleWithUser.article:type_name -> article.Article
+	6,   // 8: article.ArticleWithUser.user:type_name -> article.User
+	0,   // 9: article.CreateArticleRequest.content_format:type_name -> article.ContentFormat
+	1,   // 10: article.GetArticleRequest.view:type_name -> article.ArticleView
+	138, // 11: article.GetArticleRequest.read_mask:type_name -> google.protobuf.FieldMask
+	0,   // 12: article.UpdateArticleRequest.content_format:type_name -> article.ContentFormat
+	138, // 13: article.UpdateArticleRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,   // 14: article.ListArticlesRequest.view:type_name -> article.ArticleView
+	138, // 15: article.ListArticlesRequest.read_mask:type_name -> google.protobuf.FieldMask
+	3,   // 16: article.ListArticlesRequest.order_by:type_name -> article.ArticleOrder
+	137, // 17: article.ExportArticlesRequest.created_after:type_name -> google.protobuf.Timestamp
+	137, // 18: article.ExportArticlesRequest.created_before:type_name -> google.protobuf.Timestamp
+	1,   // 19: article.ExportArticlesRequest.view:type_name -> article.ArticleView
+	138, // 20: article.ExportArticlesRequest.read_mask:type_name -> google.protobuf.FieldMask
+	0,   // 21: article.ImportedArticle.content_format:type_name -> article.ContentFormat
+	137, // 22: article.ImportedArticle.create_time:type_name -> google.protobuf.Timestamp
+	137, // 23: article.ImportedArticle.update_time:type_name -> google.protobuf.Timestamp
+	16,  // 24: article.ImportArticlesRequest.article:type_name -> article.ImportedArticle
+	18,  // 25: article.ImportArticlesResponse.errors:type_name -> article.ImportError
+	137, // 26: article.ArticleEvent.time:type_name -> google.protobuf.Timestamp
+	21,  // 27: article.ArticleEvent.created:type_name -> article.ArticleCreated
+	22,  // 28: article.ArticleEvent.updated:type_name -> article.ArticleUpdated
+	23,  // 29: article.ArticleEvent.deleted:type_name -> article.ArticleDeleted
+	7,   // 30: article.ArticleCreated.article:type_name -> article.Article
+	7,   // 31: article.ArticleUpdated.article:type_name -> article.Article
+	7,   // 32: article.ArticleDeleted.article:type_name -> article.Article
+	139, // 33: article.WatchArticlesRequest.heartbeat_interval:type_name -> google.protobuf.Duration
+	20,  // 34: article.WatchArticlesResponse.event:type_name -> article.ArticleEvent
+	26,  // 35: article.WatchArticlesResponse.heartbeat:type_name -> article.Heartbeat
+	137, // 36: article.Heartbeat.time:type_name -> google.protobuf.Timestamp
+	137, // 37: article.Webhook.create_time:type_name -> google.protobuf.Timestamp
+	137, // 38: article.WebhookAttempt.time:type_name -> google.protobuf.Timestamp
+	5,   // 39: article.WebhookDelivery.status:type_name -> article.WebhookDeliveryStatus
+	137, // 40: article.WebhookDelivery.next_attempt_time:type_name -> google.protobuf.Timestamp
+	137, // 41: article.WebhookDelivery.create_time:type_name -> google.protobuf.Timestamp
+	137, // 42: article.WebhookDelivery.delivered_time:type_name -> google.protobuf.Timestamp
+	31,  // 43: article.WebhookDelivery.attempt_log:type_name -> article.WebhookAttempt
+	5,   // 44: article.ListWebhookDeliveriesRequest.status:type_name -> article.WebhookDeliveryStatus
+	137, // 45: article.Comment.create_time:type_name -> google.protobuf.Timestamp
+	137, // 46: article.Comment.update_time:type_name -> google.protobuf.Timestamp
+	6,   // 47: article.Comment.user:type_name -> article.User
+	35,  // 48: article.Comment.replies:type_name -> article.Comment
+	2,   // 49: article.Reaction.type:type_name -> article.ReactionType
+	137, // 50: article.Reaction.create_time:type_name -> google.protobuf.Timestamp
+	6,   // 51: article.Reaction.user:type_name -> article.User
+	8,   // 52: article.ReactionSummary.counts:type_name -> article.ReactionCount
+	2,   // 53: article.ReactionSummary.mine:type_name -> article.ReactionType
+	2,   // 54: article.AddReactionRequest.type:type_name -> article.ReactionType
+	2,   // 55: article.RemoveReactionRequest.type:type_name -> article.ReactionType
+	2,   // 56: article.ListReactionsRequest.type:type_name -> article.ReactionType
+	137, // 57: article.Collection.create_time:type_name -> google.protobuf.Timestamp
+	137, // 58: article.Collection.update_time:type_name -> google.protobuf.Timestamp
+	137, // 59: article.Bookmark.create_time:type_name -> google.protobuf.Timestamp
+	9,   // 60: article.Bookmark.article:type_name -> article.ArticleWithUser
+	4,   // 61: article.ListTrendingArticlesRequest.window:type_name -> article.TrendingWindow
+	1,   // 62: article.ListTrendingArticlesRequest.view:type_name -> article.ArticleView
+	138, // 63: article.ListTrendingArticlesRequest.read_mask:type_name -> google.protobuf.FieldMask
+	1,   // 64: article.GetRelatedArticlesRequest.view:type_name -> article.ArticleView
+	138, // 65: article.GetRelatedArticlesRequest.read_mask:type_name -> google.protobuf.FieldMask
+	137, // 66: article.Follow.create_time:type_name -> google.protobuf.Timestamp
+	1,   // 67: article.GetFeedRequest.view:type_name -> article.ArticleView
+	138, // 68: article.GetFeedRequest.read_mask:type_name -> google.protobuf.FieldMask
+	9,   // 69: article.TrendingArticle.article:type_name -> article.ArticleWithUser
+	68,  // 70: article.CreateArticleResponse.data:type_name -> article.CreateArticleData
+	140, // 71: article.CreateArticleResponse.details:type_name -> google.protobuf.Any
+	7,   // 72: article.CreateArticleData.article:type_name -> article.Article
+	70,  // 73: article.GetArticleResponse.data:type_name -> article.GetArticleData
+	140, // 74: article.GetArticleResponse.details:type_name -> google.protobuf.Any
+	9,   // 75: article.GetArticleData.article:type_name -> article.ArticleWithUser
+	72,  // 76: article.UpdateArticleResponse.data:type_name -> article.UpdateArticleData
+	140, // 77: article.UpdateArticleResponse.details:type_name -> google.protobuf.Any
+	7,   // 78: article.UpdateArticleData.article:type_name -> article.Article
+	74,  // 79: article.DeleteArticleResponse.data:type_name -> article.DeleteArticleData
+	140, // 80: article.DeleteArticleResponse.details:type_name -> google.protobuf.Any
+	76,  // 81: article.ListArticlesResponse.data:type_name -> article.ListArticlesData
+	140, // 82: article.ListArticlesResponse.details:type_name -> google.protobuf.Any
+	9,   // 83: article.ListArticlesData.articles:type_name -> article.ArticleWithUser
+	78,  // 84: article.CreateWebhookResponse.data:type_name -> article.CreateWebhookData
+	140, // 85: article.CreateWebhookResponse.details:type_name -> google.protobuf.Any
+	27,  // 86: article.CreateWebhookData.webhook:type_name -> article.Webhook
+	80,  // 87: article.ListWebhooksResponse.data:type_name -> article.ListWebhooksData
+	140, // 88: article.ListWebhooksResponse.details:type_name -> google.protobuf.Any
+	27,  // 89: article.ListWebhooksData.webhooks:type_name -> article.Webhook
+	82,  // 90: article.DeleteWebhookResponse.data:type_name -> article.DeleteWebhookData
+	140, // 91: article.DeleteWebhookResponse.details:type_name -> google.protobuf.Any
+	84,  // 92: article.ListWebhookDeliveriesResponse.data:type_name -> article.ListWebhookDeliveriesData
+	140, // 93: article.ListWebhookDeliveriesResponse.details:type_name -> google.protobuf.Any
+	32,  // 94: article.ListWebhookDeliveriesData.deliveries:type_name -> article.WebhookDelivery
+	86,  // 95: article.RetryWebhookDeliveryResponse.data:type_name -> article.RetryWebhookDeliveryData
+	140, // 96: article.RetryWebhookDeliveryResponse.details:type_name -> google.protobuf.Any
+	32,  // 97: article.RetryWebhookDeliveryData.delivery:type_name -> article.WebhookDelivery
+	88,  // 98: article.CreateCommentResponse.data:type_name -> article.CreateCommentData
+	140, // 99: article.CreateCommentResponse.details:type_name -> google.protobuf.Any
+	35,  // 100: article.CreateCommentData.comment:type_name -> article.Comment
+	90,  // 101: article.ListCommentsResponse.data:type_name -> article.ListCommentsData
+	140, // 102: article.ListCommentsResponse.details:type_name -> google.protobuf.Any
+	35,  // 103: article.ListCommentsData.comments:type_name -> article.Comment
+	92,  // 104: article.ListRepliesResponse.data:type_name -> article.ListRepliesData
+	140, // 105: article.ListRepliesResponse.details:type_name -> google.protobuf.Any
+	35,  // 106: article.ListRepliesData.replies:type_name -> article.Comment
+	94,  // 107: article.UpdateCommentResponse.data:type_name -> article.UpdateCommentData
+	140, // 108: article.UpdateCommentResponse.details:type_name -> google.protobuf.Any
+	35,  // 109: article.UpdateCommentData.comment:type_name -> article.Comment
+	96,  // 110: article.DeleteCommentResponse.data:type_name -> article.DeleteCommentData
+	140, // 111: article.DeleteCommentResponse.details:type_name -> google.protobuf.Any
+	98,  // 112: article.ModerateCommentResponse.data:type_name -> article.ModerateCommentData
+	140, // 113: article.ModerateCommentResponse.details:type_name -> google.protobuf.Any
+	35,  // 114: article.ModerateCommentData.comment:type_name -> article.Comment
+	100, // 115: article.AddReactionResponse.data:type_name -> article.AddReactionData
+	140, // 116: article.AddReactionResponse.details:type_name -> google.protobuf.Any
+	43,  // 117: article.AddReactionData.summary:type_name -> article.ReactionSummary
+	102, // 118: article.RemoveReactionResponse.data:type_name -> article.RemoveReactionData
+	140, // 119: article.RemoveReactionResponse.details:type_name -> google.protobuf.Any
+	43,  // 120: article.RemoveReactionData.summary:type_name -> article.ReactionSummary
+	104, // 121: article.ListReactionsResponse.data:type_name -> article.ListReactionsData
+	140, // 122: article.ListReactionsResponse.details:type_name -> google.protobuf.Any
+	42,  // 123: article.ListReactionsData.reactions:type_name -> article.Reaction
+	43,  // 124: article.ListReactionsData.summary:type_name -> article.ReactionSummary
+	106, // 125: article.BookmarkArticleResponse.data:type_name -> article.BookmarkArticleData
+	140, // 126: article.BookmarkArticleResponse.details:type_name -> google.protobuf.Any
+	48,  // 127: article.BookmarkArticleData.bookmark:type_name -> article.Bookmark
+	108, // 128: article.UnbookmarkArticleResponse.data:type_name -> article.UnbookmarkArticleData
+	140, // 129: article.UnbookmarkArticleResponse.details:type_name -> google.protobuf.Any
+	110, // 130: article.ListBookmarksResponse.data:type_name -> article.ListBookmarksData
+	140, // 131: article.ListBookmarksResponse.details:type_name -> google.protobuf.Any
+	48,  // 132: article.ListBookmarksData.bookmarks:type_name -> article.Bookmark
+	112, // 133: article.CreateCollectionResponse.data:type_name -> article.CreateCollectionData
+	140, // 134: article.CreateCollectionResponse.details:type_name -> google.protobuf.Any
+	47,  // 135: article.CreateCollectionData.collection:type_name -> article.Collection
+	114, // 136: article.ListCollectionsResponse.data:type_name -> article.ListCollectionsData
+	140, // 137: article.ListCollectionsResponse.details:type_name -> google.protobuf.Any
+	47,  // 138: article.ListCollectionsData.collections:type_name -> article.Collection
+	116, // 139: article.RenameCollectionResponse.data:type_name -> article.RenameCollectionData
+	140, // 140: article.RenameCollectionResponse.details:type_name -> google.protobuf.Any
+	47,  // 141: article.RenameCollectionData.collection:type_name -> article.Collection
+	118, // 142: article.ReorderCollectionsResponse.data:type_name -> article.ReorderCollectionsData
+	140, // 143: article.ReorderCollectionsResponse.details:type_name -> google.protobuf.Any
+	47,  // 144: article.ReorderCollectionsData.collections:type_name -> article.Collection
+	120, // 145: article.ShareCollectionResponse.data:type_name -> article.ShareCollectionData
+	140, // 146: article.ShareCollectionResponse.details:type_name -> google.protobuf.Any
+	47,  // 147: article.ShareCollectionData.collection:type_name -> article.Collection
+	122, // 148: article.DeleteCollectionResponse.data:type_name -> article.DeleteCollectionData
+	140, // 149: article.DeleteCollectionResponse.details:type_name -> google.protobuf.Any
+	124, // 150: article.GetSharedCollectionResponse.data:type_name -> article.GetSharedCollectionData
+	140, // 151: article.GetSharedCollectionResponse.details:type_name -> google.protobuf.Any
+	47,  // 152: article.GetSharedCollectionData.collection:type_name -> article.Collection
+	48,  // 153: article.GetSharedCollectionData.bookmarks:type_name -> article.Bookmark
+	126, // 154: article.ListTrendingArticlesResponse.data:type_name -> article.ListTrendingArticlesData
+	140, // 155: article.ListTrendingArticlesResponse.details:type_name -> google.protobuf.Any
+	66,  // 156: article.ListTrendingArticlesData.articles:type_name -> article.TrendingArticle
+	128, // 157: article.GetRelatedArticlesResponse.data:type_name -> article.GetRelatedArticlesData
+	140, // 158: article.GetRelatedArticlesResponse.details:type_name -> google.protobuf.Any
+	9,   // 159: article.GetRelatedArticlesData.articles:type_name -> article.ArticleWithUser
+	130, // 160: article.FollowAuthorResponse.data:type_name -> article.FollowAuthorData
+	140, // 161: article.FollowAuthorResponse.details:type_name -> google.protobuf.Any
+	61,  // 162: article.FollowAuthorData.follow:type_name -> article.Follow
+	132, // 163: article.UnfollowAuthorResponse.data:type_name -> article.UnfollowAuthorData
+	140, // 164: article.UnfollowAuthorResponse.details:type_name -> google.protobuf.Any
+	134, // 165: article.ListFollowingResponse.data:type_name -> article.ListFollowingData
+	140, // 166: article.ListFollowingResponse.details:type_name -> google.protobuf.Any
+	61,  // 167: article.ListFollowingData.follows:type_name -> article.Follow
+	136, // 168: article.GetFeedResponse.data:type_name -> article.GetFeedData
+	140, // 169: article.GetFeedResponse.details:type_name -> google.protobuf.Any
+	9,   // 170: article.GetFeedData.articles:type_name -> article.ArticleWithUser
+	10,  // 171: article.ArticleService.CreateArticle:input_type -> article.CreateArticleRequest
+	11,  // 172: article.ArticleService.GetArticle:input_type -> article.GetArticleRequest
+	12,  // 173: article.ArticleService.UpdateArticle:input_type -> article.UpdateArticleRequest
+	13,  // 174: article.ArticleService.DeleteArticle:input_type -> article.DeleteArticleRequest
+	14,  // 175: article.ArticleService.ListArticles:input_type -> article.ListArticlesRequest
+	15,  // 176: article.ArticleService.ExportArticles:input_type -> article.ExportArticlesRequest
+	17,  // 177: article.ArticleService.ImportArticles:input_type -> article.ImportArticlesRequest
+	24,  // 178: article.ArticleService.WatchArticles:input_type -> article.WatchArticlesRequest
+	28,  // 179: article.ArticleService.CreateWebhook:input_type -> article.CreateWebhookRequest
+	29,  // 180: article.ArticleService.ListWebhooks:input_type -> article.ListWebhooksRequest
+	30,  // 181: article.ArticleService.DeleteWebhook:input_type -> article.DeleteWebhookRequest
+	33,  // 182: article.ArticleService.ListWebhookDeliveries:input_type -> article.ListWebhookDeliveriesRequest
+	34,  // 183: article.ArticleService.RetryWebhookDelivery:input_type -> article.RetryWebhookDeliveryRequest
+	36,  // 184: article.ArticleService.CreateComment:input_type -> article.CreateCommentRequest
+	37,  // 185: article.ArticleService.ListComments:input_type -> article.ListCommentsRequest
+	38,  // 186: article.ArticleService.ListReplies:input_type -> article.ListRepliesRequest
+	39,  // 187: article.ArticleService.UpdateComment:input_type -> article.UpdateCommentRequest
+	40,  // 188: article.ArticleService.DeleteComment:input_type -> article.DeleteCommentRequest
+	41,  // 189: article.ArticleService.ModerateComment:input_type -> article.ModerateCommentRequest
+	44,  // 190: article.ArticleService.AddReaction:input_type -> article.AddReactionRequest
+	45,  // 191: article.ArticleService.RemoveReaction:input_type -> article.RemoveReactionRequest
+	46,  // 192: article.ArticleService.ListReactions:input_type -> article.ListReactionsRequest
+	49,  // 193: article.ArticleService.BookmarkArticle:input_type -> article.BookmarkArticleRequest
+	50,  // 194: article.ArticleService.UnbookmarkArticle:input_type -> article.UnbookmarkArticleRequest
+	51,  // 195: article.ArticleService.ListBookmarks:input_type -> article.ListBookmarksRequest
+	52,  // 196: article.ArticleService.CreateCollection:input_type -> article.CreateCollectionRequest
+	53,  // 197: article.ArticleService.ListCollections:input_type -> article.ListCollectionsRequest
+	54,  // 198: article.ArticleService.RenameCollection:input_type -> article.RenameCollectionRequest
+	55,  // 199: article.ArticleService.ReorderCollections:input_type -> article.ReorderCollectionsRequest
+	56,  // 200: article.ArticleService.ShareCollection:input_type -> article.ShareCollectionRequest
+	57,  // 201: article.ArticleService.DeleteCollection:input_type -> article.DeleteCollectionRequest
+	58,  // 202: article.ArticleService.GetSharedCollection:input_type -> article.GetSharedCollectionRequest
+	59,  // 203: article.ArticleService.ListTrendingArticles:input_type -> article.ListTrendingArticlesRequest
+	60,  // 204: article.ArticleService.GetRelatedArticles:input_type -> article.GetRelatedArticlesRequest
+	62,  // 205: article.ArticleService.FollowAuthor:input_type -> article.FollowAuthorRequest
+	63,  // 206: article.ArticleService.UnfollowAuthor:input_type -> article.UnfollowAuthorRequest
+	64,  // 207: article.ArticleService.ListFollowing:input_type -> article.ListFollowingRequest
+	65,  // 208: article.ArticleService.GetFeed:input_type -> article.GetFeedRequest
+	67,  // 209: article.ArticleService.CreateArticle:output_type -> article.CreateArticleResponse
+	69,  // 210: article.ArticleService.GetArticle:output_type -> article.GetArticleResponse
+	71,  // 211: article.ArticleService.UpdateArticle:output_type -> article.UpdateArticleResponse
+	73,  // 212: article.ArticleService.DeleteArticle:output_type -> article.DeleteArticleResponse
+	75,  // 213: article.ArticleService.ListArticles:output_type -> article.ListArticlesResponse
+	9,   // 214: article.ArticleService.ExportArticles:output_type -> article.ArticleWithUser
+	19,  // 215: article.ArticleService.ImportArticles:output_type -> article.ImportArticlesResponse
+	25,  // 216: article.ArticleService.WatchArticles:output_type -> article.WatchArticlesResponse
+	77,  // 217: article.ArticleService.CreateWebhook:output_type -> article.CreateWebhookResponse
+	79,  // 218: article.ArticleService.ListWebhooks:output_type -> article.ListWebhooksResponse
+	81,  // 219: article.ArticleService.DeleteWebhook:output_type -> article.DeleteWebhookResponse
+	83,  // 220: article.ArticleService.ListWebhookDeliveries:output_type -> article.ListWebhookDeliveriesResponse
+	85,  // 221: article.ArticleService.RetryWebhookDelivery:output_type -> article.RetryWebhookDeliveryResponse
+	87,  // 222: article.ArticleService.CreateComment:output_type -> article.CreateCommentResponse
+	89,  // 223: article.ArticleService.ListComments:output_type -> article.ListCommentsResponse
+	91,  // 224: article.ArticleService.ListReplies:output_type -> article.ListRepliesResponse
+	93,  // 225: article.ArticleService.UpdateComment:output_type -> article.UpdateCommentResponse
+	95,  // 226: article.ArticleService.DeleteComment:output_type -> article.DeleteCommentResponse
+	97,  // 227: article.ArticleService.ModerateComment:output_type -> article.ModerateCommentResponse
+	99,  // 228: article.ArticleService.AddReaction:output_type -> article.AddReactionResponse
+	101, // 229: article.ArticleService.RemoveReaction:output_type -> article.RemoveReactionResponse
+	103, // 230: article.ArticleService.ListReactions:output_type -> article.ListReactionsResponse
+	105, // 231: article.ArticleService.BookmarkArticle:output_type -> article.BookmarkArticleResponse
+	107, // 232: article.ArticleService.UnbookmarkArticle:output_type -> article.UnbookmarkArticleResponse
+	109, // 233: article.ArticleService.ListBookmarks:output_type -> article.ListBookmarksResponse
+	111, // 234: article.ArticleService.CreateCollection:output_type -> article.CreateCollectionResponse
+	113, // 235: article.ArticleService.ListCollections:output_type -> article.ListCollectionsResponse
+	115, // 236: article.ArticleService.RenameCollection:output_type -> article.RenameCollectionResponse
+	117, // 237: article.ArticleService.ReorderCollections:output_type -> article.ReorderCollectionsResponse
+	119, // 238: article.ArticleService.ShareCollection:output_type -> article.ShareCollectionResponse
+	121, // 239: article.ArticleService.DeleteCollection:output_type -> article.DeleteCollectionResponse
+	123, // 240: article.ArticleService.GetSharedCollection:output_type -> article.GetSharedCollectionResponse
+	125, // 241: article.ArticleService.ListTrendingArticles:output_type -> article.ListTrendingArticlesResponse
+	127, // 242: article.ArticleService.GetRelatedArticles:output_type -> article.GetRelatedArticlesResponse
+	129, // 243: article.ArticleService.FollowAuthor:output_type -> article.FollowAuthorResponse
+	131, // 244: article.ArticleService.UnfollowAuthor:output_type -> article.UnfollowAuthorResponse
+	133, // 245: article.ArticleService.ListFollowing:output_type -> article.ListFollowingResponse
+	135, // 246: article.ArticleService.GetFeed:output_type -> article.GetFeedResponse
+	209, // [209:247] is the sub-list for method output_type
+	171, // [171:209] is the sub-list for method input_type
+	171, // [171:171] is the sub-list for extension type_name
+	171, // [171:171] is the sub-list for extension extendee
+	0,   // [0:171] is the sub-list for field type_name
 }
 
 func init() { file_article_service_proto_init() }
@@ -1197,18 +9668,29 @@ func file_article_service_proto_init() {
 	if File_article_service_proto != nil {
 		return
 	}
+	file_article_service_proto_msgTypes[6].OneofWrappers = []any{}
+	file_article_service_proto_msgTypes[14].OneofWrappers = []any{
+		(*ArticleEvent_Created)(nil),
+		(*ArticleEvent_Updated)(nil),
+		(*ArticleEvent_Deleted)(nil),
+	}
+	file_article_service_proto_msgTypes[19].OneofWrappers = []any{
+		(*WatchArticlesResponse_Event)(nil),
+		(*WatchArticlesResponse_Heartbeat)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_article_service_proto_rawDesc), len(file_article_service_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   18,
+			NumEnums:      6,
+			NumMessages:   131,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_article_service_proto_goTypes,
 		DependencyIndexes: file_article_service_proto_depIdxs,
+		EnumInfos:         file_article_service_proto_enumTypes,
 		MessageInfos:      file_article_service_proto_msgTypes,
 	}.Build()
 	File_article_service_proto = out.File
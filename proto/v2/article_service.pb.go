@@ -0,0 +1,777 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.1
+// source: v2/article_service.proto
+
+package articlev2
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ContentFormat describes how article content is written
+type ContentFormat int32
+
+const (
+	ContentFormat_CONTENT_FORMAT_UNSPECIFIED ContentFormat = 0 // Treated as PLAIN
+	ContentFormat_CONTENT_FORMAT_PLAIN       ContentFormat = 1 // Plain text, rendered as-is
+	ContentFormat_CONTENT_FORMAT_MARKDOWN    ContentFormat = 2 // CommonMark + GFM, rendered to content_html
+	ContentFormat_CONTENT_FORMAT_HTML        ContentFormat = 3 // HTML, sanitised on write
+)
+
+// Enum value maps for ContentFormat.
+var (
+	ContentFormat_name = map[int32]string{
+		0: "CONTENT_FORMAT_UNSPECIFIED",
+		1: "CONTENT_FORMAT_PLAIN",
+		2: "CONTENT_FORMAT_MARKDOWN",
+		3: "CONTENT_FORMAT_HTML",
+	}
+	ContentFormat_value = map[string]int32{
+		"CONTENT_FORMAT_UNSPECIFIED": 0,
+		"CONTENT_FORMAT_PLAIN":       1,
+		"CONTENT_FORMAT_MARKDOWN":    2,
+		"CONTENT_FORMAT_HTML":        3,
+	}
+)
+
+func (x ContentFormat) Enum() *ContentFormat {
+	p := new(ContentFormat)
+	*p = x
+	return p
+}
+
+func (x ContentFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ContentFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_v2_article_service_proto_enumTypes[0].Descriptor()
+}
+
+func (ContentFormat) Type() protoreflect.EnumType {
+	return &file_v2_article_service_proto_enumTypes[0]
+}
+
+func (x ContentFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ContentFormat.Descriptor instead.
+func (ContentFormat) EnumDescriptor() ([]byte, []int) {
+	return file_v2_article_service_proto_rawDescGZIP(), []int{0}
+}
+
+// ArticleView selects how much of an article is returned
+type ArticleView int32
+
+const (
+	ArticleView_ARTICLE_VIEW_UNSPECIFIED ArticleView = 0 // BASIC for ListArticles, FULL for GetArticle
+	ArticleView_ARTICLE_VIEW_BASIC       ArticleView = 1 // Everything except content and content_html
+	ArticleView_ARTICLE_VIEW_FULL        ArticleView = 2 // All fields
+)
+
+// Enum value maps for ArticleView.
+var (
+	ArticleView_name = map[int32]string{
+		0: "ARTICLE_VIEW_UNSPECIFIED",
+		1: "ARTICLE_VIEW_BASIC",
+		2: "ARTICLE_VIEW_FULL",
+	}
+	ArticleView_value = map[string]int32{
+		"ARTICLE_VIEW_UNSPECIFIED": 0,
+		"ARTICLE_VIEW_BASIC":       1,
+		"ARTICLE_VIEW_FULL":        2,
+	}
+)
+
+func (x ArticleView) Enum() *ArticleView {
+	p := new(ArticleView)
+	*p = x
+	return p
+}
+
+func (x ArticleView) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ArticleView) Descriptor() protoreflect.EnumDescriptor {
+	return file_v2_article_service_proto_enumTypes[1].Descriptor()
+}
+
+func (ArticleView) Type() protoreflect.EnumType {
+	return &file_v2_article_service_proto_enumTypes[1]
+}
+
+func (x ArticleView) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ArticleView.Descriptor instead.
+func (ArticleView) EnumDescriptor() ([]byte, []int) {
+	return file_v2_article_service_proto_rawDescGZIP(), []int{1}
+}
+
+type Article struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // Resource name: "articles/{article}". Output only
+	Title              string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content            string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	ContentFormat      ContentFormat          `protobuf:"varint,4,opt,name=content_format,json=contentFormat,proto3,enum=article.v2.ContentFormat" json:"content_format,omitempty"`
+	ContentHtml        string                 `protobuf:"bytes,5,opt,name=content_html,json=contentHtml,proto3" json:"content_html,omitempty"`                         // Sanitised HTML for MARKDOWN and HTML content. Output only
+	Author             string                 `protobuf:"bytes,6,opt,name=author,proto3" json:"author,omitempty"`                                                      // Resource name of the author: "users/{user}". Output only
+	Excerpt            string                 `protobuf:"bytes,7,opt,name=excerpt,proto3" json:"excerpt,omitempty"`                                                    // Output only
+	WordCount          int32                  `protobuf:"varint,8,opt,name=word_count,json=wordCount,proto3" json:"word_count,omitempty"`                              // Output only
+	ReadingTimeMinutes int32                  `protobuf:"varint,9,opt,name=reading_time_minutes,json=readingTimeMinutes,proto3" json:"reading_time_minutes,omitempty"` // Output only
+	CreateTime         *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`                           // Output only
+	UpdateTime         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`                           // Output only
+	CommentCount       int32                  `protobuf:"varint,12,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`                    // Visible comments. Output only
+	ReactionCount      int32                  `protobuf:"varint,13,opt,name=reaction_count,json=reactionCount,proto3" json:"reaction_count,omitempty"`                 // Reactions of every type. Output only
+	ViewCount          int64                  `protobuf:"varint,14,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`                             // Deduplicated views, updated in batches. Output only
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Article) Reset() {
+	*x = Article{}
+	mi := &file_v2_article_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Article) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Article) ProtoMessage() {}
+
+func (x *Article) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_article_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Article.ProtoReflect.Descriptor instead.
+func (*Article) Descriptor() ([]byte, []int) {
+	return file_v2_article_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Article) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Article) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Article) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Article) GetContentFormat() ContentFormat {
+	if x != nil {
+		return x.ContentFormat
+	}
+	return ContentFormat_CONTENT_FORMAT_UNSPECIFIED
+}
+
+func (x *Article) GetContentHtml() string {
+	if x != nil {
+		return x.ContentHtml
+	}
+	return ""
+}
+
+func (x *Article) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *Article) GetExcerpt() string {
+	if x != nil {
+		return x.Excerpt
+	}
+	return ""
+}
+
+func (x *Article) GetWordCount() int32 {
+	if x != nil {
+		return x.WordCount
+	}
+	return 0
+}
+
+func (x *Article) GetReadingTimeMinutes() int32 {
+	if x != nil {
+		return x.ReadingTimeMinutes
+	}
+	return 0
+}
+
+func (x *Article) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Article) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *Article) GetCommentCount() int32 {
+	if x != nil {
+		return x.CommentCount
+	}
+	return 0
+}
+
+func (x *Article) GetReactionCount() int32 {
+	if x != nil {
+		return x.ReactionCount
+	}
+	return 0
+}
+
+func (x *Article) GetViewCount() int64 {
+	if x != nil {
+		return x.ViewCount
+	}
+	return 0
+}
+
+type CreateArticleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The article to create. The author is the authenticated user
+	Article       *Article `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateArticleRequest) Reset() {
+	*x = CreateArticleRequest{}
+	mi := &file_v2_article_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateArticleRequest) ProtoMessage() {}
+
+func (x *CreateArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_article_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateArticleRequest.ProtoReflect.Descriptor instead.
+func (*CreateArticleRequest) Descriptor() ([]byte, []int) {
+	return file_v2_article_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateArticleRequest) GetArticle() *Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+type GetArticleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // "articles/{article}"
+	View  ArticleView            `protobuf:"varint,2,opt,name=view,proto3,enum=article.v2.ArticleView" json:"view,omitempty"`
+	// Article fields to return; overrides view. name and author are always returned
+	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetArticleRequest) Reset() {
+	*x = GetArticleRequest{}
+	mi := &file_v2_article_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArticleRequest) ProtoMessage() {}
+
+func (x *GetArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_article_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArticleRequest.ProtoReflect.Descriptor instead.
+func (*GetArticleRequest) Descriptor() ([]byte, []int) {
+	return file_v2_article_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetArticleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetArticleRequest) GetView() ArticleView {
+	if x != nil {
+		return x.View
+	}
+	return ArticleView_ARTICLE_VIEW_UNSPECIFIED
+}
+
+func (x *GetArticleRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+type UpdateArticleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The article to update; article.name identifies it
+	Article *Article `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	// Fields to update: title, content, content_format, or "*" for all of them.
+	// Without a mask, fields left empty in article are unchanged
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateArticleRequest) Reset() {
+	*x = UpdateArticleRequest{}
+	mi := &file_v2_article_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateArticleRequest) ProtoMessage() {}
+
+func (x *UpdateArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_article_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateArticleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateArticleRequest) Descriptor() ([]byte, []int) {
+	return file_v2_article_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateArticleRequest) GetArticle() *Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+func (x *UpdateArticleRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteArticleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // "articles/{article}"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteArticleRequest) Reset() {
+	*x = DeleteArticleRequest{}
+	mi := &file_v2_article_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteArticleRequest) ProtoMessage() {}
+
+func (x *DeleteArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_article_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteArticleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteArticleRequest) Descriptor() ([]byte, []int) {
+	return file_v2_article_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteArticleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListArticlesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PageSize  int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Default 10, maximum 100
+	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page
+	Author    string                 `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`                        // Only articles by this author: "users/{user}"
+	View      ArticleView            `protobuf:"varint,4,opt,name=view,proto3,enum=article.v2.ArticleView" json:"view,omitempty"`
+	// Article fields to return; overrides view. name and author are always returned
+	ReadMask *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	// "create_time desc" (default) or "reaction_count desc" (most reactions first)
+	OrderBy       string `protobuf:"bytes,6,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListArticlesRequest) Reset() {
+	*x = ListArticlesRequest{}
+	mi := &file_v2_article_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListArticlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArticlesRequest) ProtoMessage() {}
+
+func (x *ListArticlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_article_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArticlesRequest.ProtoReflect.Descriptor instead.
+func (*ListArticlesRequest) Descriptor() ([]byte, []int) {
+	return file_v2_article_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListArticlesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListArticlesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListArticlesRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *ListArticlesRequest) GetView() ArticleView {
+	if x != nil {
+		return x.View
+	}
+	return ArticleView_ARTICLE_VIEW_UNSPECIFIED
+}
+
+func (x *ListArticlesRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+func (x *ListArticlesRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type ListArticlesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Articles      []*Article             `protobuf:"bytes,1,rep,name=articles,proto3" json:"articles,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	TotalSize     int32                  `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListArticlesResponse) Reset() {
+	*x = ListArticlesResponse{}
+	mi := &file_v2_article_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListArticlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArticlesResponse) ProtoMessage() {}
+
+func (x *ListArticlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_article_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArticlesResponse.ProtoReflect.Descriptor instead.
+func (*ListArticlesResponse) Descriptor() ([]byte, []int) {
+	return file_v2_article_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListArticlesResponse) GetArticles() []*Article {
+	if x != nil {
+		return x.Articles
+	}
+	return nil
+}
+
+func (x *ListArticlesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListArticlesResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+var File_v2_article_service_proto protoreflect.FileDescriptor
+
+const file_v2_article_service_proto_rawDesc = "" +
+	"\n" +
+	"\x18v2/article_service.proto\x12\n" +
+	"article.v2\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9a\x04\n" +
+	"\aArticle\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12@\n" +
+	"\x0econtent_format\x18\x04 \x01(\x0e2\x19.article.v2.ContentFormatR\rcontentFormat\x12!\n" +
+	"\fcontent_html\x18\x05 \x01(\tR\vcontentHtml\x12\x16\n" +
+	"\x06author\x18\x06 \x01(\tR\x06author\x12\x18\n" +
+	"\aexcerpt\x18\a \x01(\tR\aexcerpt\x12\x1d\n" +
+	"\n" +
+	"word_count\x18\b \x01(\x05R\twordCount\x120\n" +
+	"\x14reading_time_minutes\x18\t \x01(\x05R\x12readingTimeMinutes\x12;\n" +
+	"\vcreate_time\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\x12#\n" +
+	"\rcomment_count\x18\f \x01(\x05R\fcommentCount\x12%\n" +
+	"\x0ereaction_count\x18\r \x01(\x05R\rreactionCount\x12\x1d\n" +
+	"\n" +
+	"view_count\x18\x0e \x01(\x03R\tviewCount\"E\n" +
+	"\x14CreateArticleRequest\x12-\n" +
+	"\aarticle\x18\x01 \x01(\v2\x13.article.v2.ArticleR\aarticle\"\x8d\x01\n" +
+	"\x11GetArticleRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12+\n" +
+	"\x04view\x18\x02 \x01(\x0e2\x17.article.v2.ArticleViewR\x04view\x127\n" +
+	"\tread_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\"\x82\x01\n" +
+	"\x14UpdateArticleRequest\x12-\n" +
+	"\aarticle\x18\x01 \x01(\v2\x13.article.v2.ArticleR\aarticle\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"*\n" +
+	"\x14DeleteArticleRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\xea\x01\n" +
+	"\x13ListArticlesRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06author\x18\x03 \x01(\tR\x06author\x12+\n" +
+	"\x04view\x18\x04 \x01(\x0e2\x17.article.v2.ArticleViewR\x04view\x127\n" +
+	"\tread_mask\x18\x05 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\x12\x19\n" +
+	"\border_by\x18\x06 \x01(\tR\aorderBy\"\x8e\x01\n" +
+	"\x14ListArticlesResponse\x12/\n" +
+	"\barticles\x18\x01 \x03(\v2\x13.article.v2.ArticleR\barticles\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize*\x7f\n" +
+	"\rContentFormat\x12\x1e\n" +
+	"\x1aCONTENT_FORMAT_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14CONTENT_FORMAT_PLAIN\x10\x01\x12\x1b\n" +
+	"\x17CONTENT_FORMAT_MARKDOWN\x10\x02\x12\x17\n" +
+	"\x13CONTENT_FORMAT_HTML\x10\x03*Z\n" +
+	"\vArticleView\x12\x1c\n" +
+	"\x18ARTICLE_VIEW_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12ARTICLE_VIEW_BASIC\x10\x01\x12\x15\n" +
+	"\x11ARTICLE_VIEW_FULL\x10\x022\x80\x03\n" +
+	"\x0eArticleService\x12F\n" +
+	"\rCreateArticle\x12 .article.v2.CreateArticleRequest\x1a\x13.article.v2.Article\x12@\n" +
+	"\n" +
+	"GetArticle\x12\x1d.article.v2.GetArticleRequest\x1a\x13.article.v2.Article\x12F\n" +
+	"\rUpdateArticle\x12 .article.v2.UpdateArticleRequest\x1a\x13.article.v2.Article\x12I\n" +
+	"\rDeleteArticle\x12 .article.v2.DeleteArticleRequest\x1a\x16.google.protobuf.Empty\x12Q\n" +
+	"\fListArticles\x12\x1f.article.v2.ListArticlesRequest\x1a .article.v2.ListArticlesResponseB$Z\"article-service/proto/v2;articlev2b\x06proto3"
+
+var (
+	file_v2_article_service_proto_rawDescOnce sync.Once
+	file_v2_article_service_proto_rawDescData []byte
+)
+
+func file_v2_article_service_proto_rawDescGZIP() []byte {
+	file_v2_article_service_proto_rawDescOnce.Do(func() {
+		file_v2_article_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v2_article_service_proto_rawDesc), len(file_v2_article_service_proto_rawDesc)))
+	})
+	return file_v2_article_service_proto_rawDescData
+}
+
+var file_v2_article_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_v2_article_service_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_v2_article_service_proto_goTypes = []any{
+	(ContentFormat)(0),            // 0: article.v2.ContentFormat
+	(ArticleView)(0),              // 1: article.v2.ArticleView
+	(*Article)(nil),               // 2: article.v2.Article
+	(*CreateArticleRequest)(nil),  // 3: article.v2.CreateArticleRequest
+	(*GetArticleRequest)(nil),     // 4: article.v2.GetArticleRequest
+	(*UpdateArticleRequest)(nil),  // 5: article.v2.UpdateArticleRequest
+	(*DeleteArticleRequest)(nil),  // 6: article.v2.DeleteArticleRequest
+	(*ListArticlesRequest)(nil),   // 7: article.v2.ListArticlesRequest
+	(*ListArticlesResponse)(nil),  // 8: article.v2.ListArticlesResponse
+	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil), // 10: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),         // 11: google.protobuf.Empty
+}
+var file_v2_article_service_proto_depIdxs = []int32{
+	0,  // 0: article.v2.Article.content_format:type_name -> article.v2.ContentFormat
+	9,  // 1: article.v2.Article.create_time:type_name -> google.protobuf.Timestamp
+	9,  // 2: article.v2.Article.update_time:type_name -> google.protobuf.Timestamp
+	2,  // 3: article.v2.CreateArticleRequest.article:type_name -> article.v2.Article
+	1,  // 4: article.v2.GetArticleRequest.view:type_name -> article.v2.ArticleView
+	10, // 5: article.v2.GetArticleRequest.read_mask:type_name -> google.protobuf.FieldMask
+	2,  // 6: article.v2.UpdateArticleRequest.article:type_name -> article.v2.Article
+	10, // 7: article.v2.UpdateArticleRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 8: article.v2.ListArticlesRequest.view:type_name -> article.v2.ArticleView
+	10, // 9: article.v2.ListArticlesRequest.read_mask:type_name -> google.protobuf.FieldMask
+	2,  // 10: article.v2.ListArticlesResponse.articles:type_name -> article.v2.Article
+	3,  // 11: article.v2.ArticleService.CreateArticle:input_type -> article.v2.CreateArticleRequest
+	4,  // 12: article.v2.ArticleService.GetArticle:input_type -> article.v2.GetArticleRequest
+	5,  // 13: article.v2.ArticleService.UpdateArticle:input_type -> article.v2.UpdateArticleRequest
+	6,  // 14: article.v2.ArticleService.DeleteArticle:input_type -> article.v2.DeleteArticleRequest
+	7,  // 15: article.v2.ArticleService.ListArticles:input_type -> article.v2.ListArticlesRequest
+	2,  // 16: article.v2.ArticleService.CreateArticle:output_type -> article.v2.Article
+	2,  // 17: article.v2.ArticleService.GetArticle:output_type -> article.v2.Article
+	2,  // 18: article.v2.ArticleService.UpdateArticle:output_type -> article.v2.Article
+	11, // 19: article.v2.ArticleService.DeleteArticle:output_type -> google.protobuf.Empty
+	8,  // 20: article.v2.ArticleService.ListArticles:output_type -> article.v2.ListArticlesResponse
+	16, // [16:21] is the sub-list for method output_type
+	11, // [11:16] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_v2_article_service_proto_init() }
+func file_v2_article_service_proto_init() {
+	if File_v2_article_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v2_article_service_proto_rawDesc), len(file_v2_article_service_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_v2_article_service_proto_goTypes,
+		DependencyIndexes: file_v2_article_service_proto_depIdxs,
+		EnumInfos:         file_v2_article_service_proto_enumTypes,
+		MessageInfos:      file_v2_article_service_proto_msgTypes,
+	}.Build()
+	File_v2_article_service_proto = out.File
+	file_v2_article_service_proto_goTypes = nil
+	file_v2_article_service_proto_depIdxs = nil
+}
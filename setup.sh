@@ -31,7 +31,7 @@ read -p "   Create database? (y/n) " -n 1 -r
 echo
 if [[ $REPLY =~ ^[Yy]$ ]]; then
     psql -U postgres -c "CREATE DATABASE agrios_articles;" 2>/dev/null || echo "   Database already exists"
-    psql -U postgres -d agrios_articles -f migrations/001_create_articles_table.sql
+    go run ./cmd/server migrate up
     echo "✅ Database setup complete"
 else
     echo "⏭️  Skipping database setup"